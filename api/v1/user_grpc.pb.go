@@ -19,16 +19,62 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	User_CreateUser_FullMethodName      = "/api.v1.User/CreateUser"
-	User_CreateUserToken_FullMethodName = "/api.v1.User/CreateUserToken"
+	User_CreateUser_FullMethodName            = "/api.v1.User/CreateUser"
+	User_GetMe_FullMethodName                 = "/api.v1.User/GetMe"
+	User_UpdateMyIdentity_FullMethodName      = "/api.v1.User/UpdateMyIdentity"
+	User_DeleteMe_FullMethodName              = "/api.v1.User/DeleteMe"
+	User_RequestDataExport_FullMethodName     = "/api.v1.User/RequestDataExport"
+	User_CreateUserToken_FullMethodName       = "/api.v1.User/CreateUserToken"
+	User_RefreshUserToken_FullMethodName      = "/api.v1.User/RefreshUserToken"
+	User_Logout_FullMethodName                = "/api.v1.User/Logout"
+	User_LogoutAll_FullMethodName             = "/api.v1.User/LogoutAll"
+	User_SendPhoneVerification_FullMethodName = "/api.v1.User/SendPhoneVerification"
+	User_VerifyPhoneNumber_FullMethodName     = "/api.v1.User/VerifyPhoneNumber"
+	User_RequestPasswordReset_FullMethodName  = "/api.v1.User/RequestPasswordReset"
+	User_VerifyPasswordReset_FullMethodName   = "/api.v1.User/VerifyPasswordReset"
+	User_ResetPassword_FullMethodName         = "/api.v1.User/ResetPassword"
+	User_ChangePassword_FullMethodName        = "/api.v1.User/ChangePassword"
+	User_EnrollTOTP_FullMethodName            = "/api.v1.User/EnrollTOTP"
+	User_ConfirmTOTP_FullMethodName           = "/api.v1.User/ConfirmTOTP"
+	User_CompleteMFALogin_FullMethodName      = "/api.v1.User/CompleteMFALogin"
+	User_ListSessions_FullMethodName          = "/api.v1.User/ListSessions"
+	User_RevokeSession_FullMethodName         = "/api.v1.User/RevokeSession"
+	User_ChangeUserRole_FullMethodName        = "/api.v1.User/ChangeUserRole"
 )
 
 // UserClient is the client API for User service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserClient interface {
+	// CreateUser can be retried safely with an Idempotency-Key header, a retry with the same key and body
+	// gets the first response back.
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
+	// UpdateMyIdentity only changes the fields that are sent, the preferences are left as they are.
+	UpdateMyIdentity(ctx context.Context, in *UpdateMyIdentityRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
+	// DeleteMe hides the account right away and purges it once the grace period ends,
+	// logging in before then restores it.
+	DeleteMe(ctx context.Context, in *DeleteMeRequest, opts ...grpc.CallOption) (*DeleteMeResponse, error)
+	// RequestDataExport archives everything stored about the user, the archive is downloaded
+	// from download_url with the same bearer token until expires_at.
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
 	CreateUserToken(ctx context.Context, in *CreateUserTokenRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error)
+	RefreshUserToken(ctx context.Context, in *RefreshUserTokenRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	SendPhoneVerification(ctx context.Context, in *SendPhoneVerificationRequest, opts ...grpc.CallOption) (*SendPhoneVerificationResponse, error)
+	VerifyPhoneNumber(ctx context.Context, in *VerifyPhoneNumberRequest, opts ...grpc.CallOption) (*VerifyPhoneNumberResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	VerifyPasswordReset(ctx context.Context, in *VerifyPasswordResetRequest, opts ...grpc.CallOption) (*VerifyPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	CompleteMFALogin(ctx context.Context, in *CompleteMFALoginRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// ChangeUserRole is only allowed to admins, the new role applies once the user refreshes their token.
+	ChangeUserRole(ctx context.Context, in *ChangeUserRoleRequest, opts ...grpc.CallOption) (*ChangeUserRoleResponse, error)
 }
 
 type userClient struct {
@@ -48,6 +94,42 @@ func (c *userClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts
 	return out, nil
 }
 
+func (c *userClient) GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error) {
+	out := new(GetMeResponse)
+	err := c.cc.Invoke(ctx, User_GetMe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateMyIdentity(ctx context.Context, in *UpdateMyIdentityRequest, opts ...grpc.CallOption) (*GetMeResponse, error) {
+	out := new(GetMeResponse)
+	err := c.cc.Invoke(ctx, User_UpdateMyIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteMe(ctx context.Context, in *DeleteMeRequest, opts ...grpc.CallOption) (*DeleteMeResponse, error) {
+	out := new(DeleteMeResponse)
+	err := c.cc.Invoke(ctx, User_DeleteMe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, User_RequestDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) CreateUserToken(ctx context.Context, in *CreateUserTokenRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error) {
 	out := new(CreateUserTokenResponse)
 	err := c.cc.Invoke(ctx, User_CreateUserToken_FullMethodName, in, out, opts...)
@@ -57,12 +139,174 @@ func (c *userClient) CreateUserToken(ctx context.Context, in *CreateUserTokenReq
 	return out, nil
 }
 
+func (c *userClient) RefreshUserToken(ctx context.Context, in *RefreshUserTokenRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error) {
+	out := new(CreateUserTokenResponse)
+	err := c.cc.Invoke(ctx, User_RefreshUserToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, User_Logout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, User_LogoutAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SendPhoneVerification(ctx context.Context, in *SendPhoneVerificationRequest, opts ...grpc.CallOption) (*SendPhoneVerificationResponse, error) {
+	out := new(SendPhoneVerificationResponse)
+	err := c.cc.Invoke(ctx, User_SendPhoneVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) VerifyPhoneNumber(ctx context.Context, in *VerifyPhoneNumberRequest, opts ...grpc.CallOption) (*VerifyPhoneNumberResponse, error) {
+	out := new(VerifyPhoneNumberResponse)
+	err := c.cc.Invoke(ctx, User_VerifyPhoneNumber_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, User_RequestPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) VerifyPasswordReset(ctx context.Context, in *VerifyPasswordResetRequest, opts ...grpc.CallOption) (*VerifyPasswordResetResponse, error) {
+	out := new(VerifyPasswordResetResponse)
+	err := c.cc.Invoke(ctx, User_VerifyPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, User_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, User_ChangePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, User_EnrollTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, User_ConfirmTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CompleteMFALogin(ctx context.Context, in *CompleteMFALoginRequest, opts ...grpc.CallOption) (*CreateUserTokenResponse, error) {
+	out := new(CreateUserTokenResponse)
+	err := c.cc.Invoke(ctx, User_CompleteMFALogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, User_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, User_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ChangeUserRole(ctx context.Context, in *ChangeUserRoleRequest, opts ...grpc.CallOption) (*ChangeUserRoleResponse, error) {
+	out := new(ChangeUserRoleResponse)
+	err := c.cc.Invoke(ctx, User_ChangeUserRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
 type UserServer interface {
+	// CreateUser can be retried safely with an Idempotency-Key header, a retry with the same key and body
+	// gets the first response back.
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+	// UpdateMyIdentity only changes the fields that are sent, the preferences are left as they are.
+	UpdateMyIdentity(context.Context, *UpdateMyIdentityRequest) (*GetMeResponse, error)
+	// DeleteMe hides the account right away and purges it once the grace period ends,
+	// logging in before then restores it.
+	DeleteMe(context.Context, *DeleteMeRequest) (*DeleteMeResponse, error)
+	// RequestDataExport archives everything stored about the user, the archive is downloaded
+	// from download_url with the same bearer token until expires_at.
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
 	CreateUserToken(context.Context, *CreateUserTokenRequest) (*CreateUserTokenResponse, error)
+	RefreshUserToken(context.Context, *RefreshUserTokenRequest) (*CreateUserTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutResponse, error)
+	SendPhoneVerification(context.Context, *SendPhoneVerificationRequest) (*SendPhoneVerificationResponse, error)
+	VerifyPhoneNumber(context.Context, *VerifyPhoneNumberRequest) (*VerifyPhoneNumberResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	VerifyPasswordReset(context.Context, *VerifyPasswordResetRequest) (*VerifyPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	CompleteMFALogin(context.Context, *CompleteMFALoginRequest) (*CreateUserTokenResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// ChangeUserRole is only allowed to admins, the new role applies once the user refreshes their token.
+	ChangeUserRole(context.Context, *ChangeUserRoleRequest) (*ChangeUserRoleResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -73,9 +317,66 @@ type UnimplementedUserServer struct {
 func (UnimplementedUserServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
+func (UnimplementedUserServer) GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
+}
+func (UnimplementedUserServer) UpdateMyIdentity(context.Context, *UpdateMyIdentityRequest) (*GetMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyIdentity not implemented")
+}
+func (UnimplementedUserServer) DeleteMe(context.Context, *DeleteMeRequest) (*DeleteMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMe not implemented")
+}
+func (UnimplementedUserServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
 func (UnimplementedUserServer) CreateUserToken(context.Context, *CreateUserTokenRequest) (*CreateUserTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserToken not implemented")
 }
+func (UnimplementedUserServer) RefreshUserToken(context.Context, *RefreshUserTokenRequest) (*CreateUserTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshUserToken not implemented")
+}
+func (UnimplementedUserServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUserServer) LogoutAll(context.Context, *LogoutAllRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedUserServer) SendPhoneVerification(context.Context, *SendPhoneVerificationRequest) (*SendPhoneVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPhoneVerification not implemented")
+}
+func (UnimplementedUserServer) VerifyPhoneNumber(context.Context, *VerifyPhoneNumberRequest) (*VerifyPhoneNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPhoneNumber not implemented")
+}
+func (UnimplementedUserServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUserServer) VerifyPasswordReset(context.Context, *VerifyPasswordResetRequest) (*VerifyPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPasswordReset not implemented")
+}
+func (UnimplementedUserServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedUserServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedUserServer) CompleteMFALogin(context.Context, *CompleteMFALoginRequest) (*CreateUserTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMFALogin not implemented")
+}
+func (UnimplementedUserServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServer) ChangeUserRole(context.Context, *ChangeUserRoleRequest) (*ChangeUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserRole not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -107,6 +408,78 @@ func _User_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetMe(ctx, req.(*GetMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateMyIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateMyIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateMyIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateMyIdentity(ctx, req.(*UpdateMyIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteMe(ctx, req.(*DeleteMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_CreateUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserTokenRequest)
 	if err := dec(in); err != nil {
@@ -125,20 +498,1099 @@ func _User_CreateUserToken_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-// User_ServiceDesc is the grpc.ServiceDesc for User service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var User_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.v1.User",
-	HandlerType: (*UserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _User_CreateUser_Handler,
-		},
-		{
-			MethodName: "CreateUserToken",
-			Handler:    _User_CreateUserToken_Handler,
+func _User_RefreshUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshUserTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RefreshUserToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RefreshUserToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RefreshUserToken(ctx, req.(*RefreshUserTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_LogoutAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LogoutAll(ctx, req.(*LogoutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendPhoneVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPhoneVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendPhoneVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SendPhoneVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendPhoneVerification(ctx, req.(*SendPhoneVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_VerifyPhoneNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPhoneNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).VerifyPhoneNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_VerifyPhoneNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).VerifyPhoneNumber(ctx, req.(*VerifyPhoneNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_VerifyPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).VerifyPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_VerifyPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).VerifyPasswordReset(ctx, req.(*VerifyPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CompleteMFALogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMFALoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CompleteMFALogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CompleteMFALogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CompleteMFALogin(ctx, req.(*CompleteMFALoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangeUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangeUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangeUserRole(ctx, req.(*ChangeUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _User_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetMe",
+			Handler:    _User_GetMe_Handler,
+		},
+		{
+			MethodName: "UpdateMyIdentity",
+			Handler:    _User_UpdateMyIdentity_Handler,
+		},
+		{
+			MethodName: "DeleteMe",
+			Handler:    _User_DeleteMe_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _User_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "CreateUserToken",
+			Handler:    _User_CreateUserToken_Handler,
+		},
+		{
+			MethodName: "RefreshUserToken",
+			Handler:    _User_RefreshUserToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _User_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _User_LogoutAll_Handler,
+		},
+		{
+			MethodName: "SendPhoneVerification",
+			Handler:    _User_SendPhoneVerification_Handler,
+		},
+		{
+			MethodName: "VerifyPhoneNumber",
+			Handler:    _User_VerifyPhoneNumber_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _User_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "VerifyPasswordReset",
+			Handler:    _User_VerifyPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _User_ResetPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _User_ChangePassword_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _User_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _User_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "CompleteMFALogin",
+			Handler:    _User_CompleteMFALogin_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _User_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _User_RevokeSession_Handler,
+		},
+		{
+			MethodName: "ChangeUserRole",
+			Handler:    _User_ChangeUserRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user.proto",
+}
+
+const (
+	Profile_GetMyProfile_FullMethodName    = "/api.v1.Profile/GetMyProfile"
+	Profile_UpdateMyProfile_FullMethodName = "/api.v1.Profile/UpdateMyProfile"
+)
+
+// ProfileClient is the client API for Profile service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProfileClient interface {
+	// GetMyProfile returns the profile of the authenticated user, 404 until it is filled in.
+	GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// UpdateMyProfile only changes the fields that are sent, birthdate is required to create the profile.
+	UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+}
+
+type profileClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileClient(cc grpc.ClientConnInterface) ProfileClient {
+	return &profileClient{cc}
+}
+
+func (c *profileClient) GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, Profile_GetMyProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, Profile_UpdateMyProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServer is the server API for Profile service.
+// All implementations must embed UnimplementedProfileServer
+// for forward compatibility
+type ProfileServer interface {
+	// GetMyProfile returns the profile of the authenticated user, 404 until it is filled in.
+	GetMyProfile(context.Context, *GetMyProfileRequest) (*ProfileResponse, error)
+	// UpdateMyProfile only changes the fields that are sent, birthdate is required to create the profile.
+	UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*ProfileResponse, error)
+	mustEmbedUnimplementedProfileServer()
+}
+
+// UnimplementedProfileServer must be embedded to have forward compatible implementations.
+type UnimplementedProfileServer struct {
+}
+
+func (UnimplementedProfileServer) GetMyProfile(context.Context, *GetMyProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyProfile not implemented")
+}
+func (UnimplementedProfileServer) UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyProfile not implemented")
+}
+func (UnimplementedProfileServer) mustEmbedUnimplementedProfileServer() {}
+
+// UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServer will
+// result in compilation errors.
+type UnsafeProfileServer interface {
+	mustEmbedUnimplementedProfileServer()
+}
+
+func RegisterProfileServer(s grpc.ServiceRegistrar, srv ProfileServer) {
+	s.RegisterService(&Profile_ServiceDesc, srv)
+}
+
+func _Profile_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_GetMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetMyProfile(ctx, req.(*GetMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UpdateMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UpdateMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UpdateMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UpdateMyProfile(ctx, req.(*UpdateMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Profile_ServiceDesc is the grpc.ServiceDesc for Profile service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Profile_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.Profile",
+	HandlerType: (*ProfileServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyProfile",
+			Handler:    _Profile_GetMyProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMyProfile",
+			Handler:    _Profile_UpdateMyProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user.proto",
+}
+
+const (
+	Preferences_GetMyPreferences_FullMethodName    = "/api.v1.Preferences/GetMyPreferences"
+	Preferences_UpdateMyPreferences_FullMethodName = "/api.v1.Preferences/UpdateMyPreferences"
+)
+
+// PreferencesClient is the client API for Preferences service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PreferencesClient interface {
+	// GetMyPreferences returns who the authenticated user wants to see, every user has preferences from signup on.
+	GetMyPreferences(ctx context.Context, in *GetMyPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	// UpdateMyPreferences only changes the fields that are sent, an empty interested_in keeps the current genders.
+	UpdateMyPreferences(ctx context.Context, in *UpdateMyPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+}
+
+type preferencesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPreferencesClient(cc grpc.ClientConnInterface) PreferencesClient {
+	return &preferencesClient{cc}
+}
+
+func (c *preferencesClient) GetMyPreferences(ctx context.Context, in *GetMyPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, Preferences_GetMyPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *preferencesClient) UpdateMyPreferences(ctx context.Context, in *UpdateMyPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, Preferences_UpdateMyPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PreferencesServer is the server API for Preferences service.
+// All implementations must embed UnimplementedPreferencesServer
+// for forward compatibility
+type PreferencesServer interface {
+	// GetMyPreferences returns who the authenticated user wants to see, every user has preferences from signup on.
+	GetMyPreferences(context.Context, *GetMyPreferencesRequest) (*PreferencesResponse, error)
+	// UpdateMyPreferences only changes the fields that are sent, an empty interested_in keeps the current genders.
+	UpdateMyPreferences(context.Context, *UpdateMyPreferencesRequest) (*PreferencesResponse, error)
+	mustEmbedUnimplementedPreferencesServer()
+}
+
+// UnimplementedPreferencesServer must be embedded to have forward compatible implementations.
+type UnimplementedPreferencesServer struct {
+}
+
+func (UnimplementedPreferencesServer) GetMyPreferences(context.Context, *GetMyPreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyPreferences not implemented")
+}
+func (UnimplementedPreferencesServer) UpdateMyPreferences(context.Context, *UpdateMyPreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyPreferences not implemented")
+}
+func (UnimplementedPreferencesServer) mustEmbedUnimplementedPreferencesServer() {}
+
+// UnsafePreferencesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PreferencesServer will
+// result in compilation errors.
+type UnsafePreferencesServer interface {
+	mustEmbedUnimplementedPreferencesServer()
+}
+
+func RegisterPreferencesServer(s grpc.ServiceRegistrar, srv PreferencesServer) {
+	s.RegisterService(&Preferences_ServiceDesc, srv)
+}
+
+func _Preferences_GetMyPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreferencesServer).GetMyPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Preferences_GetMyPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreferencesServer).GetMyPreferences(ctx, req.(*GetMyPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Preferences_UpdateMyPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreferencesServer).UpdateMyPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Preferences_UpdateMyPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreferencesServer).UpdateMyPreferences(ctx, req.(*UpdateMyPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Preferences_ServiceDesc is the grpc.ServiceDesc for Preferences service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Preferences_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.Preferences",
+	HandlerType: (*PreferencesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyPreferences",
+			Handler:    _Preferences_GetMyPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateMyPreferences",
+			Handler:    _Preferences_UpdateMyPreferences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user.proto",
+}
+
+const (
+	Location_GetMyLocation_FullMethodName    = "/api.v1.Location/GetMyLocation"
+	Location_UpdateMyLocation_FullMethodName = "/api.v1.Location/UpdateMyLocation"
+)
+
+// LocationClient is the client API for Location service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LocationClient interface {
+	// GetMyLocation returns the last location shared by the authenticated user, 404 until one is shared.
+	GetMyLocation(ctx context.Context, in *GetMyLocationRequest, opts ...grpc.CallOption) (*LocationResponse, error)
+	// UpdateMyLocation replaces the location used to show nearby people.
+	UpdateMyLocation(ctx context.Context, in *UpdateMyLocationRequest, opts ...grpc.CallOption) (*LocationResponse, error)
+}
+
+type locationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocationClient(cc grpc.ClientConnInterface) LocationClient {
+	return &locationClient{cc}
+}
+
+func (c *locationClient) GetMyLocation(ctx context.Context, in *GetMyLocationRequest, opts ...grpc.CallOption) (*LocationResponse, error) {
+	out := new(LocationResponse)
+	err := c.cc.Invoke(ctx, Location_GetMyLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationClient) UpdateMyLocation(ctx context.Context, in *UpdateMyLocationRequest, opts ...grpc.CallOption) (*LocationResponse, error) {
+	out := new(LocationResponse)
+	err := c.cc.Invoke(ctx, Location_UpdateMyLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LocationServer is the server API for Location service.
+// All implementations must embed UnimplementedLocationServer
+// for forward compatibility
+type LocationServer interface {
+	// GetMyLocation returns the last location shared by the authenticated user, 404 until one is shared.
+	GetMyLocation(context.Context, *GetMyLocationRequest) (*LocationResponse, error)
+	// UpdateMyLocation replaces the location used to show nearby people.
+	UpdateMyLocation(context.Context, *UpdateMyLocationRequest) (*LocationResponse, error)
+	mustEmbedUnimplementedLocationServer()
+}
+
+// UnimplementedLocationServer must be embedded to have forward compatible implementations.
+type UnimplementedLocationServer struct {
+}
+
+func (UnimplementedLocationServer) GetMyLocation(context.Context, *GetMyLocationRequest) (*LocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyLocation not implemented")
+}
+func (UnimplementedLocationServer) UpdateMyLocation(context.Context, *UpdateMyLocationRequest) (*LocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyLocation not implemented")
+}
+func (UnimplementedLocationServer) mustEmbedUnimplementedLocationServer() {}
+
+// UnsafeLocationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocationServer will
+// result in compilation errors.
+type UnsafeLocationServer interface {
+	mustEmbedUnimplementedLocationServer()
+}
+
+func RegisterLocationServer(s grpc.ServiceRegistrar, srv LocationServer) {
+	s.RegisterService(&Location_ServiceDesc, srv)
+}
+
+func _Location_GetMyLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServer).GetMyLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Location_GetMyLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServer).GetMyLocation(ctx, req.(*GetMyLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Location_UpdateMyLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServer).UpdateMyLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Location_UpdateMyLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServer).UpdateMyLocation(ctx, req.(*UpdateMyLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Location_ServiceDesc is the grpc.ServiceDesc for Location service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Location_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.Location",
+	HandlerType: (*LocationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyLocation",
+			Handler:    _Location_GetMyLocation_Handler,
+		},
+		{
+			MethodName: "UpdateMyLocation",
+			Handler:    _Location_UpdateMyLocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user.proto",
+}
+
+const (
+	Photo_ListMyPhotos_FullMethodName  = "/api.v1.Photo/ListMyPhotos"
+	Photo_DeletePhoto_FullMethodName   = "/api.v1.Photo/DeletePhoto"
+	Photo_ReorderPhotos_FullMethodName = "/api.v1.Photo/ReorderPhotos"
+)
+
+// PhotoClient is the client API for Photo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PhotoClient interface {
+	// ListMyPhotos returns the photos in their order, the first one is the primary photo.
+	ListMyPhotos(ctx context.Context, in *ListMyPhotosRequest, opts ...grpc.CallOption) (*ListPhotosResponse, error)
+	// DeletePhoto moves the following photos up, the next one becomes primary when the primary photo is deleted.
+	DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeletePhotoResponse, error)
+	// ReorderPhotos takes every photo id in the new order, the first one becomes primary.
+	ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*ListPhotosResponse, error)
+}
+
+type photoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhotoClient(cc grpc.ClientConnInterface) PhotoClient {
+	return &photoClient{cc}
+}
+
+func (c *photoClient) ListMyPhotos(ctx context.Context, in *ListMyPhotosRequest, opts ...grpc.CallOption) (*ListPhotosResponse, error) {
+	out := new(ListPhotosResponse)
+	err := c.cc.Invoke(ctx, Photo_ListMyPhotos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoClient) DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeletePhotoResponse, error) {
+	out := new(DeletePhotoResponse)
+	err := c.cc.Invoke(ctx, Photo_DeletePhoto_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoClient) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*ListPhotosResponse, error) {
+	out := new(ListPhotosResponse)
+	err := c.cc.Invoke(ctx, Photo_ReorderPhotos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhotoServer is the server API for Photo service.
+// All implementations must embed UnimplementedPhotoServer
+// for forward compatibility
+type PhotoServer interface {
+	// ListMyPhotos returns the photos in their order, the first one is the primary photo.
+	ListMyPhotos(context.Context, *ListMyPhotosRequest) (*ListPhotosResponse, error)
+	// DeletePhoto moves the following photos up, the next one becomes primary when the primary photo is deleted.
+	DeletePhoto(context.Context, *DeletePhotoRequest) (*DeletePhotoResponse, error)
+	// ReorderPhotos takes every photo id in the new order, the first one becomes primary.
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*ListPhotosResponse, error)
+	mustEmbedUnimplementedPhotoServer()
+}
+
+// UnimplementedPhotoServer must be embedded to have forward compatible implementations.
+type UnimplementedPhotoServer struct {
+}
+
+func (UnimplementedPhotoServer) ListMyPhotos(context.Context, *ListMyPhotosRequest) (*ListPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyPhotos not implemented")
+}
+func (UnimplementedPhotoServer) DeletePhoto(context.Context, *DeletePhotoRequest) (*DeletePhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePhoto not implemented")
+}
+func (UnimplementedPhotoServer) ReorderPhotos(context.Context, *ReorderPhotosRequest) (*ListPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPhotos not implemented")
+}
+func (UnimplementedPhotoServer) mustEmbedUnimplementedPhotoServer() {}
+
+// UnsafePhotoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhotoServer will
+// result in compilation errors.
+type UnsafePhotoServer interface {
+	mustEmbedUnimplementedPhotoServer()
+}
+
+func RegisterPhotoServer(s grpc.ServiceRegistrar, srv PhotoServer) {
+	s.RegisterService(&Photo_ServiceDesc, srv)
+}
+
+func _Photo_ListMyPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServer).ListMyPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Photo_ListMyPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServer).ListMyPhotos(ctx, req.(*ListMyPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Photo_DeletePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServer).DeletePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Photo_DeletePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServer).DeletePhoto(ctx, req.(*DeletePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Photo_ReorderPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServer).ReorderPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Photo_ReorderPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServer).ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Photo_ServiceDesc is the grpc.ServiceDesc for Photo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Photo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.Photo",
+	HandlerType: (*PhotoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMyPhotos",
+			Handler:    _Photo_ListMyPhotos_Handler,
+		},
+		{
+			MethodName: "DeletePhoto",
+			Handler:    _Photo_DeletePhoto_Handler,
+		},
+		{
+			MethodName: "ReorderPhotos",
+			Handler:    _Photo_ReorderPhotos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user.proto",
+}
+
+const (
+	Interest_ListInterests_FullMethodName   = "/api.v1.Interest/ListInterests"
+	Interest_ListMyInterests_FullMethodName = "/api.v1.Interest/ListMyInterests"
+	Interest_SetMyInterests_FullMethodName  = "/api.v1.Interest/SetMyInterests"
+)
+
+// InterestClient is the client API for Interest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InterestClient interface {
+	// ListInterests returns the whole catalog ordered by category and name.
+	ListInterests(ctx context.Context, in *ListInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error)
+	// ListMyInterests returns the interests picked by the authenticated user.
+	ListMyInterests(ctx context.Context, in *ListMyInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error)
+	// SetMyInterests replaces every interest of the authenticated user, an empty list removes them all.
+	SetMyInterests(ctx context.Context, in *SetMyInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error)
+}
+
+type interestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInterestClient(cc grpc.ClientConnInterface) InterestClient {
+	return &interestClient{cc}
+}
+
+func (c *interestClient) ListInterests(ctx context.Context, in *ListInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error) {
+	out := new(ListInterestsResponse)
+	err := c.cc.Invoke(ctx, Interest_ListInterests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interestClient) ListMyInterests(ctx context.Context, in *ListMyInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error) {
+	out := new(ListInterestsResponse)
+	err := c.cc.Invoke(ctx, Interest_ListMyInterests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interestClient) SetMyInterests(ctx context.Context, in *SetMyInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error) {
+	out := new(ListInterestsResponse)
+	err := c.cc.Invoke(ctx, Interest_SetMyInterests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InterestServer is the server API for Interest service.
+// All implementations must embed UnimplementedInterestServer
+// for forward compatibility
+type InterestServer interface {
+	// ListInterests returns the whole catalog ordered by category and name.
+	ListInterests(context.Context, *ListInterestsRequest) (*ListInterestsResponse, error)
+	// ListMyInterests returns the interests picked by the authenticated user.
+	ListMyInterests(context.Context, *ListMyInterestsRequest) (*ListInterestsResponse, error)
+	// SetMyInterests replaces every interest of the authenticated user, an empty list removes them all.
+	SetMyInterests(context.Context, *SetMyInterestsRequest) (*ListInterestsResponse, error)
+	mustEmbedUnimplementedInterestServer()
+}
+
+// UnimplementedInterestServer must be embedded to have forward compatible implementations.
+type UnimplementedInterestServer struct {
+}
+
+func (UnimplementedInterestServer) ListInterests(context.Context, *ListInterestsRequest) (*ListInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInterests not implemented")
+}
+func (UnimplementedInterestServer) ListMyInterests(context.Context, *ListMyInterestsRequest) (*ListInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyInterests not implemented")
+}
+func (UnimplementedInterestServer) SetMyInterests(context.Context, *SetMyInterestsRequest) (*ListInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMyInterests not implemented")
+}
+func (UnimplementedInterestServer) mustEmbedUnimplementedInterestServer() {}
+
+// UnsafeInterestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InterestServer will
+// result in compilation errors.
+type UnsafeInterestServer interface {
+	mustEmbedUnimplementedInterestServer()
+}
+
+func RegisterInterestServer(s grpc.ServiceRegistrar, srv InterestServer) {
+	s.RegisterService(&Interest_ServiceDesc, srv)
+}
+
+func _Interest_ListInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterestServer).ListInterests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Interest_ListInterests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterestServer).ListInterests(ctx, req.(*ListInterestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Interest_ListMyInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyInterestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterestServer).ListMyInterests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Interest_ListMyInterests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterestServer).ListMyInterests(ctx, req.(*ListMyInterestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Interest_SetMyInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMyInterestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterestServer).SetMyInterests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Interest_SetMyInterests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterestServer).SetMyInterests(ctx, req.(*SetMyInterestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Interest_ServiceDesc is the grpc.ServiceDesc for Interest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Interest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.Interest",
+	HandlerType: (*InterestServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInterests",
+			Handler:    _Interest_ListInterests_Handler,
+		},
+		{
+			MethodName: "ListMyInterests",
+			Handler:    _Interest_ListMyInterests_Handler,
+		},
+		{
+			MethodName: "SetMyInterests",
+			Handler:    _Interest_SetMyInterests_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -20,17 +20,82 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationUserCreateUser = "/api.v1.User/CreateUser"
+const OperationUserGetMe = "/api.v1.User/GetMe"
+const OperationUserUpdateMyIdentity = "/api.v1.User/UpdateMyIdentity"
+const OperationUserDeleteMe = "/api.v1.User/DeleteMe"
+const OperationUserRequestDataExport = "/api.v1.User/RequestDataExport"
 const OperationUserCreateUserToken = "/api.v1.User/CreateUserToken"
+const OperationUserRefreshUserToken = "/api.v1.User/RefreshUserToken"
+const OperationUserLogout = "/api.v1.User/Logout"
+const OperationUserLogoutAll = "/api.v1.User/LogoutAll"
+const OperationUserSendPhoneVerification = "/api.v1.User/SendPhoneVerification"
+const OperationUserVerifyPhoneNumber = "/api.v1.User/VerifyPhoneNumber"
+const OperationUserRequestPasswordReset = "/api.v1.User/RequestPasswordReset"
+const OperationUserVerifyPasswordReset = "/api.v1.User/VerifyPasswordReset"
+const OperationUserResetPassword = "/api.v1.User/ResetPassword"
+const OperationUserChangePassword = "/api.v1.User/ChangePassword"
+const OperationUserEnrollTOTP = "/api.v1.User/EnrollTOTP"
+const OperationUserConfirmTOTP = "/api.v1.User/ConfirmTOTP"
+const OperationUserCompleteMFALogin = "/api.v1.User/CompleteMFALogin"
+const OperationUserListSessions = "/api.v1.User/ListSessions"
+const OperationUserRevokeSession = "/api.v1.User/RevokeSession"
+const OperationUserChangeUserRole = "/api.v1.User/ChangeUserRole"
 
 type UserHTTPServer interface {
+	// CreateUser can be retried safely with an Idempotency-Key header, a retry with the same key and body
+	//  gets the first response back.
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+	// UpdateMyIdentity only changes the fields that are sent, the preferences are left as they are.
+	UpdateMyIdentity(context.Context, *UpdateMyIdentityRequest) (*GetMeResponse, error)
+	// DeleteMe hides the account right away and purges it once the grace period ends,
+	//  logging in before then restores it.
+	DeleteMe(context.Context, *DeleteMeRequest) (*DeleteMeResponse, error)
+	// RequestDataExport archives everything stored about the user, the archive is downloaded
+	//  from download_url with the same bearer token until expires_at.
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
 	CreateUserToken(context.Context, *CreateUserTokenRequest) (*CreateUserTokenResponse, error)
+	RefreshUserToken(context.Context, *RefreshUserTokenRequest) (*CreateUserTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutResponse, error)
+	SendPhoneVerification(context.Context, *SendPhoneVerificationRequest) (*SendPhoneVerificationResponse, error)
+	VerifyPhoneNumber(context.Context, *VerifyPhoneNumberRequest) (*VerifyPhoneNumberResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	VerifyPasswordReset(context.Context, *VerifyPasswordResetRequest) (*VerifyPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	CompleteMFALogin(context.Context, *CompleteMFALoginRequest) (*CreateUserTokenResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// ChangeUserRole is only allowed to admins, the new role applies once the user refreshes their token.
+	ChangeUserRole(context.Context, *ChangeUserRoleRequest) (*ChangeUserRoleResponse, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/v1/users", _User_CreateUser0_HTTP_Handler(srv))
+	r.GET("/api/v1/users/me", _User_GetMe0_HTTP_Handler(srv))
+	r.PATCH("/api/v1/users/me/identity", _User_UpdateMyIdentity0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/users/me", _User_DeleteMe0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/me/exports", _User_RequestDataExport0_HTTP_Handler(srv))
 	r.POST("/api/v1/users/token", _User_CreateUserToken0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/token/refresh", _User_RefreshUserToken0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/logout", _User_Logout0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/logout/all", _User_LogoutAll0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/phone/otp", _User_SendPhoneVerification0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/phone/verify", _User_VerifyPhoneNumber0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/password/forgot", _User_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/password/forgot/verify", _User_VerifyPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/password/reset", _User_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/me/password", _User_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/me/2fa/totp", _User_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/me/2fa/totp/confirm", _User_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/token/mfa", _User_CompleteMFALogin0_HTTP_Handler(srv))
+	r.GET("/api/v1/users/me/sessions", _User_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/users/me/sessions/{id}", _User_RevokeSession0_HTTP_Handler(srv))
+	r.PUT("/api/v1/admin/users/{id}/role", _User_ChangeUserRole0_HTTP_Handler(srv))
 }
 
 func _User_CreateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -55,61 +120,1296 @@ func _User_CreateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _User_GetMe0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetMe)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMe(ctx, req.(*GetMeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UpdateMyIdentity0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMyIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateMyIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMyIdentity(ctx, req.(*UpdateMyIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DeleteMe0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDeleteMe)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMe(ctx, req.(*DeleteMeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteMeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RequestDataExport0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestDataExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRequestDataExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestDataExport(ctx, req.(*RequestDataExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestDataExportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_CreateUserToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateUserTokenRequest
+		var in CreateUserTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreateUserToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateUserToken(ctx, req.(*CreateUserTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateUserTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RefreshUserToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshUserTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRefreshUserToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshUserToken(ctx, req.(*RefreshUserTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateUserTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Logout0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_LogoutAll0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutAllRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutAllRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SendPhoneVerification0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendPhoneVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSendPhoneVerification)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendPhoneVerification(ctx, req.(*SendPhoneVerificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendPhoneVerificationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_VerifyPhoneNumber0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyPhoneNumberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserVerifyPhoneNumber)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyPhoneNumber(ctx, req.(*VerifyPhoneNumberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyPhoneNumberResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RequestPasswordReset0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_VerifyPasswordReset0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserVerifyPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyPasswordReset(ctx, req.(*VerifyPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyPasswordResetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ResetPassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ChangePassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_EnrollTOTP0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ConfirmTOTP0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CompleteMFALogin0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteMFALoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCompleteMFALogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteMFALogin(ctx, req.(*CompleteMFALoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateUserTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListSessions0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokeSession0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ChangeUserRole0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeUserRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserChangeUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeUserRole(ctx, req.(*ChangeUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeUserRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserHTTPClient interface {
+	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserResponse, err error)
+	GetMe(ctx context.Context, req *GetMeRequest, opts ...http.CallOption) (rsp *GetMeResponse, err error)
+	UpdateMyIdentity(ctx context.Context, req *UpdateMyIdentityRequest, opts ...http.CallOption) (rsp *GetMeResponse, err error)
+	DeleteMe(ctx context.Context, req *DeleteMeRequest, opts ...http.CallOption) (rsp *DeleteMeResponse, err error)
+	RequestDataExport(ctx context.Context, req *RequestDataExportRequest, opts ...http.CallOption) (rsp *RequestDataExportResponse, err error)
+	CreateUserToken(ctx context.Context, req *CreateUserTokenRequest, opts ...http.CallOption) (rsp *CreateUserTokenResponse, err error)
+	RefreshUserToken(ctx context.Context, req *RefreshUserTokenRequest, opts ...http.CallOption) (rsp *CreateUserTokenResponse, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
+	LogoutAll(ctx context.Context, req *LogoutAllRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
+	SendPhoneVerification(ctx context.Context, req *SendPhoneVerificationRequest, opts ...http.CallOption) (rsp *SendPhoneVerificationResponse, err error)
+	VerifyPhoneNumber(ctx context.Context, req *VerifyPhoneNumberRequest, opts ...http.CallOption) (rsp *VerifyPhoneNumberResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetResponse, err error)
+	VerifyPasswordReset(ctx context.Context, req *VerifyPasswordResetRequest, opts ...http.CallOption) (rsp *VerifyPasswordResetResponse, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordResponse, err error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordResponse, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPResponse, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest, opts ...http.CallOption) (rsp *ConfirmTOTPResponse, err error)
+	CompleteMFALogin(ctx context.Context, req *CompleteMFALoginRequest, opts ...http.CallOption) (rsp *CreateUserTokenResponse, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsResponse, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *RevokeSessionResponse, err error)
+	ChangeUserRole(ctx context.Context, req *ChangeUserRoleRequest, opts ...http.CallOption) (rsp *ChangeUserRoleResponse, err error)
+}
+
+type UserHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewUserHTTPClient(client *http.Client) UserHTTPClient {
+	return &UserHTTPClientImpl{client}
+}
+
+func (c *UserHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserResponse, error) {
+	var out CreateUserResponse
+	pattern := "/api/v1/users"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) GetMe(ctx context.Context, in *GetMeRequest, opts ...http.CallOption) (*GetMeResponse, error) {
+	var out GetMeResponse
+	pattern := "/api/v1/users/me"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetMe))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) UpdateMyIdentity(ctx context.Context, in *UpdateMyIdentityRequest, opts ...http.CallOption) (*GetMeResponse, error) {
+	var out GetMeResponse
+	pattern := "/api/v1/users/me/identity"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUpdateMyIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) DeleteMe(ctx context.Context, in *DeleteMeRequest, opts ...http.CallOption) (*DeleteMeResponse, error) {
+	var out DeleteMeResponse
+	pattern := "/api/v1/users/me"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserDeleteMe))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...http.CallOption) (*RequestDataExportResponse, error) {
+	var out RequestDataExportResponse
+	pattern := "/api/v1/users/me/exports"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRequestDataExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CreateUserToken(ctx context.Context, in *CreateUserTokenRequest, opts ...http.CallOption) (*CreateUserTokenResponse, error) {
+	var out CreateUserTokenResponse
+	pattern := "/api/v1/users/token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateUserToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RefreshUserToken(ctx context.Context, in *RefreshUserTokenRequest, opts ...http.CallOption) (*CreateUserTokenResponse, error) {
+	var out CreateUserTokenResponse
+	pattern := "/api/v1/users/token/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRefreshUserToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutResponse, error) {
+	var out LogoutResponse
+	pattern := "/api/v1/users/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...http.CallOption) (*LogoutResponse, error) {
+	var out LogoutResponse
+	pattern := "/api/v1/users/logout/all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) SendPhoneVerification(ctx context.Context, in *SendPhoneVerificationRequest, opts ...http.CallOption) (*SendPhoneVerificationResponse, error) {
+	var out SendPhoneVerificationResponse
+	pattern := "/api/v1/users/phone/otp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSendPhoneVerification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) VerifyPhoneNumber(ctx context.Context, in *VerifyPhoneNumberRequest, opts ...http.CallOption) (*VerifyPhoneNumberResponse, error) {
+	var out VerifyPhoneNumberResponse
+	pattern := "/api/v1/users/phone/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserVerifyPhoneNumber))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetResponse, error) {
+	var out RequestPasswordResetResponse
+	pattern := "/api/v1/users/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) VerifyPasswordReset(ctx context.Context, in *VerifyPasswordResetRequest, opts ...http.CallOption) (*VerifyPasswordResetResponse, error) {
+	var out VerifyPasswordResetResponse
+	pattern := "/api/v1/users/password/forgot/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserVerifyPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordResponse, error) {
+	var out ResetPasswordResponse
+	pattern := "/api/v1/users/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordResponse, error) {
+	var out ChangePasswordResponse
+	pattern := "/api/v1/users/me/password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPResponse, error) {
+	var out EnrollTOTPResponse
+	pattern := "/api/v1/users/me/2fa/totp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...http.CallOption) (*ConfirmTOTPResponse, error) {
+	var out ConfirmTOTPResponse
+	pattern := "/api/v1/users/me/2fa/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CompleteMFALogin(ctx context.Context, in *CompleteMFALoginRequest, opts ...http.CallOption) (*CreateUserTokenResponse, error) {
+	var out CreateUserTokenResponse
+	pattern := "/api/v1/users/token/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCompleteMFALogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsResponse, error) {
+	var out ListSessionsResponse
+	pattern := "/api/v1/users/me/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*RevokeSessionResponse, error) {
+	var out RevokeSessionResponse
+	pattern := "/api/v1/users/me/sessions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) ChangeUserRole(ctx context.Context, in *ChangeUserRoleRequest, opts ...http.CallOption) (*ChangeUserRoleResponse, error) {
+	var out ChangeUserRoleResponse
+	pattern := "/api/v1/admin/users/{id}/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserChangeUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationProfileGetMyProfile = "/api.v1.Profile/GetMyProfile"
+const OperationProfileUpdateMyProfile = "/api.v1.Profile/UpdateMyProfile"
+
+type ProfileHTTPServer interface {
+	// GetMyProfile returns the profile of the authenticated user, 404 until it is filled in.
+	GetMyProfile(context.Context, *GetMyProfileRequest) (*ProfileResponse, error)
+	// UpdateMyProfile only changes the fields that are sent, birthdate is required to create the profile.
+	UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*ProfileResponse, error)
+}
+
+func RegisterProfileHTTPServer(s *http.Server, srv ProfileHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/users/me/profile", _Profile_GetMyProfile0_HTTP_Handler(srv))
+	r.PATCH("/api/v1/users/me/profile", _Profile_UpdateMyProfile0_HTTP_Handler(srv))
+}
+
+func _Profile_GetMyProfile0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyProfileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileGetMyProfile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyProfile(ctx, req.(*GetMyProfileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_UpdateMyProfile0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMyProfileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileUpdateMyProfile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMyProfile(ctx, req.(*UpdateMyProfileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ProfileHTTPClient interface {
+	GetMyProfile(ctx context.Context, req *GetMyProfileRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	UpdateMyProfile(ctx context.Context, req *UpdateMyProfileRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+}
+
+type ProfileHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewProfileHTTPClient(client *http.Client) ProfileHTTPClient {
+	return &ProfileHTTPClientImpl{client}
+}
+
+func (c *ProfileHTTPClientImpl) GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/v1/users/me/profile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileGetMyProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/v1/users/me/profile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProfileUpdateMyProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationPreferencesGetMyPreferences = "/api.v1.Preferences/GetMyPreferences"
+const OperationPreferencesUpdateMyPreferences = "/api.v1.Preferences/UpdateMyPreferences"
+
+type PreferencesHTTPServer interface {
+	// GetMyPreferences returns who the authenticated user wants to see, every user has preferences from signup on.
+	GetMyPreferences(context.Context, *GetMyPreferencesRequest) (*PreferencesResponse, error)
+	// UpdateMyPreferences only changes the fields that are sent, an empty interested_in keeps the current genders.
+	UpdateMyPreferences(context.Context, *UpdateMyPreferencesRequest) (*PreferencesResponse, error)
+}
+
+func RegisterPreferencesHTTPServer(s *http.Server, srv PreferencesHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/users/me/preferences", _Preferences_GetMyPreferences0_HTTP_Handler(srv))
+	r.PATCH("/api/v1/users/me/preferences", _Preferences_UpdateMyPreferences0_HTTP_Handler(srv))
+}
+
+func _Preferences_GetMyPreferences0_HTTP_Handler(srv PreferencesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyPreferencesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPreferencesGetMyPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyPreferences(ctx, req.(*GetMyPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Preferences_UpdateMyPreferences0_HTTP_Handler(srv PreferencesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMyPreferencesRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCreateUserToken)
+		http.SetOperation(ctx, OperationPreferencesUpdateMyPreferences)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateUserToken(ctx, req.(*CreateUserTokenRequest))
+			return srv.UpdateMyPreferences(ctx, req.(*UpdateMyPreferencesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateUserTokenResponse)
+		reply := out.(*PreferencesResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type UserHTTPClient interface {
-	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserResponse, err error)
-	CreateUserToken(ctx context.Context, req *CreateUserTokenRequest, opts ...http.CallOption) (rsp *CreateUserTokenResponse, err error)
+type PreferencesHTTPClient interface {
+	GetMyPreferences(ctx context.Context, req *GetMyPreferencesRequest, opts ...http.CallOption) (rsp *PreferencesResponse, err error)
+	UpdateMyPreferences(ctx context.Context, req *UpdateMyPreferencesRequest, opts ...http.CallOption) (rsp *PreferencesResponse, err error)
 }
 
-type UserHTTPClientImpl struct {
+type PreferencesHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewUserHTTPClient(client *http.Client) UserHTTPClient {
-	return &UserHTTPClientImpl{client}
+func NewPreferencesHTTPClient(client *http.Client) PreferencesHTTPClient {
+	return &PreferencesHTTPClientImpl{client}
 }
 
-func (c *UserHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserResponse, error) {
-	var out CreateUserResponse
-	pattern := "/api/v1/users"
+func (c *PreferencesHTTPClientImpl) GetMyPreferences(ctx context.Context, in *GetMyPreferencesRequest, opts ...http.CallOption) (*PreferencesResponse, error) {
+	var out PreferencesResponse
+	pattern := "/api/v1/users/me/preferences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPreferencesGetMyPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *PreferencesHTTPClientImpl) UpdateMyPreferences(ctx context.Context, in *UpdateMyPreferencesRequest, opts ...http.CallOption) (*PreferencesResponse, error) {
+	var out PreferencesResponse
+	pattern := "/api/v1/users/me/preferences"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCreateUser))
+	opts = append(opts, http.Operation(OperationPreferencesUpdateMyPreferences))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *UserHTTPClientImpl) CreateUserToken(ctx context.Context, in *CreateUserTokenRequest, opts ...http.CallOption) (*CreateUserTokenResponse, error) {
-	var out CreateUserTokenResponse
-	pattern := "/api/v1/users/token"
+const OperationLocationGetMyLocation = "/api.v1.Location/GetMyLocation"
+const OperationLocationUpdateMyLocation = "/api.v1.Location/UpdateMyLocation"
+
+type LocationHTTPServer interface {
+	// GetMyLocation returns the last location shared by the authenticated user, 404 until one is shared.
+	GetMyLocation(context.Context, *GetMyLocationRequest) (*LocationResponse, error)
+	// UpdateMyLocation replaces the location used to show nearby people.
+	UpdateMyLocation(context.Context, *UpdateMyLocationRequest) (*LocationResponse, error)
+}
+
+func RegisterLocationHTTPServer(s *http.Server, srv LocationHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/users/me/location", _Location_GetMyLocation0_HTTP_Handler(srv))
+	r.PUT("/api/v1/users/me/location", _Location_UpdateMyLocation0_HTTP_Handler(srv))
+}
+
+func _Location_GetMyLocation0_HTTP_Handler(srv LocationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyLocationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLocationGetMyLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyLocation(ctx, req.(*GetMyLocationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Location_UpdateMyLocation0_HTTP_Handler(srv LocationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMyLocationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLocationUpdateMyLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMyLocation(ctx, req.(*UpdateMyLocationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type LocationHTTPClient interface {
+	GetMyLocation(ctx context.Context, req *GetMyLocationRequest, opts ...http.CallOption) (rsp *LocationResponse, err error)
+	UpdateMyLocation(ctx context.Context, req *UpdateMyLocationRequest, opts ...http.CallOption) (rsp *LocationResponse, err error)
+}
+
+type LocationHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewLocationHTTPClient(client *http.Client) LocationHTTPClient {
+	return &LocationHTTPClientImpl{client}
+}
+
+func (c *LocationHTTPClientImpl) GetMyLocation(ctx context.Context, in *GetMyLocationRequest, opts ...http.CallOption) (*LocationResponse, error) {
+	var out LocationResponse
+	pattern := "/api/v1/users/me/location"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLocationGetMyLocation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *LocationHTTPClientImpl) UpdateMyLocation(ctx context.Context, in *UpdateMyLocationRequest, opts ...http.CallOption) (*LocationResponse, error) {
+	var out LocationResponse
+	pattern := "/api/v1/users/me/location"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCreateUserToken))
+	opts = append(opts, http.Operation(OperationLocationUpdateMyLocation))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationPhotoListMyPhotos = "/api.v1.Photo/ListMyPhotos"
+const OperationPhotoDeletePhoto = "/api.v1.Photo/DeletePhoto"
+const OperationPhotoReorderPhotos = "/api.v1.Photo/ReorderPhotos"
+
+type PhotoHTTPServer interface {
+	// ListMyPhotos returns the photos in their order, the first one is the primary photo.
+	ListMyPhotos(context.Context, *ListMyPhotosRequest) (*ListPhotosResponse, error)
+	// DeletePhoto moves the following photos up, the next one becomes primary when the primary photo is deleted.
+	DeletePhoto(context.Context, *DeletePhotoRequest) (*DeletePhotoResponse, error)
+	// ReorderPhotos takes every photo id in the new order, the first one becomes primary.
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*ListPhotosResponse, error)
+}
+
+func RegisterPhotoHTTPServer(s *http.Server, srv PhotoHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/users/me/photos", _Photo_ListMyPhotos0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/users/me/photos/{id}", _Photo_DeletePhoto0_HTTP_Handler(srv))
+	r.PUT("/api/v1/users/me/photos/order", _Photo_ReorderPhotos0_HTTP_Handler(srv))
+}
+
+func _Photo_ListMyPhotos0_HTTP_Handler(srv PhotoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyPhotosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPhotoListMyPhotos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyPhotos(ctx, req.(*ListMyPhotosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPhotosResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Photo_DeletePhoto0_HTTP_Handler(srv PhotoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePhotoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPhotoDeletePhoto)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePhoto(ctx, req.(*DeletePhotoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePhotoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Photo_ReorderPhotos0_HTTP_Handler(srv PhotoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderPhotosRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPhotoReorderPhotos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPhotosResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PhotoHTTPClient interface {
+	ListMyPhotos(ctx context.Context, req *ListMyPhotosRequest, opts ...http.CallOption) (rsp *ListPhotosResponse, err error)
+	DeletePhoto(ctx context.Context, req *DeletePhotoRequest, opts ...http.CallOption) (rsp *DeletePhotoResponse, err error)
+	ReorderPhotos(ctx context.Context, req *ReorderPhotosRequest, opts ...http.CallOption) (rsp *ListPhotosResponse, err error)
+}
+
+type PhotoHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewPhotoHTTPClient(client *http.Client) PhotoHTTPClient {
+	return &PhotoHTTPClientImpl{client}
+}
+
+func (c *PhotoHTTPClientImpl) ListMyPhotos(ctx context.Context, in *ListMyPhotosRequest, opts ...http.CallOption) (*ListPhotosResponse, error) {
+	var out ListPhotosResponse
+	pattern := "/api/v1/users/me/photos"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPhotoListMyPhotos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *PhotoHTTPClientImpl) DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...http.CallOption) (*DeletePhotoResponse, error) {
+	var out DeletePhotoResponse
+	pattern := "/api/v1/users/me/photos/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPhotoDeletePhoto))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *PhotoHTTPClientImpl) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...http.CallOption) (*ListPhotosResponse, error) {
+	var out ListPhotosResponse
+	pattern := "/api/v1/users/me/photos/order"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPhotoReorderPhotos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationInterestListInterests = "/api.v1.Interest/ListInterests"
+const OperationInterestListMyInterests = "/api.v1.Interest/ListMyInterests"
+const OperationInterestSetMyInterests = "/api.v1.Interest/SetMyInterests"
+
+type InterestHTTPServer interface {
+	// ListInterests returns the whole catalog ordered by category and name.
+	ListInterests(context.Context, *ListInterestsRequest) (*ListInterestsResponse, error)
+	// ListMyInterests returns the interests picked by the authenticated user.
+	ListMyInterests(context.Context, *ListMyInterestsRequest) (*ListInterestsResponse, error)
+	// SetMyInterests replaces every interest of the authenticated user, an empty list removes them all.
+	SetMyInterests(context.Context, *SetMyInterestsRequest) (*ListInterestsResponse, error)
+}
+
+func RegisterInterestHTTPServer(s *http.Server, srv InterestHTTPServer) {
+	r := s.Route("/")
+	r.GET("/api/v1/interests", _Interest_ListInterests0_HTTP_Handler(srv))
+	r.GET("/api/v1/users/me/interests", _Interest_ListMyInterests0_HTTP_Handler(srv))
+	r.PUT("/api/v1/users/me/interests", _Interest_SetMyInterests0_HTTP_Handler(srv))
+}
+
+func _Interest_ListInterests0_HTTP_Handler(srv InterestHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListInterestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInterestListInterests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInterests(ctx, req.(*ListInterestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInterestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Interest_ListMyInterests0_HTTP_Handler(srv InterestHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyInterestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInterestListMyInterests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyInterests(ctx, req.(*ListMyInterestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInterestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Interest_SetMyInterests0_HTTP_Handler(srv InterestHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMyInterestsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInterestSetMyInterests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMyInterests(ctx, req.(*SetMyInterestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInterestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type InterestHTTPClient interface {
+	ListInterests(ctx context.Context, req *ListInterestsRequest, opts ...http.CallOption) (rsp *ListInterestsResponse, err error)
+	ListMyInterests(ctx context.Context, req *ListMyInterestsRequest, opts ...http.CallOption) (rsp *ListInterestsResponse, err error)
+	SetMyInterests(ctx context.Context, req *SetMyInterestsRequest, opts ...http.CallOption) (rsp *ListInterestsResponse, err error)
+}
+
+type InterestHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewInterestHTTPClient(client *http.Client) InterestHTTPClient {
+	return &InterestHTTPClientImpl{client}
+}
+
+func (c *InterestHTTPClientImpl) ListInterests(ctx context.Context, in *ListInterestsRequest, opts ...http.CallOption) (*ListInterestsResponse, error) {
+	var out ListInterestsResponse
+	pattern := "/api/v1/interests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInterestListInterests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *InterestHTTPClientImpl) ListMyInterests(ctx context.Context, in *ListMyInterestsRequest, opts ...http.CallOption) (*ListInterestsResponse, error) {
+	var out ListInterestsResponse
+	pattern := "/api/v1/users/me/interests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInterestListMyInterests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *InterestHTTPClientImpl) SetMyInterests(ctx context.Context, in *SetMyInterestsRequest, opts ...http.CallOption) (*ListInterestsResponse, error) {
+	var out ListInterestsResponse
+	pattern := "/api/v1/users/me/interests"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInterestSetMyInterests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
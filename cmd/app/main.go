@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"app/configs"
+	"app/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -24,7 +25,7 @@ var (
 	id, _ = os.Hostname()
 )
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, purgeWorker *server.AccountPurgeWorker) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -33,6 +34,7 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			purgeWorker,
 		),
 	)
 }
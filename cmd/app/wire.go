@@ -11,7 +11,12 @@ import (
 	"app/infra"
 	"app/infra/database"
 	"app/infra/encryption"
+	"app/infra/imaging"
 	tokenprovider "app/infra/token_provider"
+	twofactor "app/infra/two_factor"
+	profiledriven "app/internal/profile/port/driven"
+	profiledriver "app/internal/profile/port/driver"
+	profileusecase "app/internal/profile/usecase"
 	"app/internal/user/entity"
 	"app/internal/user/port/driven"
 	"app/internal/user/port/driver"
@@ -32,11 +37,51 @@ func wireApp(*configs.ApplicationConfig, *configs.DBConfig, log.Logger) (*kratos
 			handler.ProviderSet,
 			newApp,
 			usecase.NewUserWriterUsecase,
-			wire.Bind(new(driven.Encyptor), new(*encryption.BcryptEncryption)),
+			usecase.NewUserReaderUsecase,
+			usecase.NewUserPurgeUsecase,
+			usecase.NewDataExportUsecase,
+			usecase.NewPreferencesUsecase,
+			usecase.NewLocationUsecase,
+			profileusecase.NewProfileUsecase,
+			profileusecase.NewPhotoUsecase,
+			profileusecase.NewInterestUsecase,
+			profileusecase.NewProfileExportUsecase,
+			wire.Bind(new(driven.Encyptor), new(*encryption.Encryption)),
 			wire.Bind(new(driven.UserWriter), new(*database.UserRepository)),
 			wire.Bind(new(driven.UserGetter), new(*database.UserRepository)),
-			wire.Bind(new(driven.TokenProvider[*entity.User]), new(*tokenprovider.UserJwtProvider)),
+			wire.Bind(new(driven.UserPurger), new(*database.UserRepository)),
+			wire.Bind(new(driven.TokenProvider[*entity.AccessTokenSubject]), new(*tokenprovider.UserJwtProvider)),
+			wire.Bind(new(driven.RefreshTokenProvider), new(*tokenprovider.RefreshTokenProvider)),
+			wire.Bind(new(driven.RefreshTokenStore), new(*database.RefreshTokenRepository)),
+			wire.Bind(new(driven.LoginAttemptStore), new(*database.LoginAttemptRepository)),
+			wire.Bind(new(driven.OTPProvider), new(*tokenprovider.OTPProvider)),
+			wire.Bind(new(driven.OTPStore), new(*database.OTPRepository)),
+			wire.Bind(new(driven.PasswordResetTicketProvider), new(*tokenprovider.PasswordResetTicketProvider)),
+			wire.Bind(new(driven.PasswordResetTicketStore), new(*database.PasswordResetTicketRepository)),
+			wire.Bind(new(driven.TwoFactorProvider), new(*twofactor.TOTPProvider)),
+			wire.Bind(new(driven.RecoveryCodeStore), new(*database.RecoveryCodeRepository)),
+			wire.Bind(new(driven.MFAChallengeStore), new(*database.MFAChallengeRepository)),
+			wire.Bind(new(driven.SessionStore), new(*database.SessionRepository)),
+			wire.Bind(new(driven.PasswordHistoryStore), new(*database.PasswordHistoryRepository)),
+			wire.Bind(new(driven.DataExportStore), new(*database.DataExportRepository)),
+			wire.Bind(new(driven.PreferencesStore), new(*database.PreferencesRepository)),
+			wire.Bind(new(driven.LocationStore), new(*database.LocationRepository)),
+			wire.Bind(new(driven.ProfileDataExporter), new(*profileusecase.ProfileExportUsecase)),
+			wire.Bind(new(driven.TokenValidator[*entity.UserClaims]), new(*tokenprovider.UserJwtProvider)),
+			wire.Bind(new(driven.TokenKeySet), new(*tokenprovider.UserJwtProvider)),
 			wire.Bind(new(driver.UserWriterUsecase), new(*usecase.UserWriterUsecase)),
+			wire.Bind(new(driver.UserReaderUsecase), new(*usecase.UserReaderUsecase)),
+			wire.Bind(new(driver.UserPurgeUsecase), new(*usecase.UserPurgeUsecase)),
+			wire.Bind(new(driver.DataExportUsecase), new(*usecase.DataExportUsecase)),
+			wire.Bind(new(driver.PreferencesUsecase), new(*usecase.PreferencesUsecase)),
+			wire.Bind(new(driver.LocationUsecase), new(*usecase.LocationUsecase)),
+			wire.Bind(new(profiledriven.ProfileStore), new(*database.ProfileRepository)),
+			wire.Bind(new(profiledriven.PhotoStore), new(*database.PhotoRepository)),
+			wire.Bind(new(profiledriven.InterestStore), new(*database.InterestRepository)),
+			wire.Bind(new(profiledriven.ImageProcessor), new(*imaging.ImageProcessor)),
+			wire.Bind(new(profiledriver.ProfileUsecase), new(*profileusecase.ProfileUsecase)),
+			wire.Bind(new(profiledriver.PhotoUsecase), new(*profileusecase.PhotoUsecase)),
+			wire.Bind(new(profiledriver.InterestUsecase), new(*profileusecase.InterestUsecase)),
 		),
 	)
 }
@@ -9,9 +9,13 @@ package main
 import (
 	"app/configs"
 	"app/handler/api"
+	"app/infra"
 	"app/infra/database"
 	"app/infra/encryption"
+	"app/infra/imaging"
 	"app/infra/token_provider"
+	"app/infra/two_factor"
+	usecase2 "app/internal/profile/usecase"
 	"app/internal/user/usecase"
 	"app/server"
 	"github.com/go-kratos/kratos/v2"
@@ -28,12 +32,71 @@ import (
 func wireApp(applicationConfig *configs.ApplicationConfig, dbConfig *configs.DBConfig, logger log.Logger) (*kratos.App, func(), error) {
 	postgresDB, cleanup := database.NewPostgresDB(dbConfig, logger)
 	userRepository := database.NewUserRepository(postgresDB)
-	bcryptEncryption := encryption.NewBcryptEncryption()
+	encryptionEncryption := encryption.NewEncryption(applicationConfig)
 	userJwtProvider := tokenprovider.NewUserJwtProvider(applicationConfig)
-	userWriterUsecase := usecase.NewUserWriterUsecase(userRepository, bcryptEncryption, userRepository, userJwtProvider)
-	userApiHandler := api.NewUserApiHandler(userWriterUsecase, logger)
-	httpServer := server.NewHTTPServer(applicationConfig, userApiHandler, logger)
-	app := newApp(logger, httpServer)
+	refreshTokenProvider := tokenprovider.NewRefreshTokenProvider(applicationConfig)
+	refreshTokenRepository := database.NewRefreshTokenRepository(postgresDB)
+	tokenRevocationStore := infra.NewTokenRevocationStore(applicationConfig, postgresDB)
+	loginAttemptRepository := database.NewLoginAttemptRepository(postgresDB)
+	loginThrottle := infra.NewLoginThrottle(applicationConfig)
+	otpProvider, err := tokenprovider.NewOTPProvider(applicationConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	otpRepository := database.NewOTPRepository(postgresDB)
+	smsSender := infra.NewSMSSender(applicationConfig, logger)
+	passwordResetTicketProvider := tokenprovider.NewPasswordResetTicketProvider(applicationConfig)
+	passwordResetTicketRepository := database.NewPasswordResetTicketRepository(postgresDB)
+	totpProvider := twofactor.NewTOTPProvider(applicationConfig)
+	recoveryCodeRepository := database.NewRecoveryCodeRepository(postgresDB)
+	mfaChallengeRepository := database.NewMFAChallengeRepository(postgresDB)
+	sessionRepository := database.NewSessionRepository(postgresDB)
+	passwordHistoryRepository := database.NewPasswordHistoryRepository(postgresDB)
+	userPolicy, err := infra.NewUserPolicy(applicationConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userWriterUsecase := usecase.NewUserWriterUsecase(userRepository, encryptionEncryption, userRepository, userJwtProvider, refreshTokenProvider, refreshTokenRepository, tokenRevocationStore, loginAttemptRepository, loginThrottle, otpProvider, otpRepository, smsSender, passwordResetTicketProvider, passwordResetTicketRepository, totpProvider, recoveryCodeRepository, mfaChallengeRepository, sessionRepository, passwordHistoryRepository, userPolicy)
+	userReaderUsecase := usecase.NewUserReaderUsecase(userRepository)
+	preferencesRepository := database.NewPreferencesRepository(postgresDB)
+	locationRepository := database.NewLocationRepository(postgresDB)
+	profileRepository := database.NewProfileRepository(postgresDB)
+	photoRepository := database.NewPhotoRepository(postgresDB)
+	interestRepository := database.NewInterestRepository(postgresDB)
+	blobStorage := infra.NewBlobStorage(applicationConfig)
+	profileExportUsecase := usecase2.NewProfileExportUsecase(profileRepository, photoRepository, interestRepository, blobStorage)
+	dataExportRepository := database.NewDataExportRepository(postgresDB)
+	fileStorage := infra.NewFileStorage(applicationConfig)
+	dataExportUsecase := usecase.NewDataExportUsecase(userRepository, sessionRepository, preferencesRepository, locationRepository, profileExportUsecase, dataExportRepository, fileStorage, userPolicy)
+	userApiHandler := api.NewUserApiHandler(userWriterUsecase, userReaderUsecase, dataExportUsecase, logger)
+	profileUsecase := usecase2.NewProfileUsecase(profileRepository)
+	profileApiHandler := api.NewProfileApiHandler(profileUsecase, logger)
+	imageProcessor := imaging.NewImageProcessor()
+	photoPolicy := infra.NewPhotoPolicy(applicationConfig)
+	photoUsecase := usecase2.NewPhotoUsecase(photoRepository, blobStorage, imageProcessor, photoPolicy)
+	photoApiHandler := api.NewPhotoApiHandler(photoUsecase, photoPolicy, logger)
+	preferencesUsecase := usecase.NewPreferencesUsecase(userRepository, preferencesRepository, userPolicy)
+	preferencesApiHandler := api.NewPreferencesApiHandler(preferencesUsecase, logger)
+	locationUsecase := usecase.NewLocationUsecase(locationRepository)
+	locationApiHandler := api.NewLocationApiHandler(locationUsecase, logger)
+	interestPolicy := infra.NewInterestPolicy(applicationConfig)
+	interestUsecase := usecase2.NewInterestUsecase(interestRepository, interestPolicy)
+	interestApiHandler := api.NewInterestApiHandler(interestUsecase, logger)
+	rateLimitStore := infra.NewRateLimitStore(applicationConfig, postgresDB)
+	rateLimits := infra.NewRateLimits(applicationConfig)
+	idempotencyStore := infra.NewIdempotencyStore(applicationConfig, postgresDB)
+	idempotencyPolicy := infra.NewIdempotencyPolicy(applicationConfig)
+	trustedProxies, err := infra.NewTrustedProxies(applicationConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer := server.NewHTTPServer(applicationConfig, userApiHandler, profileApiHandler, photoApiHandler, preferencesApiHandler, locationApiHandler, interestApiHandler, userJwtProvider, tokenRevocationStore, userJwtProvider, rateLimitStore, rateLimits, idempotencyStore, idempotencyPolicy, trustedProxies, logger)
+	userPurgeUsecase := usecase.NewUserPurgeUsecase(userRepository, dataExportRepository, fileStorage, blobStorage, userPolicy)
+	accountPurgeWorker := server.NewAccountPurgeWorker(applicationConfig, userPurgeUsecase, logger)
+	app := newApp(logger, httpServer, accountPurgeWorker)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -20,12 +20,32 @@ type ApplicationConfig struct {
 	Server   Server   `mapstructure:"server"`
 	Postgres DBConfig `mapstructure:"postgres"`
 	JWT      JWT      `mapstructure:"jwt"`
+	Password Password `mapstructure:"password"`
+
+	LoginThrottle LoginThrottle `mapstructure:"login_throttle"`
+	RateLimit     RateLimit     `mapstructure:"rate_limit"`
+	Idempotency   Idempotency   `mapstructure:"idempotency"`
+
+	OTP               OTP               `mapstructure:"otp"`
+	SMS               SMS               `mapstructure:"sms"`
+	PhoneVerification PhoneVerification `mapstructure:"phone_verification"`
+	Signup            Signup            `mapstructure:"signup"`
+	PasswordReset     PasswordReset     `mapstructure:"password_reset"`
+	MFA               MFA               `mapstructure:"mfa"`
+	AccountDeletion   AccountDeletion   `mapstructure:"account_deletion"`
+	DataExport        DataExport        `mapstructure:"data_export"`
+	Storage           Storage           `mapstructure:"storage"`
+	Photo             Photo             `mapstructure:"photo"`
+	Interest          Interest          `mapstructure:"interest"`
+	Gender            Gender            `mapstructure:"gender"`
 }
 
 type Server struct {
 	HTTP    ServerConfig `mapstructure:"http"`
 	GRPC    ServerConfig `mapstructure:"grpc"`
 	OpenAPI ServerConfig `mapstructure:"open_api"`
+	// TrustedProxies lists the IPs or CIDRs of the reverse proxies allowed to set X-Forwarded-For and X-Real-IP.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type DBConfig struct {
@@ -42,9 +62,133 @@ type ServerConfig struct {
 }
 
 type JWT struct {
-	PrivateKey    string `mapstructure:"private_key"`
-	PublicKey     string `mapstructure:"public_key"`
+	PrivateKey           string   `mapstructure:"private_key"`
+	PublicKey            string   `mapstructure:"public_key"`
+	ActiveKeyID          string   `mapstructure:"active_key_id"`
+	Keys                 []JWTKey `mapstructure:"keys"`
+	ExpiresSecond        int      `mapstructure:"expires_second"`
+	RefreshExpiresSecond int      `mapstructure:"refresh_expires_second"`
+	RevocationStore      string   `mapstructure:"revocation_store"`
+}
+
+type JWTKey struct {
+	ID         string `mapstructure:"id"`
+	PrivateKey string `mapstructure:"private_key"`
+	PublicKey  string `mapstructure:"public_key"`
+}
+
+type Password struct {
+	Algorithm   string   `mapstructure:"algorithm"`
+	BcryptCost  int      `mapstructure:"bcrypt_cost"`
+	Argon2id    Argon2id `mapstructure:"argon2id"`
+	HistorySize int      `mapstructure:"history_size"`
+}
+
+type Argon2id struct {
+	Memory      uint32 `mapstructure:"memory"`
+	Iterations  uint32 `mapstructure:"iterations"`
+	Parallelism uint8  `mapstructure:"parallelism"`
+}
+
+type LoginThrottle struct {
+	WindowSecond int                 `mapstructure:"window_second"`
+	Username     LoginThrottlePolicy `mapstructure:"username"`
+	ClientIP     LoginThrottlePolicy `mapstructure:"client_ip"`
+}
+
+type LoginThrottlePolicy struct {
+	FreeAttempts     int `mapstructure:"free_attempts"`
+	BaseDelaySecond  int `mapstructure:"base_delay_second"`
+	LockoutThreshold int `mapstructure:"lockout_threshold"`
+	LockoutSecond    int `mapstructure:"lockout_second"`
+}
+
+type RateLimit struct {
+	Store      string               `mapstructure:"store"`
+	Default    RateLimitPolicy      `mapstructure:"default"`
+	Operations []OperationRateLimit `mapstructure:"operations"`
+}
+
+// OperationRateLimit is a list entry rather than a map key because viper splits keys on dots.
+type OperationRateLimit struct {
+	Operation       string `mapstructure:"operation"`
+	RateLimitPolicy `mapstructure:",squash"`
+}
+
+type RateLimitPolicy struct {
+	PerIP   RateLimitRule `mapstructure:"per_ip"`
+	PerUser RateLimitRule `mapstructure:"per_user"`
+}
+
+type RateLimitRule struct {
+	Requests     int `mapstructure:"requests"`
+	PeriodSecond int `mapstructure:"period_second"`
+}
+
+type Idempotency struct {
+	Store             string `mapstructure:"store"`
+	TTLSecond         int    `mapstructure:"ttl_second"`
+	LockTimeoutSecond int    `mapstructure:"lock_timeout_second"`
+	// Operations lists the operations honouring Idempotency-Key, the key is ignored on any other.
+	Operations []string `mapstructure:"operations"`
+}
+
+type OTP struct {
+	Length        int    `mapstructure:"length"`
 	ExpiresSecond int    `mapstructure:"expires_second"`
+	MaxAttempts   int    `mapstructure:"max_attempts"`
+	Secret        string `mapstructure:"secret"`
+}
+
+type SMS struct {
+	Driver   string `mapstructure:"driver"`
+	FilePath string `mapstructure:"file_path"`
+}
+
+type PhoneVerification struct {
+	Required bool `mapstructure:"required"`
+}
+
+type Signup struct {
+	ConcealExistingIdentifiers bool `mapstructure:"conceal_existing_identifiers"`
+}
+
+type PasswordReset struct {
+	TicketExpiresSecond int `mapstructure:"ticket_expires_second"`
+}
+
+type MFA struct {
+	Issuer                 string `mapstructure:"issuer"`
+	RecoveryCodeCount      int    `mapstructure:"recovery_code_count"`
+	ChallengeExpiresSecond int    `mapstructure:"challenge_expires_second"`
+	MaxAttempts            int    `mapstructure:"max_attempts"`
+}
+
+type AccountDeletion struct {
+	GracePeriodSecond   int `mapstructure:"grace_period_second"`
+	PurgeIntervalSecond int `mapstructure:"purge_interval_second"`
+}
+
+type DataExport struct {
+	ExpiresSecond int `mapstructure:"expires_second"`
+}
+
+type Storage struct {
+	Driver    string `mapstructure:"driver"`
+	LocalRoot string `mapstructure:"local_root"`
+}
+
+type Photo struct {
+	MaxPhotos      int   `mapstructure:"max_photos"`
+	MaxUploadBytes int64 `mapstructure:"max_upload_bytes"`
+}
+
+type Interest struct {
+	MaxPerUser int `mapstructure:"max_per_user"`
+}
+
+type Gender struct {
+	Identities []string `mapstructure:"identities"`
 }
 
 var basepath string
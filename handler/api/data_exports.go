@@ -0,0 +1,61 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OperationUserDownloadDataExport is the operation of the download route, the archive is streamed
+// as is so it is registered by hand instead of through the proto service, see DownloadDataExport.
+const OperationUserDownloadDataExport = "/api.v1.User/DownloadDataExport"
+
+// DataExportDownloadPath is the route of DownloadDataExport.
+const DataExportDownloadPath = "/api/v1/users/me/exports/{id}/download"
+
+func (h UserApiHandler) RequestDataExport(ctx context.Context, _ *v1.RequestDataExportRequest) (*v1.RequestDataExportResponse, error) {
+	export, err := h.dataExport.RequestDataExport(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.RequestDataExportResponse{
+		Id:          export.ID,
+		DownloadUrl: strings.Replace(DataExportDownloadPath, "{id}", export.ID, 1),
+		ExpiresAt:   timestamppb.New(export.ExpiresAt),
+	}, nil
+}
+
+// DownloadDataExport streams the ZIP archive of a data export. It runs through the server
+// middlewares like the generated handlers so only the owner's bearer token is accepted.
+func (h UserApiHandler) DownloadDataExport(ctx http.Context) error {
+	http.SetOperation(ctx, OperationUserDownloadDataExport)
+	handler := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return h.dataExport.DownloadDataExport(ctx, req.(*request.DownloadDataExport))
+	})
+	out, err := handler(ctx, &request.DownloadDataExport{ExportID: ctx.Vars().Get("id")})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return err
+	}
+
+	file := out.(*response.DataExportFile)
+	defer file.Content.Close()
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(nethttp.StatusOK)
+	_, err = io.Copy(w, file.Content)
+	return err
+}
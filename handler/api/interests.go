@@ -0,0 +1,69 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type InterestApiHandler struct {
+	v1.UnimplementedInterestServer
+
+	interest driver.InterestUsecase
+	log      log.Logger
+}
+
+func NewInterestApiHandler(interest driver.InterestUsecase, log log.Logger) *InterestApiHandler {
+	return &InterestApiHandler{
+		interest: interest,
+		log:      log,
+	}
+}
+
+func (h InterestApiHandler) ListInterests(ctx context.Context, _ *v1.ListInterestsRequest) (*v1.ListInterestsResponse, error) {
+	interests, err := h.interest.ListInterests(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toListInterestsResponse(interests), nil
+}
+
+func (h InterestApiHandler) ListMyInterests(ctx context.Context, _ *v1.ListMyInterestsRequest) (*v1.ListInterestsResponse, error) {
+	interests, err := h.interest.ListMyInterests(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toListInterestsResponse(interests), nil
+}
+
+func (h InterestApiHandler) SetMyInterests(ctx context.Context, params *v1.SetMyInterestsRequest) (*v1.ListInterestsResponse, error) {
+	interests, err := h.interest.SetMyInterests(ctx, &request.SetInterests{
+		InterestIDs: params.InterestIds,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toListInterestsResponse(interests), nil
+}
+
+func toListInterestsResponse(interests []*response.Interest) *v1.ListInterestsResponse {
+	result := &v1.ListInterestsResponse{
+		Interests: make([]*v1.InterestResponse, 0, len(interests)),
+	}
+	for _, interest := range interests {
+		result.Interests = append(result.Interests, &v1.InterestResponse{
+			Id:       interest.ID,
+			Slug:     interest.Slug,
+			Name:     interest.Name,
+			Category: interest.Category,
+		})
+	}
+	return result
+}
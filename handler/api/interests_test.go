@@ -0,0 +1,114 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/tests/fake"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterestApiHandler_ListInterests(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when list interests error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("list_interests_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when list interests success, it should return the catalog",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewInterestApiHandler(new(fake.FakeInterestUsecase), log.DefaultLogger)
+			got, err := h.ListInterests(tt.ctx, &v1.ListInterestsRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Len(got.Interests, 2)
+				assert.Equal("coffee", got.Interests[0].Slug)
+				assert.Equal("Food & Drink", got.Interests[0].Category)
+			}
+		})
+	}
+}
+
+func TestInterestApiHandler_ListMyInterests(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when list my interests error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("list_my_interests_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when list my interests success, it should return the interests",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewInterestApiHandler(new(fake.FakeInterestUsecase), log.DefaultLogger)
+			got, err := h.ListMyInterests(tt.ctx, &v1.ListMyInterestsRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Len(got.Interests, 1)
+				assert.Equal(int64(1), got.Interests[0].Id)
+			}
+		})
+	}
+}
+
+func TestInterestApiHandler_SetMyInterests(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.SetMyInterestsRequest
+		wantIDs []int64
+		wantErr bool
+	}{
+		{
+			name:    "when set interests error, it should return error",
+			params:  &v1.SetMyInterestsRequest{InterestIds: []int64{1, 123}},
+			wantErr: true,
+		},
+		{
+			name:    "when interests are removed, it should return an empty list",
+			params:  &v1.SetMyInterestsRequest{},
+			wantIDs: []int64{},
+		},
+		{
+			name:    "when set interests success, it should return the interests",
+			params:  &v1.SetMyInterestsRequest{InterestIds: []int64{1, 12}},
+			wantIDs: []int64{1, 12},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewInterestApiHandler(new(fake.FakeInterestUsecase), log.DefaultLogger)
+			got, err := h.SetMyInterests(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				gotIDs := []int64{}
+				for _, interest := range got.Interests {
+					gotIDs = append(gotIDs, interest.Id)
+				}
+				assert.Equal(tt.wantIDs, gotIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driver"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type LocationApiHandler struct {
+	v1.UnimplementedLocationServer
+
+	location driver.LocationUsecase
+	log      log.Logger
+}
+
+func NewLocationApiHandler(location driver.LocationUsecase, log log.Logger) *LocationApiHandler {
+	return &LocationApiHandler{
+		location: location,
+		log:      log,
+	}
+}
+
+func (h LocationApiHandler) GetMyLocation(ctx context.Context, _ *v1.GetMyLocationRequest) (*v1.LocationResponse, error) {
+	location, err := h.location.GetMyLocation(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toLocationResponse(location), nil
+}
+
+func (h LocationApiHandler) UpdateMyLocation(ctx context.Context, params *v1.UpdateMyLocationRequest) (*v1.LocationResponse, error) {
+	location, err := h.location.UpdateMyLocation(ctx, &request.UpdateLocation{
+		Latitude:  params.Latitude,
+		Longitude: params.Longitude,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toLocationResponse(location), nil
+}
+
+func toLocationResponse(location *response.Location) *v1.LocationResponse {
+	return &v1.LocationResponse{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		UpdatedAt: timestamppb.New(location.UpdatedAt),
+	}
+}
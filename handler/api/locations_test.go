@@ -0,0 +1,74 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/tests/fake"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationApiHandler_GetMyLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when get location error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("get_location_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when get location success, it should return the location",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewLocationApiHandler(new(fake.FakeLocationUsecase), log.DefaultLogger)
+			got, err := h.GetMyLocation(tt.ctx, &v1.GetMyLocationRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(-6.2, got.Latitude)
+				assert.Equal(106.8, got.Longitude)
+				assert.NotNil(got.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestLocationApiHandler_UpdateMyLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.UpdateMyLocationRequest
+		wantErr bool
+	}{
+		{
+			name:    "when update location error, it should return error",
+			params:  &v1.UpdateMyLocationRequest{Latitude: 91, Longitude: 10},
+			wantErr: true,
+		},
+		{
+			name:    "when update location success, it should return the location",
+			params:  &v1.UpdateMyLocationRequest{Latitude: 57.64911, Longitude: 10.40744},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewLocationApiHandler(new(fake.FakeLocationUsecase), log.DefaultLogger)
+			got, err := h.UpdateMyLocation(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.params.Latitude, got.Latitude)
+				assert.Equal(tt.params.Longitude, got.Longitude)
+			}
+		})
+	}
+}
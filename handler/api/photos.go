@@ -0,0 +1,203 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OperationPhotoUploadPhoto and OperationPhotoOpenPhoto are the operations of the routes registered
+// by hand since the image is sent and served as is, see UploadPhoto and OpenPhoto.
+const (
+	OperationPhotoUploadPhoto = "/api.v1.Photo/UploadPhoto"
+	OperationPhotoOpenPhoto   = "/api.v1.Photo/OpenPhoto"
+)
+
+const (
+	// PhotoUploadPath is the route of UploadPhoto.
+	PhotoUploadPath = "/api/v1/users/me/photos"
+	// PhotoFilePath is the route of OpenPhoto.
+	PhotoFilePath = "/api/v1/photos/{id}/{variant}"
+
+	// photoFormField is the multipart field holding the uploaded image.
+	photoFormField = "photo"
+	// multipartOverhead is allowed on top of the image for the boundaries and part headers.
+	multipartOverhead = 64 << 10
+)
+
+// photoUploadRequest carries the unread upload through the middlewares, String keeps the
+// logging middleware from printing the whole request.
+type photoUploadRequest struct {
+	*nethttp.Request
+}
+
+func (photoUploadRequest) String() string {
+	return "photo upload"
+}
+
+type PhotoApiHandler struct {
+	v1.UnimplementedPhotoServer
+
+	photo       driver.PhotoUsecase
+	photoPolicy *entity.PhotoPolicy
+	log         log.Logger
+}
+
+func NewPhotoApiHandler(photo driver.PhotoUsecase, photoPolicy *entity.PhotoPolicy, log log.Logger) *PhotoApiHandler {
+	return &PhotoApiHandler{
+		photo:       photo,
+		photoPolicy: photoPolicy,
+		log:         log,
+	}
+}
+
+func (h PhotoApiHandler) ListMyPhotos(ctx context.Context, _ *v1.ListMyPhotosRequest) (*v1.ListPhotosResponse, error) {
+	photos, err := h.photo.ListMyPhotos(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toListPhotosResponse(photos), nil
+}
+
+func (h PhotoApiHandler) DeletePhoto(ctx context.Context, params *v1.DeletePhotoRequest) (*v1.DeletePhotoResponse, error) {
+	err := h.photo.DeletePhoto(ctx, &request.DeletePhoto{PhotoID: params.Id})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.DeletePhotoResponse{}, nil
+}
+
+func (h PhotoApiHandler) ReorderPhotos(ctx context.Context, params *v1.ReorderPhotosRequest) (*v1.ListPhotosResponse, error) {
+	photos, err := h.photo.ReorderPhotos(ctx, &request.ReorderPhotos{PhotoIDs: params.PhotoIds})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toListPhotosResponse(photos), nil
+}
+
+// UploadPhoto takes the image from the multipart field "photo". The body is only read once the server
+// middlewares let the request through, and the content type is sniffed rather than trusted from the client.
+func (h PhotoApiHandler) UploadPhoto(ctx http.Context) error {
+	http.SetOperation(ctx, OperationPhotoUploadPhoto)
+	handler := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		upload, err := h.readUpload(req.(*photoUploadRequest).Request)
+		if err != nil {
+			return nil, err
+		}
+		return h.photo.UploadPhoto(ctx, upload)
+	})
+
+	req := ctx.Request()
+	req.Body = nethttp.MaxBytesReader(ctx.Response(), req.Body, h.photoPolicy.MaxUploadBytes+multipartOverhead)
+	out, err := handler(ctx, &photoUploadRequest{Request: req})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return err
+	}
+	return ctx.Result(nethttp.StatusOK, toPhotoResponse(out.(*response.Photo)))
+}
+
+// OpenPhoto serves a variant of a photo to any authenticated user.
+func (h PhotoApiHandler) OpenPhoto(ctx http.Context) error {
+	http.SetOperation(ctx, OperationPhotoOpenPhoto)
+	handler := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return h.photo.OpenPhoto(ctx, req.(*request.OpenPhoto))
+	})
+	out, err := handler(ctx, &request.OpenPhoto{PhotoID: ctx.Vars().Get("id"), Variant: ctx.Vars().Get("variant")})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return err
+	}
+
+	file := out.(*response.PhotoFile)
+	defer file.Content.Close()
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", file.ContentType)
+	// a photo never changes once uploaded, a new upload gets a new id
+	w.Header().Set("Cache-Control", "private, max-age=86400, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(nethttp.StatusOK)
+	_, err = io.Copy(w, file.Content)
+	return err
+}
+
+// readUpload reads at most one byte more than the upload limit so the usecase can tell an upload is too large.
+func (h PhotoApiHandler) readUpload(req *nethttp.Request) (*request.UploadPhoto, error) {
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, customerror.NewValidationErrorWithMessage(photoFormField, "must be sent as multipart/form-data")
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, customerror.NewValidationErrorWithMessage(photoFormField, "is required")
+		}
+		if err != nil {
+			return nil, h.uploadError(err)
+		}
+		if part.FormName() != photoFormField {
+			continue
+		}
+
+		content, err := io.ReadAll(io.LimitReader(part, h.photoPolicy.MaxUploadBytes+1))
+		if err != nil {
+			return nil, h.uploadError(err)
+		}
+		return &request.UploadPhoto{
+			Content:     content,
+			ContentType: nethttp.DetectContentType(content),
+		}, nil
+	}
+}
+
+func (h PhotoApiHandler) uploadError(err error) error {
+	var maxBytesError *nethttp.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		return customerror.NewValidationErrorWithMessage(photoFormField, fmt.Sprintf("must be at most %d bytes", h.photoPolicy.MaxUploadBytes))
+	}
+	return customerror.NewValidationErrorWithMessage(photoFormField, "is not a valid multipart upload")
+}
+
+func photoURL(id string, variant entity.PhotoVariant) string {
+	return strings.NewReplacer("{id}", id, "{variant}", variant.Name).Replace(PhotoFilePath)
+}
+
+func toPhotoResponse(photo *response.Photo) *v1.PhotoResponse {
+	return &v1.PhotoResponse{
+		Id:           photo.ID,
+		Position:     int32(photo.Position),
+		Primary:      photo.Primary,
+		ThumbnailUrl: photoURL(photo.ID, entity.PhotoVariantThumbnail),
+		MediumUrl:    photoURL(photo.ID, entity.PhotoVariantMedium),
+		LargeUrl:     photoURL(photo.ID, entity.PhotoVariantLarge),
+		CreatedAt:    timestamppb.New(photo.CreatedAt),
+	}
+}
+
+func toListPhotosResponse(photos []*response.Photo) *v1.ListPhotosResponse {
+	result := &v1.ListPhotosResponse{
+		Photos: make([]*v1.PhotoResponse, 0, len(photos)),
+	}
+	for _, photo := range photos {
+		result.Photos = append(result.Photos, toPhotoResponse(photo))
+	}
+	return result
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/profile/entity"
+	custommiddleware "app/middleware"
+	"app/tests/fake"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPhotoApiHandler() *PhotoApiHandler {
+	return NewPhotoApiHandler(new(fake.FakePhotoUsecase), &entity.PhotoPolicy{MaxPhotos: 6, MaxUploadBytes: 16}, log.DefaultLogger)
+}
+
+func TestPhotoApiHandler_ListMyPhotos(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when list photos error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("list_photos_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when list photos success, it should return the photos with their urls",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestPhotoApiHandler().ListMyPhotos(tt.ctx, &v1.ListMyPhotosRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Len(got.Photos, 2)
+				assert.True(got.Photos[0].Primary)
+				assert.Equal("/api/v1/photos/photo-1/thumbnail", got.Photos[0].ThumbnailUrl)
+				assert.Equal("/api/v1/photos/photo-1/medium", got.Photos[0].MediumUrl)
+				assert.Equal("/api/v1/photos/photo-1/large", got.Photos[0].LargeUrl)
+			}
+		})
+	}
+}
+
+func TestPhotoApiHandler_DeletePhoto(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.DeletePhotoRequest
+		wantErr bool
+	}{
+		{
+			name:    "when delete photo error, it should return error",
+			params:  &v1.DeletePhotoRequest{Id: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when delete photo success, it should return empty response",
+			params:  &v1.DeletePhotoRequest{Id: "photo-1"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestPhotoApiHandler().DeletePhoto(context.Background(), tt.params)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPhotoApiHandler_ReorderPhotos(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.ReorderPhotosRequest
+		wantErr bool
+	}{
+		{
+			name:    "when reorder photos error, it should return error",
+			params:  &v1.ReorderPhotosRequest{PhotoIds: []string{"test123"}},
+			wantErr: true,
+		},
+		{
+			name:    "when reorder photos success, it should return the photos in the new order",
+			params:  &v1.ReorderPhotosRequest{PhotoIds: []string{"photo-2", "photo-1"}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestPhotoApiHandler().ReorderPhotos(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal("photo-2", got.Photos[0].Id)
+				assert.True(got.Photos[0].Primary)
+			}
+		})
+	}
+}
+
+func TestPhotoApiHandler_UploadPhoto(t *testing.T) {
+	multipartBody := func(fields map[string]string) (io.Reader, string) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		for name, value := range fields {
+			part, _ := writer.CreateFormFile(name, name+".png")
+			_, _ = part.Write([]byte(value))
+		}
+		_ = writer.Close()
+		return &body, writer.FormDataContentType()
+	}
+
+	tests := []struct {
+		name        string
+		fields      map[string]string
+		contentType string
+		wantStatus  int
+		wantMessage string
+	}{
+		{
+			name:        "when body is not multipart, it should return bad request",
+			contentType: "application/json",
+			wantStatus:  nethttp.StatusBadRequest,
+			wantMessage: "must be sent as multipart/form-data",
+		},
+		{
+			name:        "when photo field is missing, it should return bad request",
+			fields:      map[string]string{"image": "\x89PNG\r\n\x1a\n"},
+			wantStatus:  nethttp.StatusBadRequest,
+			wantMessage: "is required",
+		},
+		{
+			name:        "when body is larger than the upload limit, it should return bad request",
+			fields:      map[string]string{"other": strings.Repeat("a", 128<<10)},
+			wantStatus:  nethttp.StatusBadRequest,
+			wantMessage: "must be at most 16 bytes",
+		},
+		{
+			name:       "when upload photo error, it should return error",
+			fields:     map[string]string{"photo": "test123"},
+			wantStatus: nethttp.StatusInternalServerError,
+		},
+		{
+			name:       "when upload photo success, it should return the photo",
+			fields:     map[string]string{"photo": "\x89PNG\r\n\x1a\n"},
+			wantStatus: nethttp.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := http.NewServer(http.ErrorEncoder(custommiddleware.ErrorFormatter))
+			srv.Route("/").POST(PhotoUploadPath, newTestPhotoApiHandler().UploadPhoto)
+
+			body, contentType := multipartBody(tt.fields)
+			if tt.contentType != "" {
+				contentType = tt.contentType
+			}
+			req := httptest.NewRequest(nethttp.MethodPost, PhotoUploadPath, body)
+			req.Header.Set("Content-Type", contentType)
+			recorder := httptest.NewRecorder()
+			srv.ServeHTTP(recorder, req)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantStatus, recorder.Code)
+			if tt.wantMessage != "" {
+				assert.Contains(recorder.Body.String(), tt.wantMessage)
+			}
+			if tt.wantStatus == nethttp.StatusOK {
+				var got map[string]interface{}
+				assert.NoError(json.Unmarshal(recorder.Body.Bytes(), &got))
+				assert.Equal("photo-1", got["id"])
+				assert.Equal("/api/v1/photos/photo-1/thumbnail", got["thumbnailUrl"])
+			}
+		})
+	}
+}
+
+func TestPhotoApiHandler_OpenPhoto(t *testing.T) {
+	tests := []struct {
+		name       string
+		photoID    string
+		wantStatus int
+	}{
+		{
+			name:       "when open photo error, it should return error",
+			photoID:    "test123",
+			wantStatus: nethttp.StatusInternalServerError,
+		},
+		{
+			name:       "when open photo success, it should serve the image",
+			photoID:    "photo-1",
+			wantStatus: nethttp.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := http.NewServer()
+			srv.Route("/").GET(PhotoFilePath, newTestPhotoApiHandler().OpenPhoto)
+
+			recorder := httptest.NewRecorder()
+			srv.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, "/api/v1/photos/"+tt.photoID+"/thumbnail", nil))
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantStatus, recorder.Code)
+			if tt.wantStatus == nethttp.StatusOK {
+				assert.Equal("image/jpeg", recorder.Header().Get("Content-Type"))
+				assert.Equal("image", recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driver"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PreferencesApiHandler struct {
+	v1.UnimplementedPreferencesServer
+
+	preferences driver.PreferencesUsecase
+	log         log.Logger
+}
+
+func NewPreferencesApiHandler(preferences driver.PreferencesUsecase, log log.Logger) *PreferencesApiHandler {
+	return &PreferencesApiHandler{
+		preferences: preferences,
+		log:         log,
+	}
+}
+
+func (h PreferencesApiHandler) GetMyPreferences(ctx context.Context, _ *v1.GetMyPreferencesRequest) (*v1.PreferencesResponse, error) {
+	preferences, err := h.preferences.GetMyPreferences(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toPreferencesResponse(preferences), nil
+}
+
+func (h PreferencesApiHandler) UpdateMyPreferences(ctx context.Context, params *v1.UpdateMyPreferencesRequest) (*v1.PreferencesResponse, error) {
+	update := &request.UpdatePreferences{
+		MinAge:        int32PtrToIntPtr(params.MinAge),
+		MaxAge:        int32PtrToIntPtr(params.MaxAge),
+		MaxDistanceKM: int32PtrToIntPtr(params.MaxDistanceKm),
+	}
+	// a repeated field cannot tell an empty list from a missing one, so an empty list keeps the genders
+	if len(params.InterestedIn) > 0 {
+		update.InterestedIn = make([]string, 0, len(params.InterestedIn))
+		for _, gender := range params.InterestedIn {
+			update.InterestedIn = append(update.InterestedIn, genderToString(gender))
+		}
+	}
+
+	preferences, err := h.preferences.UpdateMyPreferences(ctx, update)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toPreferencesResponse(preferences), nil
+}
+
+func int32PtrToIntPtr(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func toPreferencesResponse(preferences *response.Preferences) *v1.PreferencesResponse {
+	interestedIn := make([]v1.Gender, 0, len(preferences.InterestedIn))
+	for _, gender := range preferences.InterestedIn {
+		interestedIn = append(interestedIn, toGender(gender))
+	}
+	return &v1.PreferencesResponse{
+		InterestedIn:  interestedIn,
+		MinAge:        int32(preferences.MinAge),
+		MaxAge:        int32(preferences.MaxAge),
+		MaxDistanceKm: int32(preferences.MaxDistanceKM),
+		UpdatedAt:     timestamppb.New(preferences.UpdatedAt),
+	}
+}
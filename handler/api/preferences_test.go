@@ -0,0 +1,82 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/tests/fake"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferencesApiHandler_GetMyPreferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when get preferences error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("get_preferences_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when get preferences success, it should return the preferences",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewPreferencesApiHandler(new(fake.FakePreferencesUsecase), log.DefaultLogger)
+			got, err := h.GetMyPreferences(tt.ctx, &v1.GetMyPreferencesRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal([]v1.Gender{v1.Gender_GENDER_WOMAN}, got.InterestedIn)
+				assert.Equal(int32(18), got.MinAge)
+				assert.Equal(int32(28), got.MaxAge)
+				assert.NotNil(got.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestPreferencesApiHandler_UpdateMyPreferences(t *testing.T) {
+	underage := int32(17)
+	distance := int32(10)
+	tests := []struct {
+		name             string
+		params           *v1.UpdateMyPreferencesRequest
+		wantErr          bool
+		wantInterestedIn []v1.Gender
+	}{
+		{
+			name:    "when update preferences error, it should return error",
+			params:  &v1.UpdateMyPreferencesRequest{MinAge: &underage},
+			wantErr: true,
+		},
+		{
+			name:             "when interested in is empty, it should keep the current genders",
+			params:           &v1.UpdateMyPreferencesRequest{MaxDistanceKm: &distance},
+			wantInterestedIn: []v1.Gender{v1.Gender_GENDER_WOMAN},
+		},
+		{
+			name:             "when interested in is sent, it should replace the genders",
+			params:           &v1.UpdateMyPreferencesRequest{InterestedIn: []v1.Gender{v1.Gender_GENDER_MAN, v1.Gender_GENDER_NON_BINARY}},
+			wantInterestedIn: []v1.Gender{v1.Gender_GENDER_MAN, v1.Gender_GENDER_NON_BINARY},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewPreferencesApiHandler(new(fake.FakePreferencesUsecase), log.DefaultLogger)
+			got, err := h.UpdateMyPreferences(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.wantInterestedIn, got.InterestedIn)
+			}
+		})
+	}
+}
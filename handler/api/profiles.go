@@ -0,0 +1,68 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ProfileApiHandler struct {
+	v1.UnimplementedProfileServer
+
+	profile driver.ProfileUsecase
+	log     log.Logger
+}
+
+func NewProfileApiHandler(profile driver.ProfileUsecase, log log.Logger) *ProfileApiHandler {
+	return &ProfileApiHandler{
+		profile: profile,
+		log:     log,
+	}
+}
+
+func (h ProfileApiHandler) GetMyProfile(ctx context.Context, _ *v1.GetMyProfileRequest) (*v1.ProfileResponse, error) {
+	profile, err := h.profile.GetMyProfile(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toProfileResponse(profile), nil
+}
+
+func (h ProfileApiHandler) UpdateMyProfile(ctx context.Context, params *v1.UpdateMyProfileRequest) (*v1.ProfileResponse, error) {
+	update := &request.UpdateProfile{
+		Birthdate: params.Birthdate,
+		Bio:       params.Bio,
+		Job:       params.Job,
+		Education: params.Education,
+	}
+	if params.HeightCm != nil {
+		height := int(*params.HeightCm)
+		update.HeightCM = &height
+	}
+
+	profile, err := h.profile.UpdateMyProfile(ctx, update)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toProfileResponse(profile), nil
+}
+
+func toProfileResponse(profile *response.Profile) *v1.ProfileResponse {
+	return &v1.ProfileResponse{
+		Birthdate: profile.Birthdate.Format(entity.BirthdateLayout),
+		Age:       int32(profile.Age),
+		Bio:       profile.Bio,
+		Job:       profile.Job,
+		Education: profile.Education,
+		HeightCm:  int32(profile.HeightCM),
+		UpdatedAt: timestamppb.New(profile.UpdatedAt),
+	}
+}
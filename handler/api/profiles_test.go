@@ -0,0 +1,77 @@
+package api
+
+import (
+	v1 "app/api/v1"
+	"app/tests/fake"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileApiHandler_GetMyProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when get profile error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("get_profile_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when get profile success, it should return the profile",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewProfileApiHandler(new(fake.FakeProfileUsecase), log.DefaultLogger)
+			got, err := h.GetMyProfile(tt.ctx, &v1.GetMyProfileRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal("1995-03-04", got.Birthdate)
+				assert.Equal(int32(29), got.Age)
+				assert.NotNil(got.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestProfileApiHandler_UpdateMyProfile(t *testing.T) {
+	bio := "test123"
+	height := int32(180)
+	tests := []struct {
+		name       string
+		params     *v1.UpdateMyProfileRequest
+		wantErr    bool
+		wantHeight int32
+	}{
+		{
+			name:    "when update profile error, it should return error",
+			params:  &v1.UpdateMyProfileRequest{Bio: &bio},
+			wantErr: true,
+		},
+		{
+			name:       "when update profile success, it should return the updated profile",
+			params:     &v1.UpdateMyProfileRequest{HeightCm: &height},
+			wantErr:    false,
+			wantHeight: 180,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewProfileApiHandler(new(fake.FakeProfileUsecase), log.DefaultLogger)
+			got, err := h.UpdateMyProfile(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.wantHeight, got.HeightCm)
+			}
+		})
+	}
+}
@@ -3,22 +3,30 @@ package api
 import (
 	v1 "app/api/v1"
 	"app/internal/user/param/request"
+	"app/internal/user/param/response"
 	"app/internal/user/port/driver"
+	"app/middleware"
 	"context"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserApiHandler struct {
 	v1.UnimplementedUserServer
 
 	userWriter driver.UserWriterUsecase
+	userReader driver.UserReaderUsecase
+	dataExport driver.DataExportUsecase
 	log        log.Logger
 }
 
-func NewUserApiHandler(writer driver.UserWriterUsecase, log log.Logger) *UserApiHandler {
+func NewUserApiHandler(writer driver.UserWriterUsecase, reader driver.UserReaderUsecase, dataExport driver.DataExportUsecase, log log.Logger) *UserApiHandler {
 	return &UserApiHandler{
 		userWriter: writer,
+		userReader: reader,
+		dataExport: dataExport,
 		log:        log,
 	}
 }
@@ -29,7 +37,9 @@ func (h UserApiHandler) CreateUser(ctx context.Context, params *v1.CreateUserReq
 		PhoneNumber: params.PhoneNumber,
 		Name:        params.Name,
 		Password:    params.Password,
-		Gender:      params.Gender,
+		Gender:      createUserGender(params),
+		ShowGender:  params.ShowGender,
+		Orientation: orientationToString(params.Orientation),
 	})
 	if err != nil {
 		_ = h.log.Log(log.LevelError, err)
@@ -40,19 +50,341 @@ func (h UserApiHandler) CreateUser(ctx context.Context, params *v1.CreateUserReq
 	}, nil
 }
 
+func (h UserApiHandler) GetMe(ctx context.Context, _ *v1.GetMeRequest) (*v1.GetMeResponse, error) {
+	user, err := h.userReader.GetMe(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toGetMeResponse(user), nil
+}
+
+func (h UserApiHandler) UpdateMyIdentity(ctx context.Context, params *v1.UpdateMyIdentityRequest) (*v1.GetMeResponse, error) {
+	update := &request.UpdateIdentity{
+		ShowGender: params.ShowGender,
+	}
+	if params.Gender != nil {
+		gender := genderToString(*params.Gender)
+		update.Gender = &gender
+	}
+	if params.Orientation != nil {
+		orientation := orientationToString(*params.Orientation)
+		update.Orientation = &orientation
+	}
+
+	user, err := h.userWriter.UpdateMyIdentity(ctx, update)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toGetMeResponse(user), nil
+}
+
+func (h UserApiHandler) DeleteMe(ctx context.Context, _ *v1.DeleteMeRequest) (*v1.DeleteMeResponse, error) {
+	deletion, err := h.userWriter.DeleteAccount(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.DeleteMeResponse{
+		PurgeAt: timestamppb.New(deletion.PurgeAt),
+	}, nil
+}
+
 func (h UserApiHandler) CreateUserToken(ctx context.Context, params *v1.CreateUserTokenRequest) (*v1.CreateUserTokenResponse, error) {
+	identifier := params.Identifier
+	if identifier == "" {
+		identifier = params.Username
+	}
+
 	token, err := h.userWriter.GenerateUserToken(ctx, &request.GenerateUserToken{
-		Username: params.Username,
+		Identifier: identifier,
+		Password:   params.Password,
+		ClientIP:   middleware.ClientIP(ctx),
+		DeviceName: params.DeviceName,
+		UserAgent:  middleware.UserAgent(ctx),
+	})
+
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toCreateUserTokenResponse(token), nil
+}
+
+func (h UserApiHandler) RefreshUserToken(ctx context.Context, params *v1.RefreshUserTokenRequest) (*v1.CreateUserTokenResponse, error) {
+	token, err := h.userWriter.RefreshUserToken(ctx, &request.RefreshUserToken{
+		RefreshToken: params.RefreshToken,
+		ClientIP:     middleware.ClientIP(ctx),
+		UserAgent:    middleware.UserAgent(ctx),
+	})
+
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toCreateUserTokenResponse(token), nil
+}
+
+func (h UserApiHandler) Logout(ctx context.Context, params *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	err := h.userWriter.Logout(ctx, &request.Logout{
+		RefreshToken: params.RefreshToken,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.LogoutResponse{}, nil
+}
+
+func (h UserApiHandler) LogoutAll(ctx context.Context, _ *v1.LogoutAllRequest) (*v1.LogoutResponse, error) {
+	err := h.userWriter.LogoutAll(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.LogoutResponse{}, nil
+}
+
+func (h UserApiHandler) SendPhoneVerification(ctx context.Context, params *v1.SendPhoneVerificationRequest) (*v1.SendPhoneVerificationResponse, error) {
+	err := h.userWriter.SendPhoneVerification(ctx, &request.SendPhoneVerification{
+		PhoneNumber: params.PhoneNumber,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.SendPhoneVerificationResponse{}, nil
+}
+
+func (h UserApiHandler) VerifyPhoneNumber(ctx context.Context, params *v1.VerifyPhoneNumberRequest) (*v1.VerifyPhoneNumberResponse, error) {
+	err := h.userWriter.VerifyPhoneNumber(ctx, &request.VerifyPhoneNumber{
+		PhoneNumber: params.PhoneNumber,
+		Code:        params.Code,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.VerifyPhoneNumberResponse{}, nil
+}
+
+func (h UserApiHandler) RequestPasswordReset(ctx context.Context, params *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetResponse, error) {
+	err := h.userWriter.RequestPasswordReset(ctx, &request.RequestPasswordReset{
+		Identifier: params.Identifier,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.RequestPasswordResetResponse{}, nil
+}
+
+func (h UserApiHandler) VerifyPasswordReset(ctx context.Context, params *v1.VerifyPasswordResetRequest) (*v1.VerifyPasswordResetResponse, error) {
+	ticket, err := h.userWriter.VerifyPasswordReset(ctx, &request.VerifyPasswordReset{
+		Identifier: params.Identifier,
+		Code:       params.Code,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.VerifyPasswordResetResponse{
+		Ticket:    ticket.Ticket,
+		ExpiresIn: int32(ticket.ExpiresIn),
+	}, nil
+}
+
+func (h UserApiHandler) ResetPassword(ctx context.Context, params *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error) {
+	err := h.userWriter.ResetPassword(ctx, &request.ResetPassword{
+		Ticket:   params.Ticket,
 		Password: params.Password,
 	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.ResetPasswordResponse{}, nil
+}
 
+func (h UserApiHandler) ChangePassword(ctx context.Context, params *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error) {
+	err := h.userWriter.ChangePassword(ctx, &request.ChangePassword{
+		CurrentPassword: params.CurrentPassword,
+		NewPassword:     params.NewPassword,
+	})
 	if err != nil {
 		_ = h.log.Log(log.LevelError, err)
 		return nil, err
 	}
-	return &v1.CreateUserTokenResponse{
-		Token:     token.Token,
-		Type:      token.Type,
-		ExpiresIn: int32(token.ExpiresIn),
+	return &v1.ChangePasswordResponse{}, nil
+}
+
+func (h UserApiHandler) EnrollTOTP(ctx context.Context, _ *v1.EnrollTOTPRequest) (*v1.EnrollTOTPResponse, error) {
+	enrollment, err := h.userWriter.EnrollTOTP(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.EnrollTOTPResponse{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}, nil
+}
+
+func (h UserApiHandler) ConfirmTOTP(ctx context.Context, params *v1.ConfirmTOTPRequest) (*v1.ConfirmTOTPResponse, error) {
+	recoveryCodes, err := h.userWriter.ConfirmTOTP(ctx, &request.ConfirmTOTP{
+		Code: params.Code,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.ConfirmTOTPResponse{
+		RecoveryCodes: recoveryCodes.Codes,
+	}, nil
+}
+
+func (h UserApiHandler) CompleteMFALogin(ctx context.Context, params *v1.CompleteMFALoginRequest) (*v1.CreateUserTokenResponse, error) {
+	token, err := h.userWriter.CompleteMFALogin(ctx, &request.CompleteMFALogin{
+		ChallengeID: params.ChallengeId,
+		Code:        params.Code,
+		ClientIP:    middleware.ClientIP(ctx),
+		DeviceName:  params.DeviceName,
+		UserAgent:   middleware.UserAgent(ctx),
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return toCreateUserTokenResponse(token), nil
+}
+
+func (h UserApiHandler) ListSessions(ctx context.Context, _ *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	sessions, err := h.userWriter.ListSessions(ctx)
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+
+	result := make([]*v1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &v1.Session{
+			Id:         session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			Current:    session.Current,
+		})
+	}
+	return &v1.ListSessionsResponse{
+		Sessions: result,
 	}, nil
 }
+
+func (h UserApiHandler) RevokeSession(ctx context.Context, params *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error) {
+	err := h.userWriter.RevokeSession(ctx, &request.RevokeSession{
+		SessionID: params.Id,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.RevokeSessionResponse{}, nil
+}
+
+func (h UserApiHandler) ChangeUserRole(ctx context.Context, params *v1.ChangeUserRoleRequest) (*v1.ChangeUserRoleResponse, error) {
+	err := h.userWriter.ChangeUserRole(ctx, &request.ChangeUserRole{
+		UserID: params.Id,
+		Role:   params.Role,
+	})
+	if err != nil {
+		_ = h.log.Log(log.LevelError, err)
+		return nil, err
+	}
+	return &v1.ChangeUserRoleResponse{}, nil
+}
+
+func toCreateUserTokenResponse(token *response.Token) *v1.CreateUserTokenResponse {
+	return &v1.CreateUserTokenResponse{
+		Token:                 token.Token,
+		Type:                  token.Type,
+		ExpiresIn:             int32(token.ExpiresIn),
+		RefreshToken:          token.RefreshToken,
+		RefreshExpiresIn:      int32(token.RefreshExpiresIn),
+		MfaChallengeId:        token.MFAChallengeID,
+		MfaChallengeExpiresIn: int32(token.MFAChallengeExpiresIn),
+	}
+}
+
+func toGetMeResponse(user *response.User) *v1.GetMeResponse {
+	return &v1.GetMeResponse{
+		Id:               user.ID,
+		Name:             user.Name,
+		Username:         user.Username,
+		PhoneNumber:      user.PhoneNumber,
+		Gender:           toLegacyGender(user.Gender),
+		GenderIdentity:   toGender(user.Gender),
+		ShowGender:       user.ShowGender,
+		Orientation:      toOrientation(user.Orientation),
+		Role:             user.Role,
+		PhoneVerified:    user.PhoneVerified,
+		TwoFactorEnabled: user.TwoFactorEnabled,
+		CreatedAt:        timestamppb.New(user.CreatedAt),
+		UpdatedAt:        timestamppb.New(user.UpdatedAt),
+	}
+}
+
+// genderToString returns gender the way entity.Gender names it, GENDER_WOMAN becomes woman
+// and GENDER_UNSPECIFIED an empty string.
+func genderToString(gender v1.Gender) string {
+	if gender == v1.Gender_GENDER_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(gender.String(), "GENDER_"))
+}
+
+func toGender(value string) v1.Gender {
+	return v1.Gender(v1.Gender_value["GENDER_"+strings.ToUpper(value)])
+}
+
+// legacyGenders are the values of the deprecated string gender fields, keyed by the gender identity they stand for.
+var legacyGenders = map[string]string{
+	"man":   "male",
+	"woman": "female",
+}
+
+// createUserGender prefers gender_identity, clients still sending the deprecated gender field
+// have male and female mapped to man and woman.
+func createUserGender(params *v1.CreateUserRequest) string {
+	if params.GenderIdentity != v1.Gender_GENDER_UNSPECIFIED {
+		return genderToString(params.GenderIdentity)
+	}
+	legacy := strings.ToLower(params.Gender)
+	for gender, legacyGender := range legacyGenders {
+		if legacy == legacyGender {
+			return gender
+		}
+	}
+	return legacy
+}
+
+// toLegacyGender fills the deprecated gender field, identities that were not offered before are sent as they are.
+func toLegacyGender(value string) string {
+	if legacyGender, ok := legacyGenders[value]; ok {
+		return legacyGender
+	}
+	return value
+}
+
+func orientationToString(orientation v1.Orientation) string {
+	if orientation == v1.Orientation_ORIENTATION_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(orientation.String(), "ORIENTATION_"))
+}
+
+func toOrientation(value string) v1.Orientation {
+	return v1.Orientation(v1.Orientation_value["ORIENTATION_"+strings.ToUpper(value)])
+}
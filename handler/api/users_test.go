@@ -5,10 +5,14 @@ import (
 	"app/internal/user/port/driver"
 	"app/tests/fake"
 	"context"
+	nethttp "net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,11 +40,11 @@ func TestUserApiHandler_CreateUser(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				params: &v1.CreateUserRequest{
-					Username:    "test123",
-					Password:    faker.Password(),
-					Name:        faker.Name(),
-					Gender:      faker.Gender(),
-					PhoneNumber: faker.Phonenumber(),
+					Username:       "test123",
+					Password:       faker.Password(),
+					Name:           faker.Name(),
+					GenderIdentity: v1.Gender_GENDER_WOMAN,
+					PhoneNumber:    faker.Phonenumber(),
 				},
 			},
 			wantErr: true,
@@ -54,11 +58,11 @@ func TestUserApiHandler_CreateUser(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				params: &v1.CreateUserRequest{
-					Username:    faker.Username(),
-					Password:    faker.Password(),
-					Name:        faker.Name(),
-					Gender:      faker.Gender(),
-					PhoneNumber: faker.Phonenumber(),
+					Username:       faker.Username(),
+					Password:       faker.Password(),
+					Name:           faker.Name(),
+					GenderIdentity: v1.Gender_GENDER_WOMAN,
+					PhoneNumber:    faker.Phonenumber(),
 				},
 			},
 			wantErr: false,
@@ -66,7 +70,7 @@ func TestUserApiHandler_CreateUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewUserApiHandler(tt.fields.userWriter, tt.fields.log)
+			h := NewUserApiHandler(tt.fields.userWriter, new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), tt.fields.log)
 			got, err := h.CreateUser(tt.args.ctx, tt.args.params)
 			assert := assert.New(t)
 			if tt.wantErr {
@@ -79,6 +83,40 @@ func TestUserApiHandler_CreateUser(t *testing.T) {
 	}
 }
 
+func TestCreateUserGender(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *v1.CreateUserRequest
+		want   string
+	}{
+		{
+			name:   "when gender identity is sent, it should take precedence over the deprecated gender",
+			params: &v1.CreateUserRequest{Gender: "male", GenderIdentity: v1.Gender_GENDER_NON_BINARY},
+			want:   "non_binary",
+		},
+		{
+			name:   "when only the deprecated gender is sent, it should map male and female",
+			params: &v1.CreateUserRequest{Gender: "Female"},
+			want:   "woman",
+		},
+		{
+			name:   "when the deprecated gender holds an identity, it should pass it on",
+			params: &v1.CreateUserRequest{Gender: "agender"},
+			want:   "agender",
+		},
+		{
+			name:   "when no gender is sent, it should return an empty gender",
+			params: &v1.CreateUserRequest{},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, createUserGender(tt.params))
+		})
+	}
+}
+
 func TestUserApiHandler_CreateUserToken(t *testing.T) {
 	type fields struct {
 		UnimplementedUserServer v1.UnimplementedUserServer
@@ -110,6 +148,22 @@ func TestUserApiHandler_CreateUserToken(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "when identifier is set, it should take precedence over username",
+			fields: fields{
+				userWriter: new(fake.FakeUserUsecase),
+				log:        log.DefaultLogger,
+			},
+			args: args{
+				ctx: context.Background(),
+				params: &v1.CreateUserTokenRequest{
+					Identifier: "test123",
+					Username:   faker.Username(),
+					Password:   faker.Password(),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "when generate token success, it should return token",
 			fields: fields{
@@ -128,7 +182,7 @@ func TestUserApiHandler_CreateUserToken(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewUserApiHandler(tt.fields.userWriter, tt.fields.log)
+			h := NewUserApiHandler(tt.fields.userWriter, new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), tt.fields.log)
 			got, err := h.CreateUserToken(tt.args.ctx, tt.args.params)
 			assert := assert.New(t)
 			if tt.wantErr {
@@ -143,3 +197,616 @@ func TestUserApiHandler_CreateUserToken(t *testing.T) {
 		})
 	}
 }
+
+func TestUserApiHandler_RefreshUserToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.RefreshUserTokenRequest
+		wantErr bool
+	}{
+		{
+			name:    "when refresh token error, it should return error",
+			params:  &v1.RefreshUserTokenRequest{RefreshToken: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when refresh token success, it should return new token pair",
+			params:  &v1.RefreshUserTokenRequest{RefreshToken: faker.UUIDDigit()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.RefreshUserToken(context.Background(), tt.params)
+			assert := assert.New(t)
+			if tt.wantErr {
+				assert.Error(err)
+				assert.Nil(got)
+			} else {
+				assert.NotEmpty(got.Token)
+				assert.NotEmpty(got.RefreshToken)
+				assert.NotEmpty(got.RefreshExpiresIn)
+				assert.Equal("Bearer", got.Type)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_Logout(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.LogoutRequest
+		wantErr bool
+	}{
+		{
+			name:    "when logout error, it should return error",
+			params:  &v1.LogoutRequest{RefreshToken: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when logout success, it should return empty response",
+			params:  &v1.LogoutRequest{RefreshToken: faker.UUIDDigit()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.Logout(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_LogoutAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when logout error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("logout_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when logout success, it should return empty response",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.LogoutAll(tt.ctx, &v1.LogoutAllRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_SendPhoneVerification(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.SendPhoneVerificationRequest
+		wantErr bool
+	}{
+		{
+			name:    "when send verification error, it should return error",
+			params:  &v1.SendPhoneVerificationRequest{PhoneNumber: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when send verification success, it should return empty response",
+			params:  &v1.SendPhoneVerificationRequest{PhoneNumber: "+6281234567890"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.SendPhoneVerification(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_VerifyPhoneNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.VerifyPhoneNumberRequest
+		wantErr bool
+	}{
+		{
+			name:    "when verify error, it should return error",
+			params:  &v1.VerifyPhoneNumberRequest{PhoneNumber: "+6281234567890", Code: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when verify success, it should return empty response",
+			params:  &v1.VerifyPhoneNumberRequest{PhoneNumber: "+6281234567890", Code: "123456"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.VerifyPhoneNumber(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_RequestPasswordReset(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.RequestPasswordResetRequest
+		wantErr bool
+	}{
+		{
+			name:    "when request reset error, it should return error",
+			params:  &v1.RequestPasswordResetRequest{Identifier: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when request reset success, it should return empty response",
+			params:  &v1.RequestPasswordResetRequest{Identifier: faker.Username()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.RequestPasswordReset(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_VerifyPasswordReset(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.VerifyPasswordResetRequest
+		wantErr bool
+	}{
+		{
+			name:    "when verify error, it should return error",
+			params:  &v1.VerifyPasswordResetRequest{Identifier: faker.Username(), Code: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when verify success, it should return ticket",
+			params:  &v1.VerifyPasswordResetRequest{Identifier: faker.Username(), Code: "123456"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.VerifyPasswordReset(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.Ticket)
+				assert.NotEmpty(got.ExpiresIn)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.ResetPasswordRequest
+		wantErr bool
+	}{
+		{
+			name:    "when reset error, it should return error",
+			params:  &v1.ResetPasswordRequest{Ticket: "test123", Password: faker.Password()},
+			wantErr: true,
+		},
+		{
+			name:    "when reset success, it should return empty response",
+			params:  &v1.ResetPasswordRequest{Ticket: faker.UUIDDigit(), Password: faker.Password()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.ResetPassword(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_ChangePassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.ChangePasswordRequest
+		wantErr bool
+	}{
+		{
+			name:    "when change password error, it should return error",
+			params:  &v1.ChangePasswordRequest{CurrentPassword: "test123", NewPassword: faker.Password()},
+			wantErr: true,
+		},
+		{
+			name:    "when change password success, it should return empty response",
+			params:  &v1.ChangePasswordRequest{CurrentPassword: faker.Password(), NewPassword: faker.Password()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.ChangePassword(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_EnrollTOTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when enroll error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("enroll_totp_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when enroll success, it should return secret and uri",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.EnrollTOTP(tt.ctx, &v1.EnrollTOTPRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.Secret)
+				assert.NotEmpty(got.Uri)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_ConfirmTOTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.ConfirmTOTPRequest
+		wantErr bool
+	}{
+		{
+			name:    "when confirm error, it should return error",
+			params:  &v1.ConfirmTOTPRequest{Code: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when confirm success, it should return recovery codes",
+			params:  &v1.ConfirmTOTPRequest{Code: "123456"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.ConfirmTOTP(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.RecoveryCodes)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_CompleteMFALogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.CompleteMFALoginRequest
+		wantErr bool
+	}{
+		{
+			name:    "when complete error, it should return error",
+			params:  &v1.CompleteMFALoginRequest{ChallengeId: faker.UUIDHyphenated(), Code: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when complete success, it should return token",
+			params:  &v1.CompleteMFALoginRequest{ChallengeId: faker.UUIDHyphenated(), Code: "123456"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.CompleteMFALogin(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.Token)
+				assert.NotEmpty(got.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_ListSessions(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when list sessions error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("list_sessions_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when list sessions success, it should return sessions",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.ListSessions(tt.ctx, &v1.ListSessionsRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.Sessions)
+				assert.NotEmpty(got.Sessions[0].Id)
+				assert.NotNil(got.Sessions[0].LastSeenAt)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.RevokeSessionRequest
+		wantErr bool
+	}{
+		{
+			name:    "when revoke session error, it should return error",
+			params:  &v1.RevokeSessionRequest{Id: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when revoke session success, it should return empty response",
+			params:  &v1.RevokeSessionRequest{Id: faker.UUIDHyphenated()},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.RevokeSession(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestUserApiHandler_GetMe(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when get me error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("get_me_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when get me success, it should return the user",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.GetMe(tt.ctx, &v1.GetMeRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.NotEmpty(got.Id)
+				assert.NotEmpty(got.Username)
+				assert.Equal(v1.Gender_GENDER_MAN, got.GenderIdentity)
+				assert.Equal("male", got.Gender)
+				assert.NotNil(got.CreatedAt)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_UpdateMyIdentity(t *testing.T) {
+	unspecifiedGender := v1.Gender_GENDER_UNSPECIFIED
+	nonBinary := v1.Gender_GENDER_NON_BINARY
+	hidden := false
+	queer := v1.Orientation_ORIENTATION_QUEER
+	tests := []struct {
+		name    string
+		params  *v1.UpdateMyIdentityRequest
+		want    *v1.GetMeResponse
+		wantErr bool
+	}{
+		{
+			name:    "when update identity error, it should return error",
+			params:  &v1.UpdateMyIdentityRequest{Gender: &unspecifiedGender},
+			wantErr: true,
+		},
+		{
+			name:   "when nothing is sent, it should keep the identity",
+			params: &v1.UpdateMyIdentityRequest{},
+			want:   &v1.GetMeResponse{GenderIdentity: v1.Gender_GENDER_MAN, ShowGender: true},
+		},
+		{
+			name:   "when identity is sent, it should return the updated user",
+			params: &v1.UpdateMyIdentityRequest{Gender: &nonBinary, ShowGender: &hidden, Orientation: &queer},
+			want:   &v1.GetMeResponse{GenderIdentity: v1.Gender_GENDER_NON_BINARY, ShowGender: false, Orientation: v1.Orientation_ORIENTATION_QUEER},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.UpdateMyIdentity(context.Background(), tt.params)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.want.GenderIdentity, got.GenderIdentity)
+				assert.Equal(tt.want.ShowGender, got.ShowGender)
+				assert.Equal(tt.want.Orientation, got.Orientation)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_DeleteMe(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when delete account error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("delete_account_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when delete account success, it should return when the account is purged",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.DeleteMe(tt.ctx, &v1.DeleteMeRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.True(got.PurgeAt.AsTime().After(time.Now()))
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_RequestDataExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "when request data export error, it should return error",
+			ctx:     context.WithValue(context.Background(), fake.ContextType("request_data_export_error"), true),
+			wantErr: true,
+		},
+		{
+			name:    "when request data export success, it should return the download url",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			got, err := h.RequestDataExport(tt.ctx, &v1.RequestDataExportRequest{})
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal("/api/v1/users/me/exports/export-1/download", got.DownloadUrl)
+				assert.NotNil(got.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_DownloadDataExport(t *testing.T) {
+	tests := []struct {
+		name       string
+		exportID   string
+		wantStatus int
+	}{
+		{
+			name:       "when download data export error, it should return error",
+			exportID:   "test123",
+			wantStatus: nethttp.StatusInternalServerError,
+		},
+		{
+			name:       "when download data export success, it should stream the archive",
+			exportID:   "export-1",
+			wantStatus: nethttp.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			srv := http.NewServer()
+			srv.Route("/").GET(DataExportDownloadPath, h.DownloadDataExport)
+
+			recorder := httptest.NewRecorder()
+			srv.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, "/api/v1/users/me/exports/"+tt.exportID+"/download", nil))
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantStatus, recorder.Code)
+			if tt.wantStatus == nethttp.StatusOK {
+				assert.Equal("application/zip", recorder.Header().Get("Content-Type"))
+				assert.Equal("archive", recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserApiHandler_ChangeUserRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *v1.ChangeUserRoleRequest
+		wantErr bool
+	}{
+		{
+			name:    "when change user role error, it should return error",
+			params:  &v1.ChangeUserRoleRequest{Id: 1, Role: "test123"},
+			wantErr: true,
+		},
+		{
+			name:    "when change user role success, it should return empty response",
+			params:  &v1.ChangeUserRoleRequest{Id: 1, Role: "moderator"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserApiHandler(new(fake.FakeUserUsecase), new(fake.FakeUserReaderUsecase), new(fake.FakeDataExportUsecase), log.DefaultLogger)
+			_, err := h.ChangeUserRole(context.Background(), tt.params)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -7,4 +7,4 @@ import (
 )
 
 // ProviderSet is handler providers.
-var ProviderSet = wire.NewSet(api.NewUserApiHandler)
+var ProviderSet = wire.NewSet(api.NewUserApiHandler, api.NewProfileApiHandler, api.NewPhotoApiHandler, api.NewPreferencesApiHandler, api.NewLocationApiHandler, api.NewInterestApiHandler)
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type DataExportRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.DataExportStore = new(DataExportRepository)
+)
+
+func NewDataExportRepository(db *PostgresDB) *DataExportRepository {
+	return &DataExportRepository{
+		db: db,
+	}
+}
+
+// Create implements driven.DataExportStore.
+func (dr *DataExportRepository) Create(ctx context.Context, export *entity.DataExport) error {
+	return dr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		user_data_exports (user_id, expires_at)
+	VALUES
+		($1, $2)
+	RETURNING
+		id, created_at
+	`, export.UserID, export.ExpiresAt).Scan(&export.ID, &export.CreatedAt)
+}
+
+// GetByID implements driven.DataExportStore.
+func (dr *DataExportRepository) GetByID(ctx context.Context, id string) (*entity.DataExport, error) {
+	rows, err := dr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			expires_at,
+			created_at
+		FROM
+			user_data_exports
+		WHERE
+			id = $1
+		LIMIT
+			1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	var export entity.DataExport
+	if rows.Next() {
+		err = rows.Scan(
+			&export.ID,
+			&export.UserID,
+			&export.ExpiresAt,
+			&export.CreatedAt,
+		)
+	} else {
+		return nil, sql.ErrNoRows
+	}
+
+	return &export, err
+}
+
+// ListExpired implements driven.DataExportStore.
+func (dr *DataExportRepository) ListExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]*entity.DataExport, error) {
+	rows, err := dr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			expires_at,
+			created_at
+		FROM
+			user_data_exports
+		WHERE
+			expires_at < $1
+		ORDER BY
+			expires_at
+		LIMIT
+			$2
+	`, expiredBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exports []*entity.DataExport
+	for rows.Next() {
+		var export entity.DataExport
+		err = rows.Scan(
+			&export.ID,
+			&export.UserID,
+			&export.ExpiresAt,
+			&export.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, &export)
+	}
+	return exports, rows.Err()
+}
+
+// Delete implements driven.DataExportStore.
+func (dr *DataExportRepository) Delete(ctx context.Context, ids []string) (int64, error) {
+	result, err := dr.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			user_data_exports
+		WHERE
+			id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
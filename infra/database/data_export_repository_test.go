@@ -0,0 +1,195 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataExportRepository_Create(t *testing.T) {
+	export := &entity.DataExport{UserID: 123, ExpiresAt: time.Now().Add(24 * time.Hour)}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO user_data_exports").WithArgs(export.UserID, export.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill the generated id",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO user_data_exports").WithArgs(export.UserID, export.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("0f8fad5b-d9cb-469f-a165-70867728950e", time.Now()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewDataExportRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), export)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal("0f8fad5b-d9cb-469f-a165-70867728950e", export.ID)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDataExportRepository_GetByID(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.DataExport
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock, *entity.DataExport)
+	}{
+		{
+			name:    "when record not found, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.DataExport) {
+				mock.ExpectQuery("SELECT").WithArgs("export-id").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when record found, it should return export",
+			want: &entity.DataExport{
+				ID:        "export-id",
+				UserID:    123,
+				ExpiresAt: now.Add(24 * time.Hour),
+				CreatedAt: now,
+			},
+			expectFunc: func(mock sqlmock.Sqlmock, export *entity.DataExport) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "expires_at", "created_at"}).
+					AddRow(export.ID, export.UserID, export.ExpiresAt, export.CreatedAt)
+				mock.ExpectQuery("SELECT (.+) FROM user_data_exports").WithArgs("export-id").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewDataExportRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetByID(context.Background(), "export-id")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDataExportRepository_ListExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       []*entity.DataExport
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_data_exports WHERE expires_at < (.+)").WithArgs(now, 100).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when exports expired, it should return them",
+			want: []*entity.DataExport{
+				{ID: "export-1", UserID: 123, ExpiresAt: now.Add(-time.Hour), CreatedAt: now.Add(-25 * time.Hour)},
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_data_exports WHERE expires_at < (.+)").WithArgs(now, 100).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "expires_at", "created_at"}).
+						AddRow("export-1", 123, now.Add(-time.Hour), now.Add(-25*time.Hour)))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewDataExportRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListExpired(context.Background(), now, 100)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDataExportRepository_Delete(t *testing.T) {
+	ids := []string{"export-1", "export-2"}
+	tests := []struct {
+		name       string
+		want       int64
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM user_data_exports").WithArgs(pq.Array(ids)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when exports deleted, it should return how many were removed",
+			want:    2,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM user_data_exports").WithArgs(pq.Array(ids)).WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewDataExportRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Delete(context.Background(), ids)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
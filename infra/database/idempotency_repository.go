@@ -0,0 +1,132 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+)
+
+// idempotencyPruneInterval is how often each instance deletes the expired idempotency records.
+const idempotencyPruneInterval = time.Minute
+
+type IdempotencyRepository struct {
+	db *PostgresDB
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+var (
+	_ driven.IdempotencyStore = new(IdempotencyRepository)
+)
+
+func NewIdempotencyRepository(db *PostgresDB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+	}
+}
+
+// Reserve implements driven.IdempotencyStore.
+// An expired record is taken over in place, the lookup of the holding record is retried once
+// in case it expired and was pruned in between.
+func (ir *IdempotencyRepository) Reserve(ctx context.Context, record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	err := ir.prune(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		var key string
+		err = ir.db.Conn().QueryRowContext(ctx, `
+		INSERT INTO
+			idempotency_keys (key, fingerprint, response, expires_at)
+		VALUES
+			($1, $2, NULL, $3)
+		ON CONFLICT (key)
+		DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			response = NULL,
+			expires_at = EXCLUDED.expires_at
+		WHERE
+			idempotency_keys.expires_at <= NOW()
+		RETURNING
+			key
+		`, record.Key, record.Fingerprint, record.ExpiresAt).Scan(&key)
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		existing, err := ir.get(ctx, record.Key)
+		if errors.Is(err, sql.ErrNoRows) && attempt == 0 {
+			continue
+		}
+		return existing, err
+	}
+}
+
+func (ir *IdempotencyRepository) get(ctx context.Context, key string) (*entity.IdempotencyRecord, error) {
+	var record entity.IdempotencyRecord
+	err := ir.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			key,
+			fingerprint,
+			response,
+			expires_at
+		FROM
+			idempotency_keys
+		WHERE
+			key = $1
+	`, key).Scan(&record.Key, &record.Fingerprint, &record.Response, &record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Complete implements driven.IdempotencyStore.
+func (ir *IdempotencyRepository) Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error {
+	_, err := ir.db.Conn().ExecContext(ctx, `
+		UPDATE
+			idempotency_keys
+		SET
+			response = $2,
+			expires_at = $3
+		WHERE
+			key = $1`, key, response, expiresAt)
+	return err
+}
+
+// Release implements driven.IdempotencyStore.
+func (ir *IdempotencyRepository) Release(ctx context.Context, key string) error {
+	_, err := ir.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			idempotency_keys
+		WHERE
+			key = $1`, key)
+	return err
+}
+
+// prune deletes the expired records at most once per idempotencyPruneInterval.
+func (ir *IdempotencyRepository) prune(ctx context.Context) error {
+	ir.mu.Lock()
+	if time.Since(ir.prunedAt) < idempotencyPruneInterval {
+		ir.mu.Unlock()
+		return nil
+	}
+	ir.prunedAt = time.Now()
+	ir.mu.Unlock()
+
+	_, err := ir.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			idempotency_keys
+		WHERE
+			expires_at <= NOW()`)
+	return err
+}
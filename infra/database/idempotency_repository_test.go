@@ -0,0 +1,105 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyRepository_Reserve(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+	record := &entity.IdempotencyRecord{Key: "user:1:key", Fingerprint: "abc", ExpiresAt: expiresAt}
+	columns := []string{"key", "fingerprint", "response", "expires_at"}
+	tests := []struct {
+		name       string
+		want       *entity.IdempotencyRecord
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when prune error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when upsert error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO idempotency_keys").WithArgs("user:1:key", "abc", expiresAt).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when key is free, it should reserve it",
+			want:    nil,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO idempotency_keys").WithArgs("user:1:key", "abc", expiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("user:1:key"))
+			},
+		},
+		{
+			name:    "when key is held, it should return the holding record",
+			want:    &entity.IdempotencyRecord{Key: "user:1:key", Fingerprint: "abc", Response: []byte("response"), ExpiresAt: expiresAt},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO idempotency_keys").WithArgs("user:1:key", "abc", expiresAt).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT (.+) FROM idempotency_keys").WithArgs("user:1:key").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("user:1:key", "abc", []byte("response"), expiresAt))
+			},
+		},
+		{
+			name:    "when holding record is pruned in between, it should try to reserve the key again",
+			want:    nil,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO idempotency_keys").WithArgs("user:1:key", "abc", expiresAt).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT (.+) FROM idempotency_keys").WithArgs("user:1:key").WillReturnRows(sqlmock.NewRows(columns))
+				mock.ExpectQuery("INSERT INTO idempotency_keys").WithArgs("user:1:key", "abc", expiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("user:1:key"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewIdempotencyRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Reserve(context.Background(), record)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestIdempotencyRepository_CompleteAndRelease(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewIdempotencyRepository(&PostgresDB{conn: conn})
+	expiresAt := time.Now().Add(time.Hour)
+
+	dbMock.ExpectExec("UPDATE idempotency_keys").WithArgs("user:1:key", []byte("response"), expiresAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("DELETE FROM idempotency_keys").WithArgs("user:1:key").WillReturnError(errors.New("some database error"))
+
+	assert := assert.New(t)
+	assert.NoError(repo.Complete(context.Background(), "user:1:key", []byte("response"), expiresAt))
+	assert.Error(repo.Release(context.Background(), "user:1:key"))
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
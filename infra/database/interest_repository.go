@@ -0,0 +1,152 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+type InterestRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.InterestStore = new(InterestRepository)
+)
+
+func NewInterestRepository(db *PostgresDB) *InterestRepository {
+	return &InterestRepository{
+		db: db,
+	}
+}
+
+// ListCatalog implements driven.InterestStore.
+func (ir *InterestRepository) ListCatalog(ctx context.Context) ([]*entity.Interest, error) {
+	rows, err := ir.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			slug,
+			name,
+			category
+		FROM
+			interests
+		ORDER BY
+			category,
+			name`)
+	if err != nil {
+		return nil, err
+	}
+	return scanInterests(rows)
+}
+
+// ListByUserID implements driven.InterestStore.
+func (ir *InterestRepository) ListByUserID(ctx context.Context, userID int64) ([]*entity.Interest, error) {
+	rows, err := ir.db.Conn().QueryContext(ctx, `
+		SELECT
+			interests.id,
+			interests.slug,
+			interests.name,
+			interests.category
+		FROM
+			user_interests
+			JOIN interests ON interests.id = user_interests.interest_id
+		WHERE
+			user_interests.user_id = $1
+		ORDER BY
+			interests.category,
+			interests.name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	return scanInterests(rows)
+}
+
+// SetForUser implements driven.InterestStore.
+func (ir *InterestRepository) SetForUser(ctx context.Context, userID int64, interestIDs []int64) error {
+	tx, err := ir.db.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			user_interests
+		WHERE
+			user_id = $1
+			AND interest_id <> ALL($2::int[])`, userID, pq.Array(interestIDs))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO
+			user_interests (user_id, interest_id)
+		SELECT
+			$1, interest_id
+		FROM
+			unnest($2::int[]) AS interest_id
+		ON CONFLICT (user_id, interest_id)
+		DO NOTHING`, userID, pq.Array(interestIDs))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListSharingUsers implements driven.InterestStore.
+func (ir *InterestRepository) ListSharingUsers(ctx context.Context, userID int64, limit int) ([]*entity.SharedInterests, error) {
+	rows, err := ir.db.Conn().QueryContext(ctx, `
+		SELECT
+			others.user_id,
+			ARRAY_AGG(others.interest_id ORDER BY others.interest_id)
+		FROM
+			user_interests AS mine
+			JOIN user_interests AS others ON others.interest_id = mine.interest_id
+				AND others.user_id <> mine.user_id
+			JOIN users ON users.id = others.user_id
+				AND users.deleted_at IS NULL
+		WHERE
+			mine.user_id = $1
+		GROUP BY
+			others.user_id
+		ORDER BY
+			COUNT(*) DESC,
+			others.user_id
+		LIMIT
+			$2`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shared := []*entity.SharedInterests{}
+	for rows.Next() {
+		var sharing entity.SharedInterests
+		if err := rows.Scan(&sharing.UserID, pq.Array(&sharing.InterestIDs)); err != nil {
+			return nil, err
+		}
+		shared = append(shared, &sharing)
+	}
+	return shared, rows.Err()
+}
+
+func scanInterests(rows *sql.Rows) ([]*entity.Interest, error) {
+	defer rows.Close()
+
+	interests := []*entity.Interest{}
+	for rows.Next() {
+		var interest entity.Interest
+		if err := rows.Scan(&interest.ID, &interest.Slug, &interest.Name, &interest.Category); err != nil {
+			return nil, err
+		}
+		interests = append(interests, &interest)
+	}
+	return interests, rows.Err()
+}
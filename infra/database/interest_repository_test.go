@@ -0,0 +1,150 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterestRepository_ListByUserID(t *testing.T) {
+	columns := []string{"id", "slug", "name", "category"}
+	tests := []struct {
+		name       string
+		want       []*entity.Interest
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_interests").WithArgs(int64(1)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when user has no interests, it should return an empty list",
+			want: []*entity.Interest{},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_interests").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when user has interests, it should return them",
+			want: []*entity.Interest{
+				{ID: 12, Slug: "coffee", Name: "Coffee", Category: "Food & Drink"},
+				{ID: 1, Slug: "hiking", Name: "Hiking", Category: "Outdoors"},
+			},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(12, "coffee", "Coffee", "Food & Drink").
+					AddRow(1, "hiking", "Hiking", "Outdoors")
+				mock.ExpectQuery("SELECT (.+) FROM user_interests JOIN interests").WithArgs(int64(1)).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewInterestRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListByUserID(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.want, got)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInterestRepository_SetForUser(t *testing.T) {
+	interestIDs := []int64{1, 12}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when insert error, it should rollback",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_interests").WithArgs(int64(1), pq.Array(interestIDs)).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO user_interests").WithArgs(int64(1), pq.Array(interestIDs)).WillReturnError(errors.New("some database error"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "when success, it should replace the interests in one transaction",
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_interests").WithArgs(int64(1), pq.Array(interestIDs)).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO user_interests").WithArgs(int64(1), pq.Array(interestIDs)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewInterestRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.SetForUser(context.Background(), 1, interestIDs)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInterestRepository_ListSharingUsers(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewInterestRepository(&PostgresDB{conn: conn})
+
+	rows := sqlmock.NewRows([]string{"user_id", "interest_ids"}).
+		AddRow(3, "{1,12,30}").
+		AddRow(2, "{12}")
+	dbMock.ExpectQuery("SELECT (.+) FROM user_interests AS mine JOIN user_interests AS others").WithArgs(int64(1), 20).WillReturnRows(rows)
+
+	got, err := repo.ListSharingUsers(context.Background(), 1, 20)
+
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.Equal([]*entity.SharedInterests{
+		{UserID: 3, InterestIDs: []int64{1, 12, 30}},
+		{UserID: 2, InterestIDs: []int64{12}},
+	}, got)
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
+
+func TestInterestRepository_ListSharingUsers_leaveOutDeletedAccounts(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewInterestRepository(&PostgresDB{conn: conn})
+
+	dbMock.ExpectQuery("JOIN users ON users.id = others.user_id AND users.deleted_at IS NULL").
+		WithArgs(int64(1), 20).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "interest_ids"}))
+
+	got, err := repo.ListSharingUsers(context.Background(), 1, 20)
+
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.Empty(got)
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+
+	"github.com/lib/pq"
+)
+
+type LocationRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.LocationStore = new(LocationRepository)
+)
+
+func NewLocationRepository(db *PostgresDB) *LocationRepository {
+	return &LocationRepository{
+		db: db,
+	}
+}
+
+// GetByUserID implements driven.LocationStore.
+func (lr *LocationRepository) GetByUserID(ctx context.Context, userID int64) (*entity.Location, error) {
+	var location entity.Location
+	err := lr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			user_id,
+			latitude,
+			longitude,
+			geohash,
+			updated_at
+		FROM
+			user_locations
+		WHERE
+			user_id = $1
+	`, userID).Scan(
+		&location.UserID,
+		&location.Latitude,
+		&location.Longitude,
+		&location.Geohash,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// Save implements driven.LocationStore.
+func (lr *LocationRepository) Save(ctx context.Context, location *entity.Location) error {
+	return lr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		user_locations (user_id, latitude, longitude, geohash)
+	VALUES
+		($1, $2, $3, $4)
+	ON CONFLICT (user_id)
+	DO UPDATE SET
+		latitude = EXCLUDED.latitude,
+		longitude = EXCLUDED.longitude,
+		geohash = EXCLUDED.geohash,
+		updated_at = NOW()
+	RETURNING
+		updated_at
+	`,
+		location.UserID,
+		location.Latitude,
+		location.Longitude,
+		location.Geohash,
+	).Scan(&location.UpdatedAt)
+}
+
+// ListNearby implements driven.LocationStore. Every geohash prefix of the coverage is an index range scan,
+// the candidates in the corners of the cells are dropped by their haversine distance before the limit applies.
+func (lr *LocationRepository) ListNearby(ctx context.Context, center *entity.Location, radiusKM float64, limit int) ([]*entity.Location, error) {
+	prefixes := entity.GeohashCoverage(center.Latitude, center.Longitude, radiusKM)
+	rows, err := lr.db.Conn().QueryContext(ctx, `
+		SELECT
+			user_id,
+			latitude,
+			longitude,
+			geohash,
+			updated_at
+		FROM (
+			SELECT
+				user_locations.user_id,
+				user_locations.latitude,
+				user_locations.longitude,
+				user_locations.geohash,
+				user_locations.updated_at,
+				2 * $3 * asin(least(1, sqrt(
+					sin(radians(user_locations.latitude - $4) / 2) ^ 2 +
+					cos(radians($4)) * cos(radians(user_locations.latitude)) *
+					sin(radians(user_locations.longitude - $5) / 2) ^ 2
+				))) AS distance_km
+			FROM
+				unnest($1::text[]) AS cell(prefix)
+				JOIN user_locations ON user_locations.geohash >= cell.prefix
+					AND user_locations.geohash < cell.prefix || '~'
+				JOIN users ON users.id = user_locations.user_id
+					AND users.deleted_at IS NULL
+			WHERE
+				user_locations.user_id <> $2
+		) AS candidates
+		WHERE
+			distance_km <= $6
+		ORDER BY
+			distance_km,
+			user_id
+		LIMIT $7`,
+		pq.Array(prefixes),
+		center.UserID,
+		entity.EarthRadiusKM,
+		center.Latitude,
+		center.Longitude,
+		radiusKM,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := []*entity.Location{}
+	for rows.Next() {
+		var location entity.Location
+		err := rows.Scan(&location.UserID, &location.Latitude, &location.Longitude, &location.Geohash, &location.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, &location)
+	}
+	return locations, rows.Err()
+}
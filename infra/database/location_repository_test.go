@@ -0,0 +1,160 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationRepository_GetByUserID(t *testing.T) {
+	now := time.Now()
+	columns := []string{"user_id", "latitude", "longitude", "geohash", "updated_at"}
+	tests := []struct {
+		name       string
+		want       *entity.Location
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when user has no location, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_locations").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when user has a location, it should return it",
+			want: &entity.Location{UserID: 1, Latitude: -6.2, Longitude: 106.8, Geohash: "qqgux", UpdatedAt: now},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_locations").WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, -6.2, 106.8, "qqgux", now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewLocationRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.GetByUserID(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLocationRepository_Save(t *testing.T) {
+	now := time.Now()
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewLocationRepository(&PostgresDB{conn: conn})
+	location := &entity.Location{UserID: 1, Latitude: -6.2, Longitude: 106.8, Geohash: "qqguxv2jd"}
+
+	dbMock.ExpectQuery("INSERT INTO user_locations").WithArgs(int64(1), -6.2, 106.8, "qqguxv2jd").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(now))
+
+	assert := assert.New(t)
+	assert.NoError(repo.Save(context.Background(), location))
+	assert.Equal(now, location.UpdatedAt)
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
+
+func TestLocationRepository_ListNearby(t *testing.T) {
+	now := time.Now()
+	center := &entity.Location{UserID: 1, Latitude: -6.2, Longitude: 106.8}
+	columns := []string{"user_id", "latitude", "longitude", "geohash", "updated_at"}
+	tests := []struct {
+		name       string
+		radiusKM   float64
+		limit      int
+		wantIDs    []int64
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:     "when error on db, it should return error",
+			radiusKM: 50,
+			limit:    10,
+			wantErr:  true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM unnest").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:     "when candidates are found, it should return the ones within the radius closest first",
+			radiusKM: 50,
+			limit:    10,
+			wantIDs:  []int64{3, 2},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(3, -6.21, 106.81, "qqgu", now). // about 1.5 km
+					AddRow(2, -6.5, 106.8, "qqgu", now)    // about 33 km
+				mock.ExpectQuery("SELECT (.+) FROM unnest(.+) JOIN user_locations (.+) WHERE distance_km <= (.+) ORDER BY distance_km, user_id LIMIT").
+					WithArgs(
+						pq.Array([]string{"qqd", "qqe", "qqf", "qqg", "qqs", "qqu", "qr4", "qr5", "qrh"}),
+						int64(1), entity.EarthRadiusKM, -6.2, 106.8, float64(50), 10,
+					).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:     "when the radius is wider than the coarsest cells, it should not scan past them",
+			radiusKM: 5000,
+			limit:    10,
+			wantIDs:  []int64{},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM unnest").
+					WithArgs(
+						pq.Array([]string{"qj", "qm", "qn", "qp", "qq", "qr", "qt", "qw", "qx"}),
+						int64(1), entity.EarthRadiusKM, -6.2, 106.8, float64(5000), 10,
+					).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name:     "when listing nearby users, it should leave out deleted accounts",
+			radiusKM: 50,
+			limit:    10,
+			wantIDs:  []int64{},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("JOIN users ON users.id = user_locations.user_id AND users.deleted_at IS NULL").
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewLocationRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListNearby(context.Background(), center, tt.radiusKM, tt.limit)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				gotIDs := []int64{}
+				for _, location := range got {
+					gotIDs = append(gotIDs, location.UserID)
+				}
+				assert.Equal(tt.wantIDs, gotIDs)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
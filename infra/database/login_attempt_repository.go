@@ -0,0 +1,89 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type LoginAttemptRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.LoginAttemptStore = new(LoginAttemptRepository)
+)
+
+func NewLoginAttemptRepository(db *PostgresDB) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		db: db,
+	}
+}
+
+// Get implements driven.LoginAttemptStore.
+func (lr *LoginAttemptRepository) Get(ctx context.Context, key string) (*entity.LoginAttempt, error) {
+	rows, err := lr.db.Conn().QueryContext(ctx, `
+		SELECT
+			key,
+			failed_count,
+			last_failed_at
+		FROM
+			login_attempts
+		WHERE
+			key = $1
+		LIMIT
+			1
+	`, key)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	attempt := entity.LoginAttempt{Key: key}
+	if rows.Next() {
+		err = rows.Scan(
+			&attempt.Key,
+			&attempt.FailedCount,
+			&attempt.LastFailedAt,
+		)
+	}
+
+	return &attempt, err
+}
+
+// IncrementFailure implements driven.LoginAttemptStore.
+func (lr *LoginAttemptRepository) IncrementFailure(ctx context.Context, key string, window time.Duration) (*entity.LoginAttempt, error) {
+	var attempt entity.LoginAttempt
+	err := lr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		login_attempts (key, failed_count, last_failed_at)
+	VALUES
+		($1, 1, NOW())
+	ON CONFLICT (key)
+	DO UPDATE SET
+		failed_count = CASE
+			WHEN $2 > 0 AND login_attempts.last_failed_at < NOW() - $2 * INTERVAL '1 second' THEN 1
+			ELSE login_attempts.failed_count + 1
+		END,
+		last_failed_at = NOW()
+	RETURNING
+		key, failed_count, last_failed_at
+	`, key, int64(window.Seconds())).Scan(&attempt.Key, &attempt.FailedCount, &attempt.LastFailedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+// Reset implements driven.LoginAttemptStore.
+func (lr *LoginAttemptRepository) Reset(ctx context.Context, keys ...string) error {
+	_, err := lr.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			login_attempts
+		WHERE
+			key = ANY($1)`, pq.Array(keys))
+	return err
+}
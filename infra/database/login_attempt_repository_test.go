@@ -0,0 +1,147 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginAttemptRepository_Get(t *testing.T) {
+	lastFailedAt := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.LoginAttempt
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when query error, it should return error",
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM login_attempts").WithArgs("username:john").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when key never failed, it should return zero attempt",
+			want:    &entity.LoginAttempt{Key: "username:john"},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM login_attempts").WithArgs("username:john").
+					WillReturnRows(sqlmock.NewRows([]string{"key", "failed_count", "last_failed_at"}))
+			},
+		},
+		{
+			name:    "when key failed before, it should return the attempt",
+			want:    &entity.LoginAttempt{Key: "username:john", FailedCount: 3, LastFailedAt: lastFailedAt},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM login_attempts").WithArgs("username:john").
+					WillReturnRows(sqlmock.NewRows([]string{"key", "failed_count", "last_failed_at"}).AddRow("username:john", 3, lastFailedAt))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewLoginAttemptRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Get(context.Background(), "username:john")
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLoginAttemptRepository_IncrementFailure(t *testing.T) {
+	lastFailedAt := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.LoginAttempt
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when upsert error, it should return error",
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO login_attempts").WithArgs("ip:10.0.0.1", int64(900)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should return the counted attempt",
+			want:    &entity.LoginAttempt{Key: "ip:10.0.0.1", FailedCount: 4, LastFailedAt: lastFailedAt},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO login_attempts").WithArgs("ip:10.0.0.1", int64(900)).
+					WillReturnRows(sqlmock.NewRows([]string{"key", "failed_count", "last_failed_at"}).AddRow("ip:10.0.0.1", 4, lastFailedAt))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewLoginAttemptRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.IncrementFailure(context.Background(), "ip:10.0.0.1", 15*time.Minute)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLoginAttemptRepository_Reset(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when delete error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM login_attempts").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should delete the keys",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM login_attempts").WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewLoginAttemptRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Reset(context.Background(), "username:john", "ip:10.0.0.1")
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+)
+
+type MFAChallengeRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.MFAChallengeStore = new(MFAChallengeRepository)
+)
+
+func NewMFAChallengeRepository(db *PostgresDB) *MFAChallengeRepository {
+	return &MFAChallengeRepository{
+		db: db,
+	}
+}
+
+// Create implements driven.MFAChallengeStore.
+func (mr *MFAChallengeRepository) Create(ctx context.Context, challenge *entity.MFAChallenge) error {
+	return mr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		mfa_challenges (user_id, expires_at)
+	VALUES
+		($1, $2)
+	RETURNING
+		id, created_at
+	`, challenge.UserID, challenge.ExpiresAt).Scan(&challenge.ID, &challenge.CreatedAt)
+}
+
+// GetByID implements driven.MFAChallengeStore.
+func (mr *MFAChallengeRepository) GetByID(ctx context.Context, id string) (*entity.MFAChallenge, error) {
+	rows, err := mr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			attempts,
+			expires_at,
+			consumed_at,
+			created_at
+		FROM
+			mfa_challenges
+		WHERE
+			id = $1
+		LIMIT
+			1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	var challenge entity.MFAChallenge
+	if rows.Next() {
+		err = rows.Scan(
+			&challenge.ID,
+			&challenge.UserID,
+			&challenge.Attempts,
+			&challenge.ExpiresAt,
+			&challenge.ConsumedAt,
+			&challenge.CreatedAt,
+		)
+	} else {
+		return nil, sql.ErrNoRows
+	}
+
+	return &challenge, err
+}
+
+// IncrementAttempts implements driven.MFAChallengeStore.
+func (mr *MFAChallengeRepository) IncrementAttempts(ctx context.Context, id string) error {
+	_, err := mr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			mfa_challenges
+		SET
+			attempts = attempts + 1
+		WHERE
+			id = $1`, id)
+	return err
+}
+
+// Consume implements driven.MFAChallengeStore.
+func (mr *MFAChallengeRepository) Consume(ctx context.Context, id string) (bool, error) {
+	result, err := mr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			mfa_challenges
+		SET
+			consumed_at = NOW()
+		WHERE
+			id = $1
+			AND consumed_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMFAChallengeRepository_Create(t *testing.T) {
+	challenge := &entity.MFAChallenge{UserID: 123, ExpiresAt: time.Now().Add(5 * time.Minute)}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO mfa_challenges").WithArgs(challenge.UserID, challenge.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill the generated id",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO mfa_challenges").WithArgs(challenge.UserID, challenge.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("0f8fad5b-d9cb-469f-a165-70867728950e", time.Now()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewMFAChallengeRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), challenge)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal("0f8fad5b-d9cb-469f-a165-70867728950e", challenge.ID)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMFAChallengeRepository_GetByID(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.MFAChallenge
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock, *entity.MFAChallenge)
+	}{
+		{
+			name:    "when record not found, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.MFAChallenge) {
+				mock.ExpectQuery("SELECT").WithArgs("challenge-id").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when record found, it should return challenge",
+			want: &entity.MFAChallenge{
+				ID:        "challenge-id",
+				UserID:    123,
+				Attempts:  2,
+				ExpiresAt: now.Add(5 * time.Minute),
+				CreatedAt: now,
+			},
+			expectFunc: func(mock sqlmock.Sqlmock, challenge *entity.MFAChallenge) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "attempts", "expires_at", "consumed_at", "created_at"}).
+					AddRow(challenge.ID, challenge.UserID, challenge.Attempts, challenge.ExpiresAt, nil, challenge.CreatedAt)
+				mock.ExpectQuery("SELECT (.+) FROM mfa_challenges").WithArgs("challenge-id").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewMFAChallengeRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetByID(context.Background(), "challenge-id")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMFAChallengeRepository_Consume(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewMFAChallengeRepository(&PostgresDB{conn: conn})
+
+	dbMock.ExpectExec("UPDATE mfa_challenges SET attempts").WithArgs("challenge-id").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("UPDATE mfa_challenges SET consumed_at").WithArgs("challenge-id").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("UPDATE mfa_challenges SET consumed_at").WithArgs("challenge-id").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert := assert.New(t)
+	assert.NoError(repo.IncrementAttempts(context.Background(), "challenge-id"))
+
+	consumed, err := repo.Consume(context.Background(), "challenge-id")
+	assert.NoError(err)
+	assert.True(consumed)
+
+	consumed, err = repo.Consume(context.Background(), "challenge-id")
+	assert.NoError(err)
+	assert.False(consumed, "it should only complete a challenge once")
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
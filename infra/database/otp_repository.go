@@ -0,0 +1,115 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+)
+
+type OTPRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.OTPStore = new(OTPRepository)
+)
+
+func NewOTPRepository(db *PostgresDB) *OTPRepository {
+	return &OTPRepository{
+		db: db,
+	}
+}
+
+// Create implements driven.OTPStore.
+func (otpr *OTPRepository) Create(ctx context.Context, otp *entity.OneTimePassword) error {
+	return otpr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		one_time_passwords (user_id, purpose, code_hash, max_attempts, expires_at)
+	VALUES
+		($1, $2, $3, $4, $5)
+	RETURNING
+		id, created_at
+	`, otp.UserID, otp.Purpose, otp.CodeHash, otp.MaxAttempts, otp.ExpiresAt).Scan(&otp.ID, &otp.CreatedAt)
+}
+
+// GetLatest implements driven.OTPStore.
+func (otpr *OTPRepository) GetLatest(ctx context.Context, userID int64, purpose entity.OTPPurpose) (*entity.OneTimePassword, error) {
+	rows, err := otpr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			purpose,
+			code_hash,
+			attempts,
+			max_attempts,
+			expires_at,
+			consumed_at,
+			created_at
+		FROM
+			one_time_passwords
+		WHERE
+			user_id = $1
+			AND purpose = $2
+		ORDER BY
+			created_at DESC, id DESC
+		LIMIT
+			1
+	`, userID, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	var otp entity.OneTimePassword
+	if rows.Next() {
+		err = rows.Scan(
+			&otp.ID,
+			&otp.UserID,
+			&otp.Purpose,
+			&otp.CodeHash,
+			&otp.Attempts,
+			&otp.MaxAttempts,
+			&otp.ExpiresAt,
+			&otp.ConsumedAt,
+			&otp.CreatedAt,
+		)
+	} else {
+		return nil, sql.ErrNoRows
+	}
+
+	return &otp, err
+}
+
+// IncrementAttempts implements driven.OTPStore.
+func (otpr *OTPRepository) IncrementAttempts(ctx context.Context, id int64) error {
+	_, err := otpr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			one_time_passwords
+		SET
+			attempts = attempts + 1
+		WHERE
+			id = $1`, id)
+	return err
+}
+
+// Consume implements driven.OTPStore.
+func (otpr *OTPRepository) Consume(ctx context.Context, id int64) (bool, error) {
+	result, err := otpr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			one_time_passwords
+		SET
+			consumed_at = NOW()
+		WHERE
+			id = $1
+			AND consumed_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTPRepository_Create(t *testing.T) {
+	otp := &entity.OneTimePassword{
+		UserID:      123,
+		Purpose:     entity.OTPPurposePhoneVerification,
+		CodeHash:    "hash",
+		MaxAttempts: 5,
+		ExpiresAt:   time.Now().Add(5 * time.Minute),
+	}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO one_time_passwords").
+					WithArgs(otp.UserID, otp.Purpose, otp.CodeHash, otp.MaxAttempts, otp.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill id",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO one_time_passwords").
+					WithArgs(otp.UserID, otp.Purpose, otp.CodeHash, otp.MaxAttempts, otp.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, time.Now()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewOTPRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), otp)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(int64(10), otp.ID)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOTPRepository_GetLatest(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.OneTimePassword
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock, *entity.OneTimePassword)
+	}{
+		{
+			name:    "when record not found, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.OneTimePassword) {
+				mock.ExpectQuery("SELECT").WithArgs(int64(123), entity.OTPPurposePhoneVerification).WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when record found, it should return the latest code",
+			want: &entity.OneTimePassword{
+				ID:          10,
+				UserID:      123,
+				Purpose:     entity.OTPPurposePhoneVerification,
+				CodeHash:    "hash",
+				Attempts:    1,
+				MaxAttempts: 5,
+				ExpiresAt:   now.Add(5 * time.Minute),
+				CreatedAt:   now,
+			},
+			expectFunc: func(mock sqlmock.Sqlmock, otp *entity.OneTimePassword) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "purpose", "code_hash", "attempts", "max_attempts", "expires_at", "consumed_at", "created_at"}).
+					AddRow(otp.ID, otp.UserID, otp.Purpose, otp.CodeHash, otp.Attempts, otp.MaxAttempts, otp.ExpiresAt, nil, otp.CreatedAt)
+				mock.ExpectQuery("SELECT (.+) FROM one_time_passwords (.+) ORDER BY").WithArgs(int64(123), entity.OTPPurposePhoneVerification).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewOTPRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetLatest(context.Background(), 123, entity.OTPPurposePhoneVerification)
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOTPRepository_IncrementAttempts(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewOTPRepository(&PostgresDB{conn: conn})
+
+	dbMock.ExpectExec("UPDATE one_time_passwords SET attempts = attempts \\+ 1").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert := assert.New(t)
+	assert.NoError(repo.IncrementAttempts(context.Background(), 10))
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
+
+func TestOTPRepository_Consume(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       bool
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE one_time_passwords").WithArgs(int64(10)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when code already consumed, it should return false",
+			want: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE one_time_passwords").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when code consumed, it should return true",
+			want: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE one_time_passwords").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewOTPRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Consume(context.Background(), 10)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"app/internal/user/port/driven"
+	"context"
+)
+
+type PasswordHistoryRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.PasswordHistoryStore = new(PasswordHistoryRepository)
+)
+
+func NewPasswordHistoryRepository(db *PostgresDB) *PasswordHistoryRepository {
+	return &PasswordHistoryRepository{
+		db: db,
+	}
+}
+
+// Add implements driven.PasswordHistoryStore.
+func (pr *PasswordHistoryRepository) Add(ctx context.Context, userID int64, passwordHash string, keep int) error {
+	tx, err := pr.db.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO
+			user_password_history (user_id, password_hash)
+		VALUES
+			($1, $2)`, userID, passwordHash)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			user_password_history
+		WHERE
+			user_id = $1
+			AND id NOT IN (
+				SELECT
+					id
+				FROM
+					user_password_history
+				WHERE
+					user_id = $1
+				ORDER BY
+					id DESC
+				LIMIT
+					$2
+			)`, userID, keep)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListRecent implements driven.PasswordHistoryStore.
+func (pr *PasswordHistoryRepository) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	rows, err := pr.db.Conn().QueryContext(ctx, `
+		SELECT
+			password_hash
+		FROM
+			user_password_history
+		WHERE
+			user_id = $1
+		ORDER BY
+			id DESC
+		LIMIT
+			$2
+	`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	hashes := make([]string, 0, limit)
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, rows.Err()
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordHistoryRepository_Add(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when prune error, it should rollback",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO user_password_history").WithArgs(int64(123), "old-hash").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM user_password_history").WithArgs(int64(123), 4).WillReturnError(errors.New("some database error"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "when success, it should insert and prune in one transaction",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO user_password_history").WithArgs(int64(123), "old-hash").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM user_password_history").WithArgs(int64(123), 4).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPasswordHistoryRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Add(context.Background(), 123, "old-hash", 4)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPasswordHistoryRepository_ListRecent(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       []string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT password_hash FROM user_password_history").WithArgs(int64(123), 4).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when history found, it should return the hashes",
+			want: []string{"newer-hash", "older-hash"},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"password_hash"}).AddRow("newer-hash").AddRow("older-hash")
+				mock.ExpectQuery("SELECT password_hash FROM user_password_history").WithArgs(int64(123), 4).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPasswordHistoryRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListRecent(context.Background(), 123, 4)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.want, got)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+)
+
+type PasswordResetTicketRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.PasswordResetTicketStore = new(PasswordResetTicketRepository)
+)
+
+func NewPasswordResetTicketRepository(db *PostgresDB) *PasswordResetTicketRepository {
+	return &PasswordResetTicketRepository{
+		db: db,
+	}
+}
+
+// Create implements driven.PasswordResetTicketStore.
+func (pr *PasswordResetTicketRepository) Create(ctx context.Context, ticket *entity.PasswordResetTicket) error {
+	return pr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		password_reset_tickets (user_id, ticket_hash, expires_at)
+	VALUES
+		($1, $2, $3)
+	RETURNING
+		id, created_at
+	`, ticket.UserID, ticket.TicketHash, ticket.ExpiresAt).Scan(&ticket.ID, &ticket.CreatedAt)
+}
+
+// GetByHash implements driven.PasswordResetTicketStore.
+func (pr *PasswordResetTicketRepository) GetByHash(ctx context.Context, ticketHash string) (*entity.PasswordResetTicket, error) {
+	rows, err := pr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			ticket_hash,
+			expires_at,
+			used_at,
+			created_at
+		FROM
+			password_reset_tickets
+		WHERE
+			ticket_hash = $1
+		LIMIT
+			1
+	`, ticketHash)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	var ticket entity.PasswordResetTicket
+	if rows.Next() {
+		err = rows.Scan(
+			&ticket.ID,
+			&ticket.UserID,
+			&ticket.TicketHash,
+			&ticket.ExpiresAt,
+			&ticket.UsedAt,
+			&ticket.CreatedAt,
+		)
+	} else {
+		return nil, sql.ErrNoRows
+	}
+
+	return &ticket, err
+}
+
+// MarkUsed implements driven.PasswordResetTicketStore.
+func (pr *PasswordResetTicketRepository) MarkUsed(ctx context.Context, id int64) (bool, error) {
+	result, err := pr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			password_reset_tickets
+		SET
+			used_at = NOW()
+		WHERE
+			id = $1
+			AND used_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
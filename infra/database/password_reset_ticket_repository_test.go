@@ -0,0 +1,160 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordResetTicketRepository_Create(t *testing.T) {
+	ticket := &entity.PasswordResetTicket{
+		UserID:     123,
+		TicketHash: "hash",
+		ExpiresAt:  time.Now().Add(10 * time.Minute),
+	}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO password_reset_tickets").
+					WithArgs(ticket.UserID, ticket.TicketHash, ticket.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill id",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO password_reset_tickets").
+					WithArgs(ticket.UserID, ticket.TicketHash, ticket.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, time.Now()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPasswordResetTicketRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), ticket)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(int64(10), ticket.ID)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPasswordResetTicketRepository_GetByHash(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.PasswordResetTicket
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock, *entity.PasswordResetTicket)
+	}{
+		{
+			name:    "when record not found, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.PasswordResetTicket) {
+				mock.ExpectQuery("SELECT").WithArgs("hash").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when record found, it should return ticket",
+			want: &entity.PasswordResetTicket{
+				ID:         10,
+				UserID:     123,
+				TicketHash: "hash",
+				ExpiresAt:  now.Add(10 * time.Minute),
+				UsedAt:     &now,
+				CreatedAt:  now,
+			},
+			expectFunc: func(mock sqlmock.Sqlmock, ticket *entity.PasswordResetTicket) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "ticket_hash", "expires_at", "used_at", "created_at"}).
+					AddRow(ticket.ID, ticket.UserID, ticket.TicketHash, ticket.ExpiresAt, *ticket.UsedAt, ticket.CreatedAt)
+				mock.ExpectQuery("SELECT (.+) FROM password_reset_tickets").WithArgs("hash").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPasswordResetTicketRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetByHash(context.Background(), "hash")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPasswordResetTicketRepository_MarkUsed(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       bool
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE password_reset_tickets").WithArgs(int64(10)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when ticket already used, it should return false",
+			want: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE password_reset_tickets").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when ticket marked, it should return true",
+			want: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE password_reset_tickets").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPasswordResetTicketRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.MarkUsed(context.Background(), 10)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"context"
+
+	"github.com/lib/pq"
+)
+
+type PhotoRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.PhotoStore = new(PhotoRepository)
+)
+
+func NewPhotoRepository(db *PostgresDB) *PhotoRepository {
+	return &PhotoRepository{
+		db: db,
+	}
+}
+
+// ListByUserID implements driven.PhotoStore.
+func (pr *PhotoRepository) ListByUserID(ctx context.Context, userID int64) ([]*entity.Photo, error) {
+	rows, err := pr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			position,
+			created_at
+		FROM
+			profile_photos
+		WHERE
+			user_id = $1
+		ORDER BY
+			position`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := []*entity.Photo{}
+	for rows.Next() {
+		var photo entity.Photo
+		if err := rows.Scan(&photo.ID, &photo.UserID, &photo.Position, &photo.CreatedAt); err != nil {
+			return nil, err
+		}
+		photos = append(photos, &photo)
+	}
+	return photos, rows.Err()
+}
+
+// GetByID implements driven.PhotoStore.
+func (pr *PhotoRepository) GetByID(ctx context.Context, id string) (*entity.Photo, error) {
+	var photo entity.Photo
+	err := pr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			profile_photos.id,
+			profile_photos.user_id,
+			profile_photos.position,
+			profile_photos.created_at
+		FROM
+			profile_photos
+			JOIN users ON users.id = profile_photos.user_id
+				AND users.deleted_at IS NULL
+		WHERE
+			profile_photos.id = $1
+	`, id).Scan(&photo.ID, &photo.UserID, &photo.Position, &photo.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &photo, nil
+}
+
+// Create implements driven.PhotoStore.
+func (pr *PhotoRepository) Create(ctx context.Context, photo *entity.Photo) error {
+	return pr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		profile_photos (id, user_id, position)
+	VALUES
+		($1, $2, $3)
+	RETURNING
+		created_at
+	`, photo.ID, photo.UserID, photo.Position).Scan(&photo.CreatedAt)
+}
+
+// Delete implements driven.PhotoStore.
+func (pr *PhotoRepository) Delete(ctx context.Context, userID int64, id string) error {
+	tx, err := pr.db.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var position int
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM
+			profile_photos
+		WHERE
+			id = $1
+			AND user_id = $2
+		RETURNING
+			position`, id, userID).Scan(&position)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE
+			profile_photos
+		SET
+			position = position - 1
+		WHERE
+			user_id = $1
+			AND position > $2`, userID, position)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Reorder implements driven.PhotoStore.
+func (pr *PhotoRepository) Reorder(ctx context.Context, userID int64, ids []string) error {
+	_, err := pr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			profile_photos
+		SET
+			position = ordered.ordinality - 1
+		FROM
+			unnest($2::text[]) WITH ORDINALITY AS ordered(id, ordinality)
+		WHERE
+			profile_photos.id = ordered.id
+			AND profile_photos.user_id = $1`, userID, pq.Array(ids))
+	return err
+}
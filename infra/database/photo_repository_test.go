@@ -0,0 +1,185 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhotoRepository_ListByUserID(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "user_id", "position", "created_at"}
+	tests := []struct {
+		name       string
+		want       []*entity.Photo
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM profile_photos").WithArgs(int64(1)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when user has no photos, it should return an empty list",
+			want: []*entity.Photo{},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM profile_photos").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when user has photos, it should return them in order",
+			want: []*entity.Photo{
+				{ID: "photo-1", UserID: 1, Position: 0, CreatedAt: now},
+				{ID: "photo-2", UserID: 1, Position: 1, CreatedAt: now},
+			},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow("photo-1", 1, 0, now).AddRow("photo-2", 1, 1, now)
+				mock.ExpectQuery("SELECT (.+) FROM profile_photos (.+) ORDER BY position").WithArgs(int64(1)).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPhotoRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListByUserID(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.want, got)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPhotoRepository_GetByID(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "user_id", "position", "created_at"}
+	tests := []struct {
+		name       string
+		want       *entity.Photo
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when photo not found or its owner is deleted, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM profile_photos JOIN users (.+) AND users.deleted_at IS NULL").WithArgs("photo-1").WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when photo found, it should return it",
+			want: &entity.Photo{ID: "photo-1", UserID: 1, Position: 0, CreatedAt: now},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow("photo-1", 1, 0, now)
+				mock.ExpectQuery("SELECT (.+) FROM profile_photos JOIN users (.+) AND users.deleted_at IS NULL").WithArgs("photo-1").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPhotoRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.GetByID(context.Background(), "photo-1")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPhotoRepository_Create(t *testing.T) {
+	now := time.Now()
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewPhotoRepository(&PostgresDB{conn: conn})
+	photo := &entity.Photo{ID: "photo-1", UserID: 1, Position: 2}
+
+	dbMock.ExpectQuery("INSERT INTO profile_photos").WithArgs("photo-1", int64(1), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+	assert := assert.New(t)
+	assert.NoError(repo.Create(context.Background(), photo))
+	assert.Equal(now, photo.CreatedAt)
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
+
+func TestPhotoRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when user has no such photo, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("DELETE FROM profile_photos").WithArgs("photo-1", int64(1)).WillReturnRows(sqlmock.NewRows([]string{"position"}))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "when success, it should move the following photos up in one transaction",
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("DELETE FROM profile_photos").WithArgs("photo-1", int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(0))
+				mock.ExpectExec("UPDATE profile_photos SET position = position - 1").WithArgs(int64(1), 0).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPhotoRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Delete(context.Background(), 1, "photo-1")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPhotoRepository_Reorder(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewPhotoRepository(&PostgresDB{conn: conn})
+	ids := []string{"photo-2", "photo-1"}
+
+	dbMock.ExpectExec("UPDATE profile_photos SET position = ordered.ordinality - 1").WithArgs(int64(1), pq.Array(ids)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert := assert.New(t)
+	assert.NoError(repo.Reorder(context.Background(), 1, ids))
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+
+	"github.com/lib/pq"
+)
+
+type PreferencesRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.PreferencesStore = new(PreferencesRepository)
+)
+
+func NewPreferencesRepository(db *PostgresDB) *PreferencesRepository {
+	return &PreferencesRepository{
+		db: db,
+	}
+}
+
+// GetByUserID implements driven.PreferencesStore.
+func (pr *PreferencesRepository) GetByUserID(ctx context.Context, userID int64) (*entity.Preferences, error) {
+	var (
+		preferences  entity.Preferences
+		interestedIn []string
+	)
+	err := pr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			user_id,
+			interested_in,
+			min_age,
+			max_age,
+			max_distance_km,
+			created_at,
+			updated_at
+		FROM
+			user_preferences
+		WHERE
+			user_id = $1
+	`, userID).Scan(
+		&preferences.UserID,
+		pq.Array(&interestedIn),
+		&preferences.MinAge,
+		&preferences.MaxAge,
+		&preferences.MaxDistanceKM,
+		&preferences.CreatedAt,
+		&preferences.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gender := range interestedIn {
+		preferences.InterestedIn = append(preferences.InterestedIn, entity.Gender(gender))
+	}
+	return &preferences, nil
+}
+
+// Save implements driven.PreferencesStore.
+func (pr *PreferencesRepository) Save(ctx context.Context, preferences *entity.Preferences) error {
+	interestedIn := make([]string, 0, len(preferences.InterestedIn))
+	for _, gender := range preferences.InterestedIn {
+		interestedIn = append(interestedIn, gender.String())
+	}
+
+	return pr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		user_preferences (user_id, interested_in, min_age, max_age, max_distance_km)
+	VALUES
+		($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id)
+	DO UPDATE SET
+		interested_in = EXCLUDED.interested_in,
+		min_age = EXCLUDED.min_age,
+		max_age = EXCLUDED.max_age,
+		max_distance_km = EXCLUDED.max_distance_km,
+		updated_at = NOW()
+	RETURNING
+		created_at, updated_at
+	`,
+		preferences.UserID,
+		pq.Array(interestedIn),
+		preferences.MinAge,
+		preferences.MaxAge,
+		preferences.MaxDistanceKM,
+	).Scan(&preferences.CreatedAt, &preferences.UpdatedAt)
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferencesRepository_GetByUserID(t *testing.T) {
+	now := time.Now()
+	columns := []string{"user_id", "interested_in", "min_age", "max_age", "max_distance_km", "created_at", "updated_at"}
+	tests := []struct {
+		name       string
+		want       *entity.Preferences
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when user has no preferences, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_preferences").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when user has preferences, it should return them",
+			want: &entity.Preferences{UserID: 1, InterestedIn: []entity.Gender{entity.GenderMan, entity.GenderNonBinary}, MinAge: 20, MaxAge: 30, MaxDistanceKM: 25, CreatedAt: now, UpdatedAt: now},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_preferences").WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "{man,non_binary}", 20, 30, 25, now, now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPreferencesRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.GetByUserID(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPreferencesRepository_Save(t *testing.T) {
+	now := time.Now()
+	preferences := &entity.Preferences{UserID: 1, InterestedIn: []entity.Gender{entity.GenderWoman}, MinAge: 18, MaxAge: 28, MaxDistanceKM: 50}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when upsert error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO user_preferences").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should fill the timestamps",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO user_preferences").WithArgs(int64(1), pq.Array([]string{"woman"}), 18, 28, 50).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewPreferencesRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Save(context.Background(), preferences)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(now, preferences.UpdatedAt)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
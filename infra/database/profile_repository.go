@@ -0,0 +1,81 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"context"
+)
+
+type ProfileRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.ProfileStore = new(ProfileRepository)
+)
+
+func NewProfileRepository(db *PostgresDB) *ProfileRepository {
+	return &ProfileRepository{
+		db: db,
+	}
+}
+
+// GetByUserID implements driven.ProfileStore.
+func (pr *ProfileRepository) GetByUserID(ctx context.Context, userID int64) (*entity.Profile, error) {
+	var profile entity.Profile
+	err := pr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			user_id,
+			birthdate,
+			bio,
+			job,
+			education,
+			COALESCE(height_cm, 0),
+			created_at,
+			updated_at
+		FROM
+			profiles
+		WHERE
+			user_id = $1
+	`, userID).Scan(
+		&profile.UserID,
+		&profile.Birthdate,
+		&profile.Bio,
+		&profile.Job,
+		&profile.Education,
+		&profile.HeightCM,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// Save implements driven.ProfileStore.
+func (pr *ProfileRepository) Save(ctx context.Context, profile *entity.Profile) error {
+	return pr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		profiles (user_id, birthdate, bio, job, education, height_cm)
+	VALUES
+		($1, $2, $3, $4, $5, NULLIF($6, 0))
+	ON CONFLICT (user_id)
+	DO UPDATE SET
+		birthdate = EXCLUDED.birthdate,
+		bio = EXCLUDED.bio,
+		job = EXCLUDED.job,
+		education = EXCLUDED.education,
+		height_cm = EXCLUDED.height_cm,
+		updated_at = NOW()
+	RETURNING
+		created_at, updated_at
+	`,
+		profile.UserID,
+		profile.Birthdate.Format(entity.BirthdateLayout),
+		profile.Bio,
+		profile.Job,
+		profile.Education,
+		profile.HeightCM,
+	).Scan(&profile.CreatedAt, &profile.UpdatedAt)
+}
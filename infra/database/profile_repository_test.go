@@ -0,0 +1,102 @@
+package database
+
+import (
+	"app/internal/profile/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileRepository_GetByUserID(t *testing.T) {
+	birthdate := time.Date(1995, time.March, 4, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	columns := []string{"user_id", "birthdate", "bio", "job", "education", "height_cm", "created_at", "updated_at"}
+	tests := []struct {
+		name       string
+		want       *entity.Profile
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when user has no profile, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM profiles").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "when user has a profile, it should return it",
+			want: &entity.Profile{UserID: 1, Birthdate: birthdate, Bio: "hi", Job: "engineer", Education: "ITB", HeightCM: 170, CreatedAt: now, UpdatedAt: now},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM profiles").WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, birthdate, "hi", "engineer", "ITB", 170, now, now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewProfileRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.GetByUserID(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProfileRepository_Save(t *testing.T) {
+	now := time.Now()
+	profile := &entity.Profile{UserID: 1, Birthdate: time.Date(1995, time.March, 4, 0, 0, 0, 0, time.UTC), Bio: "hi"}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when upsert error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO profiles").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should fill the timestamps",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO profiles").WithArgs(int64(1), "1995-03-04", "hi", "", "", 0).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewProfileRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			saved := *profile
+			err := repo.Save(context.Background(), &saved)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(now, saved.UpdatedAt)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+)
+
+// rateLimitPruneInterval is how often each instance deletes the buckets that refilled completely.
+const rateLimitPruneInterval = time.Minute
+
+// RateLimitRepository shares the token buckets between instances, see entity.TokenBucket for how
+// full_at stands for the tokens left.
+type RateLimitRepository struct {
+	db *PostgresDB
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+var (
+	_ driven.RateLimitStore = new(RateLimitRepository)
+)
+
+func NewRateLimitRepository(db *PostgresDB) *RateLimitRepository {
+	return &RateLimitRepository{
+		db: db,
+	}
+}
+
+// Take implements driven.RateLimitStore.
+// The token is only taken when the bucket holds one, so an empty bucket leaves the row untouched.
+func (rr *RateLimitRepository) Take(ctx context.Context, key string, limit entity.RateLimit) (time.Duration, error) {
+	err := rr.prune(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	interval := limit.Interval().Seconds()
+	tolerance := limit.BurstTolerance().Seconds()
+	var fullAt time.Time
+	err = rr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		rate_limit_buckets (key, full_at)
+	VALUES
+		($1, NOW() + $2 * INTERVAL '1 second')
+	ON CONFLICT (key)
+	DO UPDATE SET
+		full_at = GREATEST(rate_limit_buckets.full_at, NOW()) + $2 * INTERVAL '1 second'
+	WHERE
+		rate_limit_buckets.full_at <= NOW() + $3 * INTERVAL '1 second'
+	RETURNING
+		full_at
+	`, key, interval, tolerance).Scan(&fullAt)
+	if err == nil {
+		return 0, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	var wait float64
+	err = rr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			EXTRACT(EPOCH FROM full_at - NOW()) - $2
+		FROM
+			rate_limit_buckets
+		WHERE
+			key = $1
+	`, key, tolerance).Scan(&wait)
+	if errors.Is(err, sql.ErrNoRows) {
+		// pruned in between, the bucket refilled anyway
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait * float64(time.Second)), nil
+}
+
+// prune deletes the buckets that are full again at most once per rateLimitPruneInterval.
+func (rr *RateLimitRepository) prune(ctx context.Context) error {
+	rr.mu.Lock()
+	if time.Since(rr.prunedAt) < rateLimitPruneInterval {
+		rr.mu.Unlock()
+		return nil
+	}
+	rr.prunedAt = time.Now()
+	rr.mu.Unlock()
+
+	_, err := rr.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			rate_limit_buckets
+		WHERE
+			full_at < NOW()`)
+	return err
+}
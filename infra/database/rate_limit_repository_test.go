@@ -0,0 +1,107 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitRepository_Take(t *testing.T) {
+	limit := entity.RateLimit{Requests: 5, Period: 10 * time.Second}
+	tests := []struct {
+		name       string
+		want       time.Duration
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when prune error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when upsert error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO rate_limit_buckets").WithArgs("ip:10.0.0.1", 2.0, 8.0).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when bucket holds a token, it should allow the call",
+			want:    0,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO rate_limit_buckets").WithArgs("ip:10.0.0.1", 2.0, 8.0).
+					WillReturnRows(sqlmock.NewRows([]string{"full_at"}).AddRow(time.Now().Add(2 * time.Second)))
+			},
+		},
+		{
+			name:    "when bucket is empty, it should return how long until the next token",
+			want:    1500 * time.Millisecond,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO rate_limit_buckets").WithArgs("ip:10.0.0.1", 2.0, 8.0).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT (.+) FROM rate_limit_buckets").WithArgs("ip:10.0.0.1", 8.0).
+					WillReturnRows(sqlmock.NewRows([]string{"wait"}).AddRow(1.5))
+			},
+		},
+		{
+			name:    "when bucket is pruned after the upsert, it should allow the next call right away",
+			want:    0,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT INTO rate_limit_buckets").WithArgs("ip:10.0.0.1", 2.0, 8.0).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT (.+) FROM rate_limit_buckets").WithArgs("ip:10.0.0.1", 8.0).
+					WillReturnRows(sqlmock.NewRows([]string{"wait"}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRateLimitRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Take(context.Background(), "ip:10.0.0.1", limit)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRateLimitRepository_Take_prunesOncePerInterval(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewRateLimitRepository(&PostgresDB{conn: conn})
+	limit := entity.RateLimit{Requests: 5, Period: 10 * time.Second}
+
+	dbMock.ExpectExec("DELETE FROM rate_limit_buckets").WillReturnResult(sqlmock.NewResult(0, 3))
+	for i := 0; i < 2; i++ {
+		dbMock.ExpectQuery("INSERT INTO rate_limit_buckets").WithArgs("user:1", 2.0, 8.0).
+			WillReturnRows(sqlmock.NewRows([]string{"full_at"}).AddRow(time.Now()))
+	}
+
+	assert := assert.New(t)
+	for i := 0; i < 2; i++ {
+		_, err := repo.Take(context.Background(), "user:1", limit)
+		assert.NoError(err)
+	}
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"app/internal/user/port/driven"
+	"context"
+)
+
+type RecoveryCodeRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.RecoveryCodeStore = new(RecoveryCodeRepository)
+)
+
+func NewRecoveryCodeRepository(db *PostgresDB) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{
+		db: db,
+	}
+}
+
+// Replace implements driven.RecoveryCodeStore.
+func (rr *RecoveryCodeRepository) Replace(ctx context.Context, userID int64, codeHashes []string) error {
+	tx, err := rr.db.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			user_recovery_codes
+		WHERE
+			user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, codeHash := range codeHashes {
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO
+			user_recovery_codes (user_id, code_hash)
+		VALUES
+			($1, $2)`, userID, codeHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Consume implements driven.RecoveryCodeStore.
+func (rr *RecoveryCodeRepository) Consume(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	result, err := rr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_recovery_codes
+		SET
+			used_at = NOW()
+		WHERE
+			user_id = $1
+			AND code_hash = $2
+			AND used_at IS NULL`, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
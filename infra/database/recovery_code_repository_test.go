@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryCodeRepository_Replace(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when insert error, it should rollback",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_recovery_codes").WithArgs(int64(123)).WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec("INSERT INTO user_recovery_codes").WithArgs(int64(123), "hash-1").WillReturnError(errors.New("some database error"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "when success, it should replace the codes in one transaction",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_recovery_codes").WithArgs(int64(123)).WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec("INSERT INTO user_recovery_codes").WithArgs(int64(123), "hash-1").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO user_recovery_codes").WithArgs(int64(123), "hash-2").WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRecoveryCodeRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Replace(context.Background(), 123, []string{"hash-1", "hash-2"})
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecoveryCodeRepository_Consume(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       bool
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_recovery_codes").WithArgs(int64(123), "hash").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when code unknown or used, it should return false",
+			want: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_recovery_codes").WithArgs(int64(123), "hash").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when code consumed, it should return true",
+			want: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_recovery_codes").WithArgs(int64(123), "hash").WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRecoveryCodeRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.Consume(context.Background(), 123, "hash")
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+)
+
+type RefreshTokenRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.RefreshTokenStore = new(RefreshTokenRepository)
+)
+
+func NewRefreshTokenRepository(db *PostgresDB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db: db,
+	}
+}
+
+// Create implements driven.RefreshTokenStore.
+func (rr *RefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	return rr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		user_refresh_tokens (user_id, family_id, token_hash, expires_at)
+	VALUES
+		($1, $2, $3, $4)
+	RETURNING
+		id, created_at
+	`, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+}
+
+// GetByHash implements driven.RefreshTokenStore.
+func (rr *RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	rows, err := rr.db.Conn().QueryContext(ctx, `
+		SELECT
+			id,
+			user_id,
+			family_id,
+			token_hash,
+			expires_at,
+			used_at,
+			revoked_at,
+			created_at
+		FROM
+			user_refresh_tokens
+		WHERE
+			token_hash = $1
+		LIMIT
+			1
+	`, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	var token entity.RefreshToken
+	if rows.Next() {
+		err = rows.Scan(
+			&token.ID,
+			&token.UserID,
+			&token.FamilyID,
+			&token.TokenHash,
+			&token.ExpiresAt,
+			&token.UsedAt,
+			&token.RevokedAt,
+			&token.CreatedAt,
+		)
+	} else {
+		return nil, sql.ErrNoRows
+	}
+
+	return &token, err
+}
+
+// MarkUsed implements driven.RefreshTokenStore.
+// The used_at check makes concurrent refreshes with the same token race safe.
+func (rr *RefreshTokenRepository) MarkUsed(ctx context.Context, id int64) (bool, error) {
+	result, err := rr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_refresh_tokens
+		SET
+			used_at = NOW()
+		WHERE
+			id = $1
+			AND used_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// RevokeFamily implements driven.RefreshTokenStore.
+func (rr *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	_, err := rr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_refresh_tokens
+		SET
+			revoked_at = NOW()
+		WHERE
+			family_id = $1
+			AND revoked_at IS NULL`, familyID)
+	return err
+}
+
+// RevokeByUserID implements driven.RefreshTokenStore.
+func (rr *RefreshTokenRepository) RevokeByUserID(ctx context.Context, userID int64) error {
+	_, err := rr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_refresh_tokens
+		SET
+			revoked_at = NOW()
+		WHERE
+			user_id = $1
+			AND revoked_at IS NULL`, userID)
+	return err
+}
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	token := &entity.RefreshToken{
+		UserID:    123,
+		FamilyID:  "3f1c3c5e-3f5a-4a55-9d0e-7c2f7e4f2a11",
+		TokenHash: "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO user_refresh_tokens").
+					WithArgs(token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill id",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO user_refresh_tokens").
+					WithArgs(token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, time.Now()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRefreshTokenRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), token)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(int64(10), token.ID)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshTokenRepository_GetByHash(t *testing.T) {
+	usedAt := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.RefreshToken
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock, *entity.RefreshToken)
+	}{
+		{
+			name:    "when record not found, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.RefreshToken) {
+				mock.ExpectQuery("SELECT").WithArgs("hash").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name:    "when error on database, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.RefreshToken) {
+				mock.ExpectQuery("SELECT").WithArgs("hash").WillReturnError(errors.New("database error"))
+			},
+		},
+		{
+			name: "when token found, it should return token",
+			want: &entity.RefreshToken{
+				ID:        1,
+				UserID:    123,
+				FamilyID:  "3f1c3c5e-3f5a-4a55-9d0e-7c2f7e4f2a11",
+				TokenHash: "hash",
+				ExpiresAt: time.Now(),
+				UsedAt:    &usedAt,
+				CreatedAt: time.Now(),
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, token *entity.RefreshToken) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "family_id", "token_hash", "expires_at", "used_at", "revoked_at", "created_at"}).
+					AddRow(token.ID, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt, usedAt, nil, token.CreatedAt)
+				mock.ExpectQuery("SELECT").WithArgs("hash").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRefreshTokenRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetByHash(context.Background(), "hash")
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshTokenRepository_MarkUsed(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       bool
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_refresh_tokens").WithArgs(int64(1)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name: "when token already used, it should return false",
+			want: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_refresh_tokens").WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when token not used yet, it should return true",
+			want: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_refresh_tokens").WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewRefreshTokenRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.MarkUsed(context.Background(), 1)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	familyID := "3f1c3c5e-3f5a-4a55-9d0e-7c2f7e4f2a11"
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewRefreshTokenRepository(&PostgresDB{conn: conn})
+
+	dbMock.ExpectExec("UPDATE user_refresh_tokens").WithArgs(familyID).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	err := repo.RevokeFamily(context.Background(), familyID)
+
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+)
+
+type SessionRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.SessionStore = new(SessionRepository)
+)
+
+func NewSessionRepository(db *PostgresDB) *SessionRepository {
+	return &SessionRepository{
+		db: db,
+	}
+}
+
+const selectSessionQuery = `
+		SELECT
+			id,
+			user_id,
+			device_name,
+			user_agent,
+			ip_address,
+			last_seen_at,
+			expires_at,
+			revoked_at,
+			created_at
+		FROM
+			user_sessions`
+
+// Create implements driven.SessionStore.
+func (sr *SessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	return sr.db.Conn().QueryRowContext(ctx, `
+	INSERT INTO
+		user_sessions (id, user_id, device_name, user_agent, ip_address, expires_at)
+	VALUES
+		($1, $2, $3, $4, $5, $6)
+	RETURNING
+		last_seen_at, created_at
+	`, session.ID, session.UserID, session.DeviceName, session.UserAgent, session.IPAddress, session.ExpiresAt).
+		Scan(&session.LastSeenAt, &session.CreatedAt)
+}
+
+// GetByID implements driven.SessionStore.
+func (sr *SessionRepository) GetByID(ctx context.Context, id string) (*entity.Session, error) {
+	rows, err := sr.db.Conn().QueryContext(ctx, selectSessionQuery+`
+		WHERE
+			id = $1
+		LIMIT
+			1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, sql.ErrNoRows
+	}
+	return scanSession(rows)
+}
+
+// ListActiveByUserID implements driven.SessionStore.
+func (sr *SessionRepository) ListActiveByUserID(ctx context.Context, userID int64) ([]*entity.Session, error) {
+	rows, err := sr.db.Conn().QueryContext(ctx, selectSessionQuery+`
+		WHERE
+			user_id = $1
+			AND revoked_at IS NULL
+			AND expires_at > NOW()
+		ORDER BY
+			last_seen_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	sessions := make([]*entity.Session, 0)
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+// Touch implements driven.SessionStore.
+func (sr *SessionRepository) Touch(ctx context.Context, session *entity.Session) error {
+	_, err := sr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_sessions
+		SET
+			user_agent = $1,
+			ip_address = $2,
+			expires_at = $3,
+			last_seen_at = NOW()
+		WHERE
+			id = $4`, session.UserAgent, session.IPAddress, session.ExpiresAt, session.ID)
+	return err
+}
+
+// Revoke implements driven.SessionStore.
+func (sr *SessionRepository) Revoke(ctx context.Context, id string) error {
+	_, err := sr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_sessions
+		SET
+			revoked_at = NOW()
+		WHERE
+			id = $1
+			AND revoked_at IS NULL`, id)
+	return err
+}
+
+// RevokeByUserID implements driven.SessionStore.
+func (sr *SessionRepository) RevokeByUserID(ctx context.Context, userID int64) error {
+	_, err := sr.db.Conn().ExecContext(ctx, `
+		UPDATE
+			user_sessions
+		SET
+			revoked_at = NOW()
+		WHERE
+			user_id = $1
+			AND revoked_at IS NULL`, userID)
+	return err
+}
+
+func scanSession(rows *sql.Rows) (*entity.Session, error) {
+	var session entity.Session
+	err := rows.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.DeviceName,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.LastSeenAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+		&session.CreatedAt,
+	)
+	return &session, err
+}
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var sessionColumns = []string{"id", "user_id", "device_name", "user_agent", "ip_address", "last_seen_at", "expires_at", "revoked_at", "created_at"}
+
+func TestSessionRepository_Create(t *testing.T) {
+	session := &entity.Session{
+		ID:         "0f8fad5b-d9cb-469f-a165-70867728950e",
+		UserID:     123,
+		DeviceName: "Pixel 8",
+		UserAgent:  "okhttp/4.12.0",
+		IPAddress:  "10.0.0.1",
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("^INSERT INTO user_sessions").
+					WithArgs(session.ID, session.UserID, session.DeviceName, session.UserAgent, session.IPAddress, session.ExpiresAt).
+					WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert success, it should fill the timestamps",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				now := time.Now()
+				mock.ExpectQuery("^INSERT INTO user_sessions").
+					WithArgs(session.ID, session.UserID, session.DeviceName, session.UserAgent, session.IPAddress, session.ExpiresAt).
+					WillReturnRows(sqlmock.NewRows([]string{"last_seen_at", "created_at"}).AddRow(now, now))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewSessionRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Create(context.Background(), session)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.False(session.LastSeenAt.IsZero())
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_GetByID(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.Session
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock, *entity.Session)
+	}{
+		{
+			name:    "when record not found, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.Session) {
+				mock.ExpectQuery("SELECT").WithArgs("session-id").WillReturnRows(sqlmock.NewRows(sessionColumns))
+			},
+		},
+		{
+			name: "when record found, it should return session",
+			want: &entity.Session{
+				ID:         "session-id",
+				UserID:     123,
+				DeviceName: "Pixel 8",
+				UserAgent:  "okhttp/4.12.0",
+				IPAddress:  "10.0.0.1",
+				LastSeenAt: now,
+				ExpiresAt:  now.Add(time.Hour),
+				CreatedAt:  now,
+			},
+			expectFunc: func(mock sqlmock.Sqlmock, session *entity.Session) {
+				rows := sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.UserID, session.DeviceName, session.UserAgent,
+					session.IPAddress, session.LastSeenAt, session.ExpiresAt, nil, session.CreatedAt)
+				mock.ExpectQuery("SELECT (.+) FROM user_sessions").WithArgs("session-id").WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewSessionRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := repo.GetByID(context.Background(), "session-id")
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_ListActiveByUserID(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		wantLen    int
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_sessions").WithArgs(int64(123)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when no session, it should return empty list",
+			wantLen: 0,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_sessions").WithArgs(int64(123)).WillReturnRows(sqlmock.NewRows(sessionColumns))
+			},
+		},
+		{
+			name:    "when sessions found, it should return every session",
+			wantLen: 2,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(sessionColumns).
+					AddRow("first", int64(123), "Pixel 8", "okhttp/4.12.0", "10.0.0.1", now, now.Add(time.Hour), nil, now).
+					AddRow("second", int64(123), "", "Mozilla/5.0", "10.0.0.2", now.Add(-time.Hour), now.Add(time.Hour), nil, now)
+				mock.ExpectQuery("SELECT (.+) FROM user_sessions").WithArgs(int64(123)).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewSessionRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.ListActiveByUserID(context.Background(), 123)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Len(got, tt.wantLen)
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_Revoke(t *testing.T) {
+	conn, dbMock := newMockConn()
+	defer conn.Close()
+	repo := NewSessionRepository(&PostgresDB{conn: conn})
+
+	session := &entity.Session{ID: "session-id", UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1", ExpiresAt: time.Now().Add(time.Hour)}
+	dbMock.ExpectExec("UPDATE user_sessions SET user_agent").WithArgs(session.UserAgent, session.IPAddress, session.ExpiresAt, session.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("UPDATE user_sessions SET revoked_at").WithArgs("session-id").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("UPDATE user_sessions SET revoked_at").WithArgs(int64(123)).WillReturnError(errors.New("some database error"))
+
+	assert := assert.New(t)
+	assert.NoError(repo.Touch(context.Background(), session))
+	assert.NoError(repo.Revoke(context.Background(), "session-id"))
+	assert.Error(repo.RevokeByUserID(context.Background(), 123))
+	assert.NoError(dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"time"
+)
+
+type TokenRevocationRepository struct {
+	db *PostgresDB
+}
+
+var (
+	_ driven.TokenRevocationStore = new(TokenRevocationRepository)
+)
+
+func NewTokenRevocationRepository(db *PostgresDB) *TokenRevocationRepository {
+	return &TokenRevocationRepository{
+		db: db,
+	}
+}
+
+// Revoke implements driven.TokenRevocationStore.
+// Entries of tokens that already expired are pruned on every revocation.
+func (tr *TokenRevocationRepository) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	_, err := tr.db.Conn().ExecContext(ctx, `
+		DELETE FROM
+			revoked_tokens
+		WHERE
+			expires_at <= NOW()`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tr.db.Conn().ExecContext(ctx, `
+		INSERT INTO
+			revoked_tokens (token_id, expires_at)
+		VALUES
+			($1, $2)
+		ON CONFLICT (token_id)
+		DO NOTHING`, tokenID, expiresAt)
+	return err
+}
+
+// RevokeUser implements driven.TokenRevocationStore.
+func (tr *TokenRevocationRepository) RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time) error {
+	issuedBefore = issuedBefore.Truncate(time.Second)
+	_, err := tr.db.Conn().ExecContext(ctx, `
+		INSERT INTO
+			revoked_user_tokens (user_id, issued_before)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			issued_before = GREATEST(revoked_user_tokens.issued_before, EXCLUDED.issued_before)`, userID, issuedBefore)
+	return err
+}
+
+// IsRevoked implements driven.TokenRevocationStore.
+func (tr *TokenRevocationRepository) IsRevoked(ctx context.Context, claims *entity.UserClaims) (revoked bool, err error) {
+	err = tr.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			EXISTS (
+				SELECT 1 FROM revoked_tokens WHERE token_id IN ($1, $4) AND expires_at > NOW()
+			)
+			OR EXISTS (
+				SELECT 1 FROM revoked_user_tokens WHERE user_id = $2 AND issued_before > $3
+			)
+	`, claims.TokenID, claims.UserID, claims.IssuedAt, claims.SessionID).Scan(&revoked)
+	return
+}
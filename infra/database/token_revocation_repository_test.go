@@ -0,0 +1,147 @@
+package database
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRevocationRepository_Revoke(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when prune error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM revoked_tokens").WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when insert error, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM revoked_tokens").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO revoked_tokens").WithArgs("token-id", expiresAt).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should prune expired entries and insert the token",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM revoked_tokens").WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO revoked_tokens").WithArgs("token-id", expiresAt).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewTokenRevocationRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.Revoke(context.Background(), "token-id", expiresAt)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRevocationRepository_RevokeUser(t *testing.T) {
+	issuedBefore := time.Date(2024, 1, 1, 10, 0, 0, 700, time.UTC)
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO revoked_user_tokens").WithArgs(int64(1), issuedBefore.Truncate(time.Second)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should store issued before truncated to the second",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO revoked_user_tokens").WithArgs(int64(1), issuedBefore.Truncate(time.Second)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewTokenRevocationRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := repo.RevokeUser(context.Background(), 1, issuedBefore)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRevocationRepository_IsRevoked(t *testing.T) {
+	claims := &entity.UserClaims{UserID: 1, TokenID: "token-id", SessionID: "session-id", IssuedAt: time.Now()}
+	tests := []struct {
+		name       string
+		want       bool
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT").WithArgs(claims.TokenID, claims.UserID, claims.IssuedAt, claims.SessionID).WillReturnError(errors.New("database error"))
+			},
+		},
+		{
+			name: "when token revoked, it should return true",
+			want: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT").WithArgs(claims.TokenID, claims.UserID, claims.IssuedAt, claims.SessionID).WillReturnRows(sqlmock.NewRows([]string{"revoked"}).AddRow(true))
+			},
+		},
+		{
+			name: "when token not revoked, it should return false",
+			want: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT").WithArgs(claims.TokenID, claims.UserID, claims.IssuedAt, claims.SessionID).WillReturnRows(sqlmock.NewRows([]string{"revoked"}).AddRow(false))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			repo := NewTokenRevocationRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := repo.IsRevoked(context.Background(), claims)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
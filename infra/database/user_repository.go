@@ -5,6 +5,9 @@ import (
 	"app/internal/user/port/driven"
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type UserRepository struct {
@@ -14,6 +17,7 @@ type UserRepository struct {
 var (
 	_ driven.UserWriter = new(UserRepository)
 	_ driven.UserGetter = new(UserRepository)
+	_ driven.UserPurger = new(UserRepository)
 )
 
 func NewUserRepository(db *PostgresDB) *UserRepository {
@@ -23,16 +27,54 @@ func NewUserRepository(db *PostgresDB) *UserRepository {
 }
 
 // Create implements driven.UserWriter.
-func (ur *UserRepository) Create(ctx context.Context, user *entity.User) (id int64, err error) {
-	err = ur.db.Conn().QueryRowContext(ctx, `
+func (ur *UserRepository) Create(ctx context.Context, user *entity.User, preferences *entity.Preferences) (id int64, err error) {
+	tx, err := ur.db.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return id, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = tx.QueryRowContext(ctx, `
 	INSERT INTO
-		users (username, name, gender, phone_number, password)
+		users (username, name, gender, show_gender, orientation, phone_number, password, role)
 	VALUES
-		($1, $2, $3, $4, $5)
+		($1, $2, $3, $4, $5, $6, $7, $8)
 	RETURNING
 		id
-	`, user.Username, user.Name, user.Gender.String(), user.PhoneNumber, user.Password).Scan(&id)
-	return
+	`, user.Username, user.Name, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.PhoneNumber, user.Password, user.Role.String()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	if preferences == nil {
+		return id, tx.Commit()
+	}
+
+	interestedIn := make([]string, 0, len(preferences.InterestedIn))
+	for _, gender := range preferences.InterestedIn {
+		interestedIn = append(interestedIn, gender.String())
+	}
+	preferences.UserID = id
+	err = tx.QueryRowContext(ctx, `
+	INSERT INTO
+		user_preferences (user_id, interested_in, min_age, max_age, max_distance_km)
+	VALUES
+		($1, $2, $3, $4, $5)
+	RETURNING
+		created_at, updated_at
+	`,
+		preferences.UserID,
+		pq.Array(interestedIn),
+		preferences.MinAge,
+		preferences.MaxAge,
+		preferences.MaxDistanceKM,
+	).Scan(&preferences.CreatedAt, &preferences.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, tx.Commit()
 }
 
 // UpdateLoginInformation implements driven.UserWriter.
@@ -49,9 +91,248 @@ func (ur *UserRepository) UpdateLoginInformation(ctx context.Context, user *enti
 	return err
 }
 
+// UpdatePassword implements driven.UserWriter.
+func (ur *UserRepository) UpdatePassword(ctx context.Context, user *entity.User) error {
+	_, err := ur.db.Conn().ExecContext(ctx, `
+		UPDATE
+			users
+		SET
+			password = $1,
+			updated_at = NOW()
+		WHERE
+			id = $2`, user.Password, user.ID)
+	return err
+}
+
+// MarkPhoneVerified implements driven.UserWriter.
+func (ur *UserRepository) MarkPhoneVerified(ctx context.Context, user *entity.User) error {
+	return ur.db.Conn().QueryRowContext(ctx, `
+	UPDATE
+		users
+	SET
+		phone_verified_at = NOW(),
+		updated_at = NOW()
+	WHERE
+		id = $1
+	RETURNING
+		phone_verified_at
+	`, user.ID).Scan(&user.PhoneVerifiedAt)
+}
+
+// UpdateTOTP implements driven.UserWriter.
+func (ur *UserRepository) UpdateTOTP(ctx context.Context, user *entity.User) error {
+	_, err := ur.db.Conn().ExecContext(ctx, `
+		UPDATE
+			users
+		SET
+			totp_secret = NULLIF($1, ''),
+			totp_enabled_at = $2,
+			updated_at = NOW()
+		WHERE
+			id = $3`, user.TOTPSecret, user.TOTPEnabledAt, user.ID)
+	return err
+}
+
+// UpdateRole implements driven.UserWriter.
+func (ur *UserRepository) UpdateRole(ctx context.Context, user *entity.User) error {
+	_, err := ur.db.Conn().ExecContext(ctx, `
+		UPDATE
+			users
+		SET
+			role = $1,
+			updated_at = NOW()
+		WHERE
+			id = $2`, user.Role.String(), user.ID)
+	return err
+}
+
+// UpdateIdentity implements driven.UserWriter.
+func (ur *UserRepository) UpdateIdentity(ctx context.Context, user *entity.User) error {
+	_, err := ur.db.Conn().ExecContext(ctx, `
+		UPDATE
+			users
+		SET
+			gender = $1,
+			show_gender = $2,
+			orientation = $3,
+			updated_at = NOW()
+		WHERE
+			id = $4`, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.ID)
+	return err
+}
+
+// SoftDelete implements driven.UserWriter.
+func (ur *UserRepository) SoftDelete(ctx context.Context, user *entity.User) error {
+	return ur.db.Conn().QueryRowContext(ctx, `
+	UPDATE
+		users
+	SET
+		deleted_at = NOW(),
+		updated_at = NOW()
+	WHERE
+		id = $1
+	RETURNING
+		deleted_at
+	`, user.ID).Scan(&user.DeletedAt)
+}
+
+// Restore implements driven.UserWriter.
+func (ur *UserRepository) Restore(ctx context.Context, user *entity.User) error {
+	_, err := ur.db.Conn().ExecContext(ctx, `
+		UPDATE
+			users
+		SET
+			deleted_at = NULL,
+			updated_at = NOW()
+		WHERE
+			id = $1`, user.ID)
+	return err
+}
+
+// ListPurgeable implements driven.UserPurger.
+func (ur *UserRepository) ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	rows, err := ur.db.Conn().QueryContext(ctx, `
+		SELECT
+			id
+		FROM
+			users
+		WHERE
+			deleted_at IS NOT NULL
+			AND deleted_at < $1
+		ORDER BY
+			deleted_at
+		LIMIT
+			$2
+	`, deletedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+// Purge implements driven.UserPurger.
+// Dependent rows go through ON DELETE CASCADE, login attempts are keyed by username so they are removed here.
+func (ur *UserRepository) Purge(ctx context.Context, userIDs []int64, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := ur.db.Conn().QueryRowContext(ctx, `
+	WITH purged AS (
+		DELETE FROM
+			users
+		WHERE
+			id = ANY($1)
+			AND deleted_at IS NOT NULL
+			AND deleted_at < $2
+		RETURNING
+			username
+	), purged_login_attempts AS (
+		DELETE FROM
+			login_attempts
+		WHERE
+			key IN (SELECT 'username:' || username FROM purged)
+	)
+	SELECT
+		COUNT(*)
+	FROM
+		purged
+	`, pq.Array(userIDs), deletedBefore).Scan(&purged)
+	return purged, err
+}
+
+// GetByID implements driven.UserGetter.
+func (ur *UserRepository) GetByID(ctx context.Context, id int64) (*entity.User, error) {
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			id = $1
+			AND deleted_at IS NULL
+		LIMIT
+			1
+	`, id)
+}
+
 // GetByUsername implements driven.UserGetter.
 func (ur *UserRepository) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
-	rows, err := ur.db.Conn().QueryContext(ctx, `
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			username = $1
+			AND deleted_at IS NULL
+		LIMIT
+			1
+	`, username)
+}
+
+// GetByPhoneNumber implements driven.UserGetter.
+func (ur *UserRepository) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			phone_number = $1
+			AND deleted_at IS NULL
+		LIMIT
+			1
+	`, phoneNumber)
+}
+
+// GetDeletedByID implements driven.UserGetter.
+func (ur *UserRepository) GetDeletedByID(ctx context.Context, id int64) (*entity.User, error) {
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			id = $1
+			AND deleted_at IS NOT NULL
+		LIMIT
+			1
+	`, id)
+}
+
+// GetDeletedByUsername implements driven.UserGetter.
+func (ur *UserRepository) GetDeletedByUsername(ctx context.Context, username string) (*entity.User, error) {
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			username = $1
+			AND deleted_at IS NOT NULL
+		LIMIT
+			1
+	`, username)
+}
+
+// GetDeletedByPhoneNumber implements driven.UserGetter.
+func (ur *UserRepository) GetDeletedByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
+	return ur.queryOne(ctx, selectUserQuery+`
+		WHERE
+			phone_number = $1
+			AND deleted_at IS NOT NULL
+		LIMIT
+			1
+	`, phoneNumber)
+}
+
+// GetLoginInformation implements driven.UserGetter.
+func (ur *UserRepository) GetLoginInformation(ctx context.Context, userID int64) (*entity.LoginInformation, error) {
+	var loginInformation entity.LoginInformation
+	err := ur.db.Conn().QueryRowContext(ctx, `
+		SELECT
+			COALESCE(success_login_count, 0),
+			last_login_at
+		FROM
+			user_tokens
+		WHERE
+			user_id = $1
+	`, userID).Scan(&loginInformation.SuccessLoginCount, &loginInformation.LastLoginAt)
+	if err != nil {
+		return nil, err
+	}
+	return &loginInformation, nil
+}
+
+const selectUserQuery = `
 		SELECT
 			id,
 			name,
@@ -59,15 +340,20 @@ func (ur *UserRepository) GetByUsername(ctx context.Context, username string) (*
 			password,
 			phone_number,
 			gender,
+			show_gender,
+			orientation,
+			role,
+			phone_verified_at,
+			COALESCE(totp_secret, ''),
+			totp_enabled_at,
+			deleted_at,
 			created_at,
 			updated_at
 		FROM
-			users
-		WHERE
-			username = $1
-		LIMIT
-			1
-	`, username)
+			users`
+
+func (ur *UserRepository) queryOne(ctx context.Context, query string, args ...interface{}) (*entity.User, error) {
+	rows, err := ur.db.Conn().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +368,13 @@ func (ur *UserRepository) GetByUsername(ctx context.Context, username string) (*
 			&user.Password,
 			&user.PhoneNumber,
 			&user.Gender,
+			&user.ShowGender,
+			&user.Orientation,
+			&user.Role,
+			&user.PhoneVerifiedAt,
+			&user.TOTPSecret,
+			&user.TOTPEnabledAt,
+			&user.DeletedAt,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
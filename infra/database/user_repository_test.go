@@ -11,6 +11,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-faker/faker/v4"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,18 +26,19 @@ func newMockConn() (*sql.DB, sqlmock.Sqlmock) {
 
 func TestUserRepository_Create(t *testing.T) {
 	type args struct {
-		ctx  context.Context
-		user *entity.User
+		ctx         context.Context
+		user        *entity.User
+		preferences *entity.Preferences
 	}
 	tests := []struct {
 		name       string
 		args       args
 		wantId     int64
 		wantErr    bool
-		expectFunc func(sqlmock.Sqlmock, *entity.User)
+		expectFunc func(sqlmock.Sqlmock, *entity.User, *entity.Preferences)
 	}{
 		{
-			name: "when given user entity, it should insert Name, phoneNumber, password",
+			name: "when given user entity, it should insert the user and its preferences in one transaction",
 			args: args{
 				ctx: context.Background(),
 				user: &entity.User{
@@ -44,16 +46,45 @@ func TestUserRepository_Create(t *testing.T) {
 					Name:        faker.Name(),
 					Username:    "asdasd123",
 					PhoneNumber: faker.Phonenumber(),
-					Gender:      entity.Gender("male"),
+					Gender:      entity.GenderMan,
+					ShowGender:  true,
 					Password:    faker.Password(),
 				},
+				preferences: &entity.Preferences{InterestedIn: []entity.Gender{entity.GenderWoman}, MinAge: 18, MaxAge: 28, MaxDistanceKM: 50},
 			},
 			wantId:  123131,
 			wantErr: false,
-			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User) {
+			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User, preferences *entity.Preferences) {
+				now := time.Now()
+				mock.ExpectBegin()
 				mock.ExpectQuery("^INSERT INTO users").
-					WithArgs(user.Username, user.Name, user.Gender.String(), user.PhoneNumber, user.Password).
+					WithArgs(user.Username, user.Name, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.PhoneNumber, user.Password, user.Role.String()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(123131))
+				mock.ExpectQuery("^INSERT INTO user_preferences").
+					WithArgs(int64(123131), pq.Array([]string{"woman"}), preferences.MinAge, preferences.MaxAge, preferences.MaxDistanceKM).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "when no preferences are given, it should only insert the user",
+			args: args{
+				ctx: context.Background(),
+				user: &entity.User{
+					Name:        faker.Name(),
+					Username:    "asdasd123",
+					PhoneNumber: faker.Phonenumber(),
+					Password:    faker.Password(),
+				},
+			},
+			wantId:  123131,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User, preferences *entity.Preferences) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("^INSERT INTO users").
+					WithArgs(user.Username, user.Name, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.PhoneNumber, user.Password, user.Role.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(123131))
+				mock.ExpectCommit()
 			},
 		},
 		{
@@ -65,13 +96,40 @@ func TestUserRepository_Create(t *testing.T) {
 					PhoneNumber: faker.Phonenumber(),
 					Password:    faker.Password(),
 				},
+				preferences: &entity.Preferences{},
 			},
 			wantId:  0,
 			wantErr: true,
-			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User) {
+			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User, preferences *entity.Preferences) {
+				mock.ExpectBegin()
 				mock.ExpectQuery("^INSERT INTO users").
-					WithArgs(user.Username, user.Name, user.Gender.String(), user.PhoneNumber, user.Password).
+					WithArgs(user.Username, user.Name, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.PhoneNumber, user.Password, user.Role.String()).
 					WillReturnError(errors.New("some database error"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "when inserting the preferences fails, it should roll back the user",
+			args: args{
+				ctx: context.Background(),
+				user: &entity.User{
+					Name:        faker.Name(),
+					Username:    "asdasd123",
+					PhoneNumber: faker.Phonenumber(),
+					Password:    faker.Password(),
+				},
+				preferences: &entity.Preferences{},
+			},
+			wantId:  0,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, user *entity.User, preferences *entity.Preferences) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("^INSERT INTO users").
+					WithArgs(user.Username, user.Name, user.Gender.String(), user.ShowGender, user.Orientation.String(), user.PhoneNumber, user.Password, user.Role.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(123131))
+				mock.ExpectQuery("^INSERT INTO user_preferences").
+					WillReturnError(errors.New("some database error"))
+				mock.ExpectRollback()
 			},
 		},
 	}
@@ -84,9 +142,9 @@ func TestUserRepository_Create(t *testing.T) {
 			}
 			udb := NewUserRepository(&pgConn)
 
-			tt.expectFunc(dbMock, tt.args.user)
+			tt.expectFunc(dbMock, tt.args.user, tt.args.preferences)
 
-			gotId, err := udb.Create(tt.args.ctx, tt.args.user)
+			gotId, err := udb.Create(tt.args.ctx, tt.args.user, tt.args.preferences)
 
 			assert := assert.New(t)
 			assert.Equal(tt.wantErr, err != nil)
@@ -206,14 +264,16 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 				Username:    "testUsername123",
 				PhoneNumber: faker.Phonenumber(),
 				Password:    faker.Password(),
-				Gender:      entity.Gender("male"),
+				Gender:      entity.GenderMan,
+				ShowGender:  true,
+				Role:        entity.RoleUser,
 				CreatedAt:   time.Now(),
 				UpdatedAt:   time.Now(),
 			},
 			wantErr: false,
 			expectFunc: func(mock sqlmock.Sqlmock, expectedUser *entity.User) {
-				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "created_at", "updated_at"}).
-					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "male", expectedUser.CreatedAt, expectedUser.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "show_gender", "orientation", "role", "phone_verified_at", "totp_secret", "totp_enabled_at", "deleted_at", "created_at", "updated_at"}).
+					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "man", true, "", "user", nil, "", nil, nil, expectedUser.CreatedAt, expectedUser.UpdatedAt)
 
 				mock.ExpectQuery("SELECT").WithArgs("testUsername123").WillReturnRows(rows)
 			},
@@ -237,3 +297,627 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 		assert.NoError(dbMock.ExpectationsWereMet())
 	}
 }
+
+func TestUserRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         int64
+		want       *entity.User
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock, *entity.User)
+	}{
+		{
+			name:    "when record not found, it should return error",
+			id:      1,
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT").WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name:    "when error on database, it should return error",
+			id:      1,
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT").WithArgs(int64(1)).WillReturnError(errors.New("database error"))
+			},
+		},
+		{
+			name: "when user found, it should return user",
+			id:   1231321,
+			want: &entity.User{
+				ID:          1231321,
+				Name:        faker.Name(),
+				Username:    "testUsername123",
+				PhoneNumber: faker.Phonenumber(),
+				Password:    faker.Password(),
+				Gender:      entity.GenderMan,
+				ShowGender:  true,
+				Role:        entity.RoleUser,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, expectedUser *entity.User) {
+				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "show_gender", "orientation", "role", "phone_verified_at", "totp_secret", "totp_enabled_at", "deleted_at", "created_at", "updated_at"}).
+					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "man", true, "", "user", nil, "", nil, nil, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+
+				mock.ExpectQuery("SELECT").WithArgs(expectedUser.ID).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := udb.GetByID(context.Background(), tt.id)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_GetByPhoneNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		phoneNumber string
+		want        *entity.User
+		wantErr     bool
+		expectFunc  func(sqlmock.Sqlmock, *entity.User)
+	}{
+		{
+			name:        "when record not found, it should return error",
+			phoneNumber: "+6281234567890",
+			want:        nil,
+			wantErr:     true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT (.+) WHERE phone_number").WithArgs("+6281234567890").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name:        "when error on database, it should return error",
+			phoneNumber: "+6281234567890",
+			want:        nil,
+			wantErr:     true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT (.+) WHERE phone_number").WithArgs("+6281234567890").WillReturnError(errors.New("database error"))
+			},
+		},
+		{
+			name:        "when user found, it should return user",
+			phoneNumber: "+6281234567890",
+			want: &entity.User{
+				ID:          1231321,
+				Name:        faker.Name(),
+				Username:    "testUsername123",
+				PhoneNumber: "+6281234567890",
+				Password:    faker.Password(),
+				Gender:      entity.GenderMan,
+				ShowGender:  true,
+				Role:        entity.RoleUser,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, expectedUser *entity.User) {
+				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "show_gender", "orientation", "role", "phone_verified_at", "totp_secret", "totp_enabled_at", "deleted_at", "created_at", "updated_at"}).
+					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "man", true, "", "user", nil, "", nil, nil, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+
+				mock.ExpectQuery("SELECT (.+) WHERE phone_number").WithArgs(expectedUser.PhoneNumber).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := udb.GetByPhoneNumber(context.Background(), tt.phoneNumber)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_UpdatePassword(t *testing.T) {
+	user := &entity.User{ID: 123131, Password: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$aGFzaA"}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users").WithArgs(user.Password, user.ID).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should update the password",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users").WithArgs(user.Password, user.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := udb.UpdatePassword(context.Background(), user)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_MarkPhoneVerified(t *testing.T) {
+	verifiedAt := time.Now()
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on database, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE users SET phone_verified_at").WithArgs(int64(123131)).WillReturnError(errors.New("database error"))
+			},
+		},
+		{
+			name:    "when success, it should set the verification time",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE users SET phone_verified_at").WithArgs(int64(123131)).
+					WillReturnRows(sqlmock.NewRows([]string{"phone_verified_at"}).AddRow(verifiedAt))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+			user := &entity.User{ID: 123131}
+
+			tt.expectFunc(dbMock)
+
+			err := udb.MarkPhoneVerified(context.Background(), user)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(!tt.wantErr, user.IsPhoneVerified())
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_GetDeletedByID(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name       string
+		want       *entity.User
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock, *entity.User)
+	}{
+		{
+			name:    "when no deleted user has the id, it should return error",
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT (.+) WHERE id = (.+) AND deleted_at IS NOT NULL").WithArgs(int64(1231321)).WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when deleted user found, it should return user with deleted at",
+			want: &entity.User{
+				ID:          1231321,
+				Name:        faker.Name(),
+				Username:    "testusername123",
+				PhoneNumber: faker.Phonenumber(),
+				Password:    faker.Password(),
+				Gender:      entity.GenderMan,
+				ShowGender:  true,
+				Role:        entity.RoleUser,
+				DeletedAt:   &deletedAt,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, expectedUser *entity.User) {
+				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "show_gender", "orientation", "role", "phone_verified_at", "totp_secret", "totp_enabled_at", "deleted_at", "created_at", "updated_at"}).
+					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "man", true, "", "user", nil, "", nil, deletedAt, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+
+				mock.ExpectQuery("SELECT (.+) WHERE id = (.+) AND deleted_at IS NOT NULL").WithArgs(expectedUser.ID).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := udb.GetDeletedByID(context.Background(), 1231321)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_GetDeletedByUsername(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name       string
+		want       *entity.User
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock, *entity.User)
+	}{
+		{
+			name:    "when no deleted user has the username, it should return error",
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock, _ *entity.User) {
+				mock.ExpectQuery("SELECT (.+) WHERE username = (.+) AND deleted_at IS NOT NULL").WithArgs("testusername123").WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			name: "when deleted user found, it should return user with deleted at",
+			want: &entity.User{
+				ID:          1231321,
+				Name:        faker.Name(),
+				Username:    "testusername123",
+				PhoneNumber: faker.Phonenumber(),
+				Password:    faker.Password(),
+				Gender:      entity.GenderMan,
+				ShowGender:  true,
+				Role:        entity.RoleUser,
+				DeletedAt:   &deletedAt,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock, expectedUser *entity.User) {
+				rows := sqlmock.NewRows([]string{"id", "name", "username", "password", "phone_number", "gender", "show_gender", "orientation", "role", "phone_verified_at", "totp_secret", "totp_enabled_at", "deleted_at", "created_at", "updated_at"}).
+					AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Username, expectedUser.Password, expectedUser.PhoneNumber, "man", true, "", "user", nil, "", nil, deletedAt, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+
+				mock.ExpectQuery("SELECT (.+) WHERE username = (.+) AND deleted_at IS NOT NULL").WithArgs(expectedUser.Username).WillReturnRows(rows)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock, tt.want)
+
+			got, err := udb.GetDeletedByUsername(context.Background(), "testusername123")
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_SoftDelete(t *testing.T) {
+	deletedAt := time.Now()
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE users SET deleted_at").WithArgs(int64(123131)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should set deleted at of the user",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE users SET deleted_at").WithArgs(int64(123131)).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+			user := &entity.User{ID: 123131}
+
+			tt.expectFunc(dbMock)
+
+			err := udb.SoftDelete(context.Background(), user)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.True(user.IsDeleted())
+			}
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = NULL").WithArgs(int64(123131)).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should clear deleted at of the user",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = NULL").WithArgs(int64(123131)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := udb.Restore(context.Background(), &entity.User{ID: 123131})
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_ListPurgeable(t *testing.T) {
+	deletedBefore := time.Now().Add(-30 * 24 * time.Hour)
+	tests := []struct {
+		name       string
+		want       []int64
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			want:    nil,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at < (.+)").WithArgs(deletedBefore, 100).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when deleted users are past the grace period, it should return their ids",
+			want:    []int64{3, 1},
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at < (.+)").WithArgs(deletedBefore, 100).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := udb.ListPurgeable(context.Background(), deletedBefore, 100)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_Purge(t *testing.T) {
+	deletedBefore := time.Now().Add(-30 * 24 * time.Hour)
+	userIDs := []int64{3, 1}
+	tests := []struct {
+		name       string
+		want       int64
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			want:    0,
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WITH purged AS \\( DELETE FROM users").WithArgs(pq.Array(userIDs), deletedBefore).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when users are still deleted, it should return how many were removed",
+			want:    2,
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WITH purged AS \\( DELETE FROM users (.+) DELETE FROM login_attempts").WithArgs(pq.Array(userIDs), deletedBefore).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := udb.Purge(context.Background(), userIDs, deletedBefore)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_GetLoginInformation(t *testing.T) {
+	lastLoginAt := time.Now()
+	tests := []struct {
+		name       string
+		want       *entity.LoginInformation
+		wantErr    error
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when user never logged in, it should return no rows error",
+			wantErr: sql.ErrNoRows,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_tokens").WithArgs(int64(123)).WillReturnRows(sqlmock.NewRows([]string{"success_login_count", "last_login_at"}))
+			},
+		},
+		{
+			name: "when user logged in before, it should return the login count and time",
+			want: &entity.LoginInformation{SuccessLoginCount: 4, LastLoginAt: &lastLoginAt},
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_tokens").WithArgs(int64(123)).
+					WillReturnRows(sqlmock.NewRows([]string{"success_login_count", "last_login_at"}).AddRow(4, lastLoginAt))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			got, err := udb.GetLoginInformation(context.Background(), 123)
+
+			assert := assert.New(t)
+			assert.ErrorIs(err, tt.wantErr)
+			assert.Equal(tt.want, got)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_UpdateRole(t *testing.T) {
+	user := &entity.User{ID: 123131, Role: entity.RoleModerator}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET role").WithArgs("moderator", user.ID).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should update the role",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET role").WithArgs("moderator", user.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := udb.UpdateRole(context.Background(), user)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_UpdateIdentity(t *testing.T) {
+	user := &entity.User{ID: 123131, Gender: entity.GenderNonBinary, ShowGender: false, Orientation: entity.OrientationQueer}
+	tests := []struct {
+		name       string
+		wantErr    bool
+		expectFunc func(sqlmock.Sqlmock)
+	}{
+		{
+			name:    "when error on db, it should return error",
+			wantErr: true,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET gender").WithArgs("non_binary", false, "queer", user.ID).WillReturnError(errors.New("some database error"))
+			},
+		},
+		{
+			name:    "when success, it should update the gender, its visibility and the orientation",
+			wantErr: false,
+			expectFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET gender").WithArgs("non_binary", false, "queer", user.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, dbMock := newMockConn()
+			defer conn.Close()
+			udb := NewUserRepository(&PostgresDB{conn: conn})
+
+			tt.expectFunc(dbMock)
+
+			err := udb.UpdateIdentity(context.Background(), user)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			assert.NoError(dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package encryption
+
+import (
+	"app/internal/user/port/driven"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix = "$argon2id$"
+
+	defaultArgon2idMemory      = 64 * 1024
+	defaultArgon2idIterations  = 3
+	defaultArgon2idParallelism = 2
+	argon2idSaltLength         = 16
+	argon2idKeyLength          = 32
+
+	// Bounds of the parameters read from a stored hash, a malformed hash
+	// would otherwise make argon2 panic or allocate without limit.
+	maxArgon2idMemory     = 1024 * 1024
+	maxArgon2idIterations = 16
+	maxArgon2idKeyLength  = 128
+)
+
+var (
+	_ driven.Encyptor = new(Argon2idEncryption)
+
+	errMismatchedHashAndPassword = errors.New("hashed password is not the hash of the given password")
+	errInvalidArgon2idHash       = errors.New("invalid argon2id hash")
+)
+
+type Argon2idEncryption struct {
+	// Memory is in KiB.
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+func NewArgon2idEncryption(memory, iterations uint32, parallelism uint8) *Argon2idEncryption {
+	if memory == 0 {
+		memory = defaultArgon2idMemory
+	}
+	if iterations == 0 {
+		iterations = defaultArgon2idIterations
+	}
+	if parallelism == 0 {
+		parallelism = defaultArgon2idParallelism
+	}
+	return &Argon2idEncryption{
+		Memory:      memory,
+		Iterations:  iterations,
+		Parallelism: parallelism,
+	}
+}
+
+// Encrypt hashes data and encodes it in the PHC string format,
+// e.g. $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>.
+func (ae *Argon2idEncryption) Encrypt(data []byte) ([]byte, error) {
+	salt := make([]byte, argon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	hash := argon2.IDKey(data, salt, ae.Iterations, ae.Memory, ae.Parallelism, argon2idKeyLength)
+	return []byte(fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		ae.Memory,
+		ae.Iterations,
+		ae.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	)), nil
+}
+
+func (*Argon2idEncryption) CompareEncryptedAndData(encrypted, data []byte) error {
+	params, salt, hash, err := decodeArgon2id(encrypted)
+	if err != nil {
+		return err
+	}
+
+	otherHash := argon2.IDKey(data, salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(hash)))
+	if subtle.ConstantTimeCompare(hash, otherHash) != 1 {
+		return errMismatchedHashAndPassword
+	}
+	return nil
+}
+
+func (ae *Argon2idEncryption) NeedsRehash(encrypted []byte) bool {
+	params, _, hash, err := decodeArgon2id(encrypted)
+	if err != nil {
+		return true
+	}
+	return *params != *ae || len(hash) != argon2idKeyLength
+}
+
+func decodeArgon2id(encrypted []byte) (params *Argon2idEncryption, salt, hash []byte, err error) {
+	// "", "argon2id", "v=19", "m=65536,t=3,p=2", salt, hash
+	parts := strings.Split(string(encrypted), "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	params = new(Argon2idEncryption)
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+	if params.Memory == 0 || params.Memory > maxArgon2idMemory ||
+		params.Iterations == 0 || params.Iterations > maxArgon2idIterations ||
+		params.Parallelism == 0 {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+	if hash, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(hash) == 0 || len(hash) > maxArgon2idKeyLength {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+	return params, salt, hash, nil
+}
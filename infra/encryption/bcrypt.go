@@ -8,16 +8,35 @@ import (
 
 var _ driven.Encyptor = new(BcryptEncryption)
 
-type BcryptEncryption struct{}
+type BcryptEncryption struct {
+	Cost int
+}
 
-func NewBcryptEncryption() *BcryptEncryption {
-	return &BcryptEncryption{}
+func NewBcryptEncryption(cost int) *BcryptEncryption {
+	return &BcryptEncryption{
+		Cost: cost,
+	}
 }
 
-func (*BcryptEncryption) Encrypt(data []byte, cost int) ([]byte, error) {
-	return bcrypt.GenerateFromPassword(data, cost)
+func (be *BcryptEncryption) Encrypt(data []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(data, be.cost())
 }
 
 func (*BcryptEncryption) CompareEncryptedAndData(encrypted, data []byte) error {
 	return bcrypt.CompareHashAndPassword(encrypted, data)
 }
+
+func (be *BcryptEncryption) NeedsRehash(encrypted []byte) bool {
+	cost, err := bcrypt.Cost(encrypted)
+	if err != nil {
+		return true
+	}
+	return cost != be.cost()
+}
+
+func (be *BcryptEncryption) cost() int {
+	if be.Cost < bcrypt.MinCost {
+		return bcrypt.DefaultCost
+	}
+	return be.Cost
+}
@@ -0,0 +1,55 @@
+package encryption
+
+import (
+	"app/configs"
+	"app/internal/user/port/driven"
+	"bytes"
+)
+
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+var _ driven.Encyptor = new(Encryption)
+
+// Encryption hashes with the algorithm configured in password.algorithm but still verifies
+// hashes of every supported algorithm, so existing users are migrated when they log in.
+type Encryption struct {
+	current  driven.Encyptor
+	bcrypt   *BcryptEncryption
+	argon2id *Argon2idEncryption
+}
+
+func NewEncryption(conf *configs.ApplicationConfig) *Encryption {
+	passwordConf := conf.Password
+	encryption := &Encryption{
+		bcrypt: NewBcryptEncryption(passwordConf.BcryptCost),
+		argon2id: NewArgon2idEncryption(
+			passwordConf.Argon2id.Memory,
+			passwordConf.Argon2id.Iterations,
+			passwordConf.Argon2id.Parallelism,
+		),
+	}
+
+	encryption.current = encryption.bcrypt
+	if passwordConf.Algorithm == AlgorithmArgon2id {
+		encryption.current = encryption.argon2id
+	}
+	return encryption
+}
+
+func (e *Encryption) Encrypt(data []byte) ([]byte, error) {
+	return e.current.Encrypt(data)
+}
+
+func (e *Encryption) CompareEncryptedAndData(encrypted, data []byte) error {
+	if bytes.HasPrefix(encrypted, []byte(argon2idPrefix)) {
+		return e.argon2id.CompareEncryptedAndData(encrypted, data)
+	}
+	return e.bcrypt.CompareEncryptedAndData(encrypted, data)
+}
+
+func (e *Encryption) NeedsRehash(encrypted []byte) bool {
+	return e.current.NeedsRehash(encrypted)
+}
@@ -0,0 +1,87 @@
+package encryption
+
+import (
+	"app/configs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestArgon2idEncryption(t *testing.T) {
+	assert := assert.New(t)
+	encryption := NewArgon2idEncryption(1024, 1, 1)
+
+	encrypted, err := encryption.Encrypt([]byte("Secret123!"))
+	assert.NoError(err)
+	assert.True(strings.HasPrefix(string(encrypted), "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	assert.NoError(encryption.CompareEncryptedAndData(encrypted, []byte("Secret123!")))
+	assert.Error(encryption.CompareEncryptedAndData(encrypted, []byte("Secret123?")))
+	assert.False(encryption.NeedsRehash(encrypted))
+
+	tests := []struct {
+		name      string
+		encrypted string
+	}{
+		{name: "when hash produced with other cost, it should need rehash", encrypted: strings.Replace(string(encrypted), "t=1", "t=2", 1)},
+		{name: "when hash is bcrypt, it should need rehash", encrypted: "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"},
+		{name: "when hash malformed, it should need rehash", encrypted: "$argon2id$v=19$m=1024"},
+		{name: "when hash has no parallelism, it should need rehash", encrypted: strings.Replace(string(encrypted), "p=1", "p=0", 1)},
+		{name: "when hash has no iterations, it should need rehash", encrypted: strings.Replace(string(encrypted), "t=1", "t=0", 1)},
+		{name: "when hash has no memory, it should need rehash", encrypted: strings.Replace(string(encrypted), "m=1024", "m=0", 1)},
+		{name: "when hash has too much memory, it should need rehash", encrypted: strings.Replace(string(encrypted), "m=1024", "m=4294967295", 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(encryption.NeedsRehash([]byte(tt.encrypted)))
+			assert.Error(encryption.CompareEncryptedAndData([]byte(tt.encrypted), []byte("Secret123!")))
+		})
+	}
+}
+
+func TestEncryption(t *testing.T) {
+	bcryptHash, _ := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+
+	tests := []struct {
+		name           string
+		conf           configs.Password
+		wantPrefix     string
+		wantBcryptStay bool
+	}{
+		{
+			name:           "when algorithm is argon2id, it should hash with argon2id and flag bcrypt hashes",
+			conf:           configs.Password{Algorithm: AlgorithmArgon2id, Argon2id: configs.Argon2id{Memory: 1024, Iterations: 1, Parallelism: 1}},
+			wantPrefix:     "$argon2id$",
+			wantBcryptStay: false,
+		},
+		{
+			name:           "when algorithm is bcrypt with other cost, it should flag hashes with outdated cost",
+			conf:           configs.Password{Algorithm: AlgorithmBcrypt, BcryptCost: bcrypt.MinCost + 1},
+			wantPrefix:     "$2a$05$",
+			wantBcryptStay: false,
+		},
+		{
+			name:           "when algorithm is bcrypt with same cost, it should keep bcrypt hashes",
+			conf:           configs.Password{Algorithm: AlgorithmBcrypt, BcryptCost: bcrypt.MinCost},
+			wantPrefix:     "$2a$04$",
+			wantBcryptStay: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			encryption := NewEncryption(&configs.ApplicationConfig{Password: tt.conf})
+
+			encrypted, err := encryption.Encrypt([]byte("Secret123!"))
+			assert.NoError(err)
+			assert.True(strings.HasPrefix(string(encrypted), tt.wantPrefix), string(encrypted))
+			assert.NoError(encryption.CompareEncryptedAndData(encrypted, []byte("Secret123!")))
+			assert.False(encryption.NeedsRehash(encrypted))
+
+			assert.NoError(encryption.CompareEncryptedAndData(bcryptHash, []byte("Secret123!")))
+			assert.Equal(!tt.wantBcryptStay, encryption.NeedsRehash(bcryptHash))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package imaging
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+)
+
+var (
+	_ driven.ImageProcessor = new(ImageProcessor)
+)
+
+const (
+	// maxPixels keeps a small compressed upload from expanding into gigabytes once decoded.
+	maxPixels = 40_000_000
+
+	jpegQuality = 85
+)
+
+// ImageProcessor scales images with the standard library only, EXIF orientation is not applied
+// so photos taken sideways are stored the way the camera encoded them.
+type ImageProcessor struct{}
+
+func NewImageProcessor() *ImageProcessor {
+	return &ImageProcessor{}
+}
+
+// Resize implements driven.ImageProcessor.
+func (ip *ImageProcessor) Resize(content []byte, variants []entity.PhotoVariant) (map[string][]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, entity.ErrUnsupportedImage
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, entity.ErrUnsupportedImage
+	}
+
+	// flatten onto white so transparent PNG pixels do not turn black in the JPEG
+	source := image.NewRGBA(decoded.Bounds())
+	draw.Draw(source, source.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(source, source.Bounds(), decoded, decoded.Bounds().Min, draw.Over)
+
+	result := make(map[string][]byte, len(variants))
+	for _, variant := range variants {
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, scaleDown(source, variant.MaxSize), &jpeg.Options{Quality: jpegQuality})
+		if err != nil {
+			return nil, err
+		}
+		result[variant.Name] = buf.Bytes()
+	}
+	return result, nil
+}
+
+// scaleDown fits source into maxSize pixels on its longest side by averaging the source pixels
+// covered by every destination pixel, images that already fit are never scaled up.
+func scaleDown(source *image.RGBA, maxSize int) *image.RGBA {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return source
+	}
+
+	dstWidth, dstHeight := maxSize, maxSize
+	if width > height {
+		dstHeight = max(1, height*maxSize/width)
+	} else {
+		dstWidth = max(1, width*maxSize/height)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		y0 := y * height / dstHeight
+		y1 := max(y0+1, (y+1)*height/dstHeight)
+		for x := 0; x < dstWidth; x++ {
+			x0 := x * width / dstWidth
+			x1 := max(x0+1, (x+1)*width/dstWidth)
+
+			var r, g, b, a, count int
+			for sy := y0; sy < y1; sy++ {
+				offset := source.PixOffset(bounds.Min.X+x0, bounds.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(source.Pix[offset])
+					g += int(source.Pix[offset+1])
+					b += int(source.Pix[offset+2])
+					a += int(source.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
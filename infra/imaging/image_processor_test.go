@@ -0,0 +1,93 @@
+package imaging
+
+import (
+	"app/internal/profile/entity"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageProcessor_Resize(t *testing.T) {
+	processor := NewImageProcessor()
+	variants := []entity.PhotoVariant{{Name: "medium", MaxSize: 100}, {Name: "thumbnail", MaxSize: 20}}
+
+	encodePNG := func(width, height int) []byte {
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for i := range img.Pix {
+			img.Pix[i] = 0xff
+		}
+		img.Set(0, 0, color.RGBA{R: 0xff, A: 0xff})
+		var buf bytes.Buffer
+		_ = png.Encode(&buf, img)
+		return buf.Bytes()
+	}
+
+	testCases := []struct {
+		name    string
+		content []byte
+		sizes   map[string]image.Point
+		err     error
+	}{
+		{
+			name:    "when image is larger than the variants, it should scale it down keeping the aspect ratio",
+			content: encodePNG(400, 200),
+			sizes: map[string]image.Point{
+				"medium":    {X: 100, Y: 50},
+				"thumbnail": {X: 20, Y: 10},
+			},
+		},
+		{
+			name:    "when image is smaller than a variant, it should not scale it up",
+			content: encodePNG(50, 80),
+			sizes: map[string]image.Point{
+				"medium":    {X: 50, Y: 80},
+				"thumbnail": {X: 12, Y: 20},
+			},
+		},
+		{
+			name:    "when content is not an image, it should return unsupported image error",
+			content: []byte("not an image"),
+			err:     entity.ErrUnsupportedImage,
+		},
+		{
+			name:    "when image has too many pixels, it should return unsupported image error",
+			content: withPNGSize(encodePNG(1, 1), 8000, 5001),
+			err:     entity.ErrUnsupportedImage,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := processor.Resize(tc.content, variants)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, len(tc.sizes))
+			for name, size := range tc.sizes {
+				img, err := jpeg.Decode(bytes.NewReader(result[name]))
+				assert.NoError(t, err)
+				assert.Equal(t, size, img.Bounds().Size(), name)
+			}
+		})
+	}
+}
+
+// withPNGSize rewrites the dimensions in the header of a PNG so a huge image does not have to be allocated.
+func withPNGSize(content []byte, width, height uint32) []byte {
+	// 8 bytes signature, 4 bytes length, then the IHDR chunk type and data
+	header := content[12 : 12+4+13]
+	binary.BigEndian.PutUint32(header[4:], width)
+	binary.BigEndian.PutUint32(header[8:], height)
+	binary.BigEndian.PutUint32(content[12+4+13:], crc32.ChecksumIEEE(header))
+	return content
+}
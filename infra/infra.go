@@ -1,16 +1,247 @@
 package infra
 
 import (
+	"app/configs"
 	"app/infra/database"
 	"app/infra/encryption"
+	"app/infra/imaging"
+	"app/infra/memory"
+	"app/infra/sms"
+	"app/infra/storage"
 	tokenprovider "app/infra/token_provider"
+	twofactor "app/infra/two_factor"
+	profileentity "app/internal/profile/entity"
+	profiledriven "app/internal/profile/port/driven"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"fmt"
+	"net"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 var ProviderSet = wire.NewSet(
 	database.NewPostgresDB,
-	encryption.NewBcryptEncryption,
+	encryption.NewEncryption,
 	database.NewUserRepository,
 	tokenprovider.NewUserJwtProvider,
+	tokenprovider.NewRefreshTokenProvider,
+	database.NewRefreshTokenRepository,
+	NewTokenRevocationStore,
+	database.NewLoginAttemptRepository,
+	NewLoginThrottle,
+	NewRateLimitStore,
+	NewRateLimits,
+	NewIdempotencyStore,
+	NewIdempotencyPolicy,
+	NewTrustedProxies,
+	tokenprovider.NewOTPProvider,
+	database.NewOTPRepository,
+	NewSMSSender,
+	tokenprovider.NewPasswordResetTicketProvider,
+	database.NewPasswordResetTicketRepository,
+	twofactor.NewTOTPProvider,
+	database.NewRecoveryCodeRepository,
+	database.NewMFAChallengeRepository,
+	database.NewSessionRepository,
+	database.NewPasswordHistoryRepository,
+	database.NewDataExportRepository,
+	database.NewPreferencesRepository,
+	database.NewLocationRepository,
+	database.NewProfileRepository,
+	database.NewPhotoRepository,
+	database.NewInterestRepository,
+	imaging.NewImageProcessor,
+	NewFileStorage,
+	NewBlobStorage,
+	NewUserPolicy,
+	NewPhotoPolicy,
+	NewInterestPolicy,
 )
+
+// NewTokenRevocationStore selects the revocation store configured in jwt.revocation_store.
+// The in-memory store is only safe when a single instance is running.
+func NewTokenRevocationStore(conf *configs.ApplicationConfig, db *database.PostgresDB) driven.TokenRevocationStore {
+	if conf.JWT.RevocationStore == "memory" {
+		return memory.NewTokenRevocationStore()
+	}
+	return database.NewTokenRevocationRepository(db)
+}
+
+// NewLoginThrottle builds the login back-off policies from login_throttle.
+func NewLoginThrottle(conf *configs.ApplicationConfig) *entity.LoginThrottle {
+	window := time.Duration(conf.LoginThrottle.WindowSecond) * time.Second
+	return &entity.LoginThrottle{
+		Username: newLoginThrottlePolicy(conf.LoginThrottle.Username, window),
+		ClientIP: newLoginThrottlePolicy(conf.LoginThrottle.ClientIP, window),
+	}
+}
+
+func newLoginThrottlePolicy(conf configs.LoginThrottlePolicy, window time.Duration) entity.LoginThrottlePolicy {
+	return entity.LoginThrottlePolicy{
+		FreeAttempts:     conf.FreeAttempts,
+		BaseDelay:        time.Duration(conf.BaseDelaySecond) * time.Second,
+		LockoutThreshold: conf.LockoutThreshold,
+		Lockout:          time.Duration(conf.LockoutSecond) * time.Second,
+		Window:           window,
+	}
+}
+
+// NewRateLimitStore selects the rate limit store configured in rate_limit.store.
+// The in-memory store only counts the calls reaching this instance.
+func NewRateLimitStore(conf *configs.ApplicationConfig, db *database.PostgresDB) driven.RateLimitStore {
+	if conf.RateLimit.Store == "memory" {
+		return memory.NewRateLimitStore()
+	}
+	return database.NewRateLimitRepository(db)
+}
+
+// NewRateLimits builds the limits of every operation from rate_limit.
+func NewRateLimits(conf *configs.ApplicationConfig) *entity.RateLimits {
+	limits := &entity.RateLimits{
+		Default:    newRateLimitPolicy(conf.RateLimit.Default),
+		Operations: make(map[string]entity.RateLimitPolicy, len(conf.RateLimit.Operations)),
+	}
+	for _, operation := range conf.RateLimit.Operations {
+		limits.Operations[operation.Operation] = newRateLimitPolicy(operation.RateLimitPolicy)
+	}
+	return limits
+}
+
+func newRateLimitPolicy(conf configs.RateLimitPolicy) entity.RateLimitPolicy {
+	return entity.RateLimitPolicy{
+		PerIP:   newRateLimit(conf.PerIP),
+		PerUser: newRateLimit(conf.PerUser),
+	}
+}
+
+func newRateLimit(conf configs.RateLimitRule) entity.RateLimit {
+	return entity.RateLimit{
+		Requests: conf.Requests,
+		Period:   time.Duration(conf.PeriodSecond) * time.Second,
+	}
+}
+
+// NewIdempotencyStore selects the idempotency store configured in idempotency.store.
+// With the in-memory store a retry reaching another instance is processed again.
+func NewIdempotencyStore(conf *configs.ApplicationConfig, db *database.PostgresDB) driven.IdempotencyStore {
+	if conf.Idempotency.Store == "memory" {
+		return memory.NewIdempotencyStore()
+	}
+	return database.NewIdempotencyRepository(db)
+}
+
+func NewIdempotencyPolicy(conf *configs.ApplicationConfig) *entity.IdempotencyPolicy {
+	policy := &entity.IdempotencyPolicy{
+		TTL:         time.Duration(conf.Idempotency.TTLSecond) * time.Second,
+		LockTimeout: time.Duration(conf.Idempotency.LockTimeoutSecond) * time.Second,
+		Operations:  make(map[string]struct{}, len(conf.Idempotency.Operations)),
+	}
+	for _, operation := range conf.Idempotency.Operations {
+		policy.Operations[operation] = struct{}{}
+	}
+	if policy.TTL <= 0 {
+		policy.TTL = 24 * time.Hour
+	}
+	if policy.LockTimeout <= 0 {
+		policy.LockTimeout = time.Minute
+	}
+	return policy
+}
+
+// NewTrustedProxies fails on an entry of server.trusted_proxies that is neither an IP nor a CIDR.
+func NewTrustedProxies(conf *configs.ApplicationConfig) (entity.TrustedProxies, error) {
+	proxies := make(entity.TrustedProxies, 0, len(conf.Server.TrustedProxies))
+	for _, value := range conf.Server.TrustedProxies {
+		if ip := net.ParseIP(value); ip != nil {
+			bits := net.IPv6len * 8
+			if ip.To4() != nil {
+				bits = net.IPv4len * 8
+			}
+			value = fmt.Sprintf("%s/%d", value, bits)
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("server.trusted_proxies: invalid IP or CIDR %q", value)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// NewSMSSender selects the sender configured in sms.driver.
+func NewSMSSender(conf *configs.ApplicationConfig, logger log.Logger) driven.SMSSender {
+	if conf.SMS.Driver == "file" {
+		return sms.NewFileSender(conf.SMS.FilePath)
+	}
+	return sms.NewLogSender(logger)
+}
+
+// NewFileStorage selects the file storage configured in storage.driver, only the local filesystem is supported for now.
+func NewFileStorage(conf *configs.ApplicationConfig) driven.FileStorage {
+	return storage.NewLocalFileStorage(conf.Storage.LocalRoot)
+}
+
+// NewBlobStorage keeps photos in the same storage as the other files, see NewFileStorage.
+func NewBlobStorage(conf *configs.ApplicationConfig) profiledriven.BlobStorage {
+	return storage.NewLocalFileStorage(conf.Storage.LocalRoot)
+}
+
+// NewUserPolicy fails on a gender identity of gender.identities that is not one of entity.Genders.
+func NewUserPolicy(conf *configs.ApplicationConfig) (*entity.UserPolicy, error) {
+	policy := &entity.UserPolicy{
+		RequireVerifiedPhone:   conf.PhoneVerification.Required,
+		ConcealSignupConflicts: conf.Signup.ConcealExistingIdentifiers,
+		MFAChallengeTTL:        time.Duration(conf.MFA.ChallengeExpiresSecond) * time.Second,
+		MFAMaxAttempts:         conf.MFA.MaxAttempts,
+		PasswordHistorySize:    conf.Password.HistorySize,
+		DeletionGracePeriod:    time.Duration(conf.AccountDeletion.GracePeriodSecond) * time.Second,
+		DataExportTTL:          time.Duration(conf.DataExport.ExpiresSecond) * time.Second,
+	}
+	for _, identity := range conf.Gender.Identities {
+		gender, ok := entity.GenderFromString(identity)
+		if !ok {
+			return nil, fmt.Errorf("gender.identities: unknown gender identity %q", identity)
+		}
+		policy.Genders = append(policy.Genders, gender)
+	}
+	if policy.MFAChallengeTTL <= 0 {
+		policy.MFAChallengeTTL = 5 * time.Minute
+	}
+	if policy.MFAMaxAttempts <= 0 {
+		policy.MFAMaxAttempts = 5
+	}
+	if policy.DeletionGracePeriod <= 0 {
+		policy.DeletionGracePeriod = 30 * 24 * time.Hour
+	}
+	if policy.DataExportTTL <= 0 {
+		policy.DataExportTTL = 24 * time.Hour
+	}
+	return policy, nil
+}
+
+func NewPhotoPolicy(conf *configs.ApplicationConfig) *profileentity.PhotoPolicy {
+	policy := &profileentity.PhotoPolicy{
+		MaxPhotos:      conf.Photo.MaxPhotos,
+		MaxUploadBytes: conf.Photo.MaxUploadBytes,
+	}
+	if policy.MaxPhotos <= 0 {
+		policy.MaxPhotos = 6
+	}
+	if policy.MaxUploadBytes <= 0 {
+		policy.MaxUploadBytes = 10 << 20
+	}
+	return policy
+}
+
+func NewInterestPolicy(conf *configs.ApplicationConfig) *profileentity.InterestPolicy {
+	policy := &profileentity.InterestPolicy{
+		MaxInterests: conf.Interest.MaxPerUser,
+	}
+	if policy.MaxInterests <= 0 {
+		policy.MaxInterests = 10
+	}
+	return policy
+}
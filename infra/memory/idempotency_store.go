@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	_ driven.IdempotencyStore = new(IdempotencyStore)
+)
+
+// IdempotencyStore keeps idempotency records in process memory, a retry reaching another instance
+// is processed again so it is meant for single instance deployments and local development.
+type IdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]entity.IdempotencyRecord
+	now     func() time.Time
+}
+
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{
+		records: make(map[string]entity.IdempotencyRecord),
+		now:     time.Now,
+	}
+}
+
+// Reserve implements driven.IdempotencyStore.
+// Expired records are pruned on every reservation.
+func (s *IdempotencyStore) Reserve(ctx context.Context, record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, stored := range s.records {
+		if !now.Before(stored.ExpiresAt) {
+			delete(s.records, key)
+		}
+	}
+
+	if existing, ok := s.records[record.Key]; ok {
+		return &existing, nil
+	}
+	s.records[record.Key] = *record
+	return nil, nil
+}
+
+// Complete implements driven.IdempotencyStore.
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		record.Response = response
+		record.ExpiresAt = expiresAt
+		s.records[key] = record
+	}
+	return nil
+}
+
+// Release implements driven.IdempotencyStore.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	now := time.Now()
+	store := NewIdempotencyStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	assert := assert.New(t)
+
+	existing, err := store.Reserve(ctx, &entity.IdempotencyRecord{Key: "key-1", Fingerprint: "a", ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(err)
+	assert.Nil(existing, "it should reserve a new key")
+
+	existing, err = store.Reserve(ctx, &entity.IdempotencyRecord{Key: "key-1", Fingerprint: "b", ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(err)
+	assert.Equal("a", existing.Fingerprint, "it should return the record holding the key")
+	assert.False(existing.IsCompleted())
+
+	assert.NoError(store.Complete(ctx, "key-1", []byte("response"), now.Add(time.Hour)))
+	existing, err = store.Reserve(ctx, &entity.IdempotencyRecord{Key: "key-1", Fingerprint: "a", ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(err)
+	assert.Equal([]byte("response"), existing.Response, "it should keep the response until the record expires")
+
+	now = now.Add(2 * time.Hour)
+	existing, err = store.Reserve(ctx, &entity.IdempotencyRecord{Key: "key-1", Fingerprint: "b", ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(err)
+	assert.Nil(existing, "it should reserve the key again once the record expired")
+
+	assert.NoError(store.Release(ctx, "key-1"))
+	existing, err = store.Reserve(ctx, &entity.IdempotencyRecord{Key: "key-1", Fingerprint: "c", ExpiresAt: now.Add(time.Minute)})
+	assert.NoError(err)
+	assert.Nil(existing, "it should reserve a released key")
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	_ driven.RateLimitStore = new(RateLimitStore)
+)
+
+// rateLimitPruneInterval is how often buckets that refilled completely are dropped.
+const rateLimitPruneInterval = time.Minute
+
+// RateLimitStore keeps token buckets in process memory, every instance counts on its own
+// so it is meant for single instance deployments and local development.
+type RateLimitStore struct {
+	mu       sync.Mutex
+	buckets  map[string]*entity.TokenBucket
+	prunedAt time.Time
+	now      func() time.Time
+}
+
+func NewRateLimitStore() *RateLimitStore {
+	return &RateLimitStore{
+		buckets: make(map[string]*entity.TokenBucket),
+		now:     time.Now,
+	}
+}
+
+// Take implements driven.RateLimitStore.
+func (s *RateLimitStore) Take(ctx context.Context, key string, limit entity.RateLimit) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = new(entity.TokenBucket)
+		s.buckets[key] = bucket
+	}
+	return bucket.Take(limit, now), nil
+}
+
+// prune drops the buckets that are full again, they behave the same as a key that was never seen.
+func (s *RateLimitStore) prune(now time.Time) {
+	if now.Sub(s.prunedAt) < rateLimitPruneInterval {
+		return
+	}
+	s.prunedAt = now
+
+	for key, bucket := range s.buckets {
+		if !now.Before(bucket.FullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
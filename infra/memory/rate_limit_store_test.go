@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitStore_Take(t *testing.T) {
+	now := time.Now()
+	store := NewRateLimitStore()
+	store.now = func() time.Time { return now }
+	limit := entity.RateLimit{Requests: 2, Period: 10 * time.Second}
+	assert := assert.New(t)
+
+	for i := 0; i < 2; i++ {
+		retryAfter, err := store.Take(context.Background(), "ip:1", limit)
+		assert.NoError(err)
+		assert.Zero(retryAfter, "it should allow a burst up to the limit")
+	}
+
+	retryAfter, err := store.Take(context.Background(), "ip:1", limit)
+	assert.NoError(err)
+	assert.Equal(5*time.Second, retryAfter, "it should wait for the next token once the bucket is empty")
+
+	retryAfter, err = store.Take(context.Background(), "ip:2", limit)
+	assert.NoError(err)
+	assert.Zero(retryAfter, "it should count every key on its own")
+
+	now = now.Add(5 * time.Second)
+	retryAfter, err = store.Take(context.Background(), "ip:1", limit)
+	assert.NoError(err)
+	assert.Zero(retryAfter, "it should refill the bucket over time")
+
+	now = now.Add(time.Hour)
+	_, err = store.Take(context.Background(), "ip:3", limit)
+	assert.NoError(err)
+	assert.NotContains(store.buckets, "ip:1", "it should prune the buckets that are full again")
+	assert.Contains(store.buckets, "ip:3")
+}
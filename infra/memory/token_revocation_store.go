@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	_ driven.TokenRevocationStore = new(TokenRevocationStore)
+)
+
+// TokenRevocationStore keeps revocations in process memory,
+// it is meant for single instance deployments and local development.
+type TokenRevocationStore struct {
+	mu           sync.RWMutex
+	tokens       map[string]time.Time
+	issuedBefore map[int64]time.Time
+	now          func() time.Time
+}
+
+func NewTokenRevocationStore() *TokenRevocationStore {
+	return &TokenRevocationStore{
+		tokens:       make(map[string]time.Time),
+		issuedBefore: make(map[int64]time.Time),
+		now:          time.Now,
+	}
+}
+
+// Revoke implements driven.TokenRevocationStore.
+// Entries of tokens that already expired are pruned on every revocation.
+func (s *TokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for id, tokenExpiresAt := range s.tokens {
+		if !now.Before(tokenExpiresAt) {
+			delete(s.tokens, id)
+		}
+	}
+
+	if now.Before(expiresAt) {
+		s.tokens[tokenID] = expiresAt
+	}
+	return nil
+}
+
+// RevokeUser implements driven.TokenRevocationStore.
+func (s *TokenRevocationStore) RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issuedBefore = issuedBefore.Truncate(time.Second)
+	if current, ok := s.issuedBefore[userID]; !ok || issuedBefore.After(current) {
+		s.issuedBefore[userID] = issuedBefore
+	}
+	return nil
+}
+
+// IsRevoked implements driven.TokenRevocationStore.
+func (s *TokenRevocationStore) IsRevoked(ctx context.Context, claims *entity.UserClaims) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, id := range []string{claims.TokenID, claims.SessionID} {
+		if expiresAt, ok := s.tokens[id]; ok && id != "" && s.now().Before(expiresAt) {
+			return true, nil
+		}
+	}
+
+	if issuedBefore, ok := s.issuedBefore[claims.UserID]; ok && claims.IssuedAt.Before(issuedBefore) {
+		return true, nil
+	}
+	return false, nil
+}
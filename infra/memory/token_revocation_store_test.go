@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRevocationStore_IsRevoked(t *testing.T) {
+	now := time.Now()
+	store := NewTokenRevocationStore()
+	store.now = func() time.Time { return now }
+
+	assert := assert.New(t)
+	assert.NoError(store.Revoke(context.Background(), "revoked", now.Add(time.Hour)))
+	assert.NoError(store.RevokeUser(context.Background(), 2, now))
+	assert.NoError(store.Revoke(context.Background(), "revoked-session", now.Add(time.Hour)))
+
+	tests := []struct {
+		name   string
+		claims *entity.UserClaims
+		want   bool
+	}{
+		{
+			name:   "when token id revoked, it should return true",
+			claims: &entity.UserClaims{UserID: 1, TokenID: "revoked", IssuedAt: now},
+			want:   true,
+		},
+		{
+			name:   "when token id not revoked, it should return false",
+			claims: &entity.UserClaims{UserID: 1, TokenID: "active", IssuedAt: now},
+			want:   false,
+		},
+		{
+			name:   "when user revoked after token issued, it should return true",
+			claims: &entity.UserClaims{UserID: 2, TokenID: "old", IssuedAt: now.Add(-time.Minute)},
+			want:   true,
+		},
+		{
+			name:   "when token issued after user revoked, it should return false",
+			claims: &entity.UserClaims{UserID: 2, TokenID: "new", IssuedAt: now.Add(time.Second)},
+			want:   false,
+		},
+		{
+			name:   "when token issued in the same second right after user revoked, it should return false",
+			claims: &entity.UserClaims{UserID: 2, TokenID: "relogin", IssuedAt: now.Truncate(time.Second)},
+			want:   false,
+		},
+		{
+			name:   "when session of the token revoked, it should return true",
+			claims: &entity.UserClaims{UserID: 1, TokenID: "active", SessionID: "revoked-session", IssuedAt: now},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.IsRevoked(context.Background(), tt.claims)
+			assert.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestTokenRevocationStore_LoginRightAfterRevokeUser(t *testing.T) {
+	store := NewTokenRevocationStore()
+
+	assert := assert.New(t)
+	assert.NoError(store.RevokeUser(context.Background(), 1, time.Now()))
+
+	// issue times of tokens are cut to whole seconds, see jwt.NewNumericDate.
+	claims := &entity.UserClaims{UserID: 1, TokenID: "relogin", IssuedAt: jwt.NewNumericDate(time.Now()).Time}
+	revoked, err := store.IsRevoked(context.Background(), claims)
+	assert.NoError(err)
+	assert.False(revoked)
+}
+
+func TestTokenRevocationStore_PruneExpired(t *testing.T) {
+	now := time.Now()
+	store := NewTokenRevocationStore()
+	store.now = func() time.Time { return now }
+
+	assert := assert.New(t)
+	assert.NoError(store.Revoke(context.Background(), "short", now.Add(time.Minute)))
+	assert.NoError(store.Revoke(context.Background(), "long", now.Add(time.Hour)))
+
+	now = now.Add(2 * time.Minute)
+	revoked, err := store.IsRevoked(context.Background(), &entity.UserClaims{TokenID: "short"})
+	assert.NoError(err)
+	assert.False(revoked)
+
+	assert.NoError(store.Revoke(context.Background(), "other", now.Add(time.Hour)))
+	assert.NotContains(store.tokens, "short")
+	assert.Contains(store.tokens, "long")
+	assert.Contains(store.tokens, "other")
+}
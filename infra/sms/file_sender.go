@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"app/internal/user/port/driven"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	_ driven.SMSSender = new(FileSender)
+)
+
+// FileSender appends every message as a line to a file so tests and developers can read the codes.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{
+		path: path,
+	}
+}
+
+// Send implements driven.SMSSender.
+func (fs *FileSender) Send(ctx context.Context, phoneNumber, message string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	file, err := os.OpenFile(fs.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phoneNumber, message)
+	return err
+}
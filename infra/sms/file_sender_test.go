@@ -0,0 +1,30 @@
+package sms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSender_Send(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "sms.log")
+	sender := NewFileSender(path)
+
+	assert.NoError(sender.Send(context.Background(), "+6281234567890", "your code is 123456"))
+	assert.NoError(sender.Send(context.Background(), "+6289876543210", "your code is 654321"))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(lines, 2)
+	assert.True(strings.HasSuffix(lines[0], "\t+6281234567890\tyour code is 123456"))
+	assert.True(strings.HasSuffix(lines[1], "\t+6289876543210\tyour code is 654321"))
+
+	sender = NewFileSender(filepath.Join(t.TempDir(), "missing", "sms.log"))
+	assert.Error(sender.Send(context.Background(), "+6281234567890", "your code is 123456"), "it should fail when the directory does not exist")
+}
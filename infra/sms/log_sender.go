@@ -0,0 +1,28 @@
+package sms
+
+import (
+	"app/internal/user/port/driven"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	_ driven.SMSSender = new(LogSender)
+)
+
+// LogSender writes messages to the application log instead of sending them, it is meant for local development.
+type LogSender struct {
+	log log.Logger
+}
+
+func NewLogSender(logger log.Logger) *LogSender {
+	return &LogSender{
+		log: logger,
+	}
+}
+
+// Send implements driven.SMSSender.
+func (ls *LogSender) Send(ctx context.Context, phoneNumber, message string) error {
+	return ls.log.Log(log.LevelInfo, "msg", "sms", "phone_number", phoneNumber, "message", message)
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	profiledriven "app/internal/profile/port/driven"
+	"app/internal/user/port/driven"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	_ driven.FileStorage        = new(LocalFileStorage)
+	_ profiledriven.BlobStorage = new(LocalFileStorage)
+)
+
+// LocalFileStorage keeps files under a directory of the local filesystem,
+// it is only meant for a single instance since other instances cannot read the files.
+type LocalFileStorage struct {
+	root string
+}
+
+func NewLocalFileStorage(root string) *LocalFileStorage {
+	return &LocalFileStorage{
+		root: root,
+	}
+}
+
+// Put implements driven.FileStorage.
+func (ls *LocalFileStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so a reader never sees a partial file
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// Open implements driven.FileStorage.
+func (ls *LocalFileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// Delete implements driven.FileStorage.
+func (ls *LocalFileStorage) Delete(ctx context.Context, key string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// DeletePrefix implements driven.FileStorage and profiledriven.BlobStorage.
+// Keys are paths so a prefix ending with a slash is a directory.
+func (ls *LocalFileStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	if !strings.HasSuffix(prefix, "/") {
+		return errors.New("file key prefix must end with a slash")
+	}
+
+	path, err := ls.path(prefix)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(path)
+}
+
+// path maps key inside root and refuses keys that would escape it.
+func (ls *LocalFileStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + filepath.FromSlash(key))
+	if cleaned == string(filepath.Separator) || strings.Contains(key, "\x00") {
+		return "", errors.New("invalid file key")
+	}
+	return filepath.Join(ls.root, cleaned), nil
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalFileStorage(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	storage := NewLocalFileStorage(root)
+	ctx := context.Background()
+
+	t.Run("when file is put, it should be opened with the same content", func(t *testing.T) {
+		assert.NoError(storage.Put(ctx, "exports/1/archive.zip", strings.NewReader("content")))
+
+		file, err := storage.Open(ctx, "exports/1/archive.zip")
+		assert.NoError(err)
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		assert.Equal("content", string(content))
+	})
+
+	t.Run("when file does not exist, it should return not exist error", func(t *testing.T) {
+		_, err := storage.Open(ctx, "exports/1/missing.zip")
+		assert.ErrorIs(err, fs.ErrNotExist)
+	})
+
+	t.Run("when key goes up the directory tree, it should stay inside the root", func(t *testing.T) {
+		assert.NoError(storage.Put(ctx, "../../escaped.txt", strings.NewReader("content")))
+
+		_, err := os.Stat(filepath.Join(root, "escaped.txt"))
+		assert.NoError(err)
+	})
+
+	t.Run("when key is empty, it should return error", func(t *testing.T) {
+		assert.Error(storage.Put(ctx, "", strings.NewReader("content")))
+	})
+
+	t.Run("when file is deleted, it should not be opened anymore", func(t *testing.T) {
+		assert.NoError(storage.Delete(ctx, "exports/1/archive.zip"))
+		assert.NoError(storage.Delete(ctx, "exports/1/archive.zip"))
+
+		_, err := storage.Open(ctx, "exports/1/archive.zip")
+		assert.ErrorIs(err, fs.ErrNotExist)
+	})
+
+	t.Run("when prefix is deleted, it should remove every file under it only", func(t *testing.T) {
+		assert.NoError(storage.Put(ctx, "photos/1/a/thumbnail.jpg", strings.NewReader("content")))
+		assert.NoError(storage.Put(ctx, "photos/1/b/large.jpg", strings.NewReader("content")))
+		assert.NoError(storage.Put(ctx, "photos/12/a/thumbnail.jpg", strings.NewReader("content")))
+
+		assert.NoError(storage.DeletePrefix(ctx, "photos/1/"))
+		assert.NoError(storage.DeletePrefix(ctx, "photos/1/"))
+
+		_, err := storage.Open(ctx, "photos/1/a/thumbnail.jpg")
+		assert.ErrorIs(err, fs.ErrNotExist)
+		_, err = storage.Open(ctx, "photos/1/b/large.jpg")
+		assert.ErrorIs(err, fs.ErrNotExist)
+		file, err := storage.Open(ctx, "photos/12/a/thumbnail.jpg")
+		assert.NoError(err)
+		file.Close()
+	})
+
+	t.Run("when prefix does not end with a slash, it should return error", func(t *testing.T) {
+		assert.Error(storage.DeletePrefix(ctx, "photos/1"))
+		assert.Error(storage.DeletePrefix(ctx, "/"))
+	})
+}
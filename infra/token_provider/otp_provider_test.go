@@ -0,0 +1,40 @@
+package tokenprovider
+
+import (
+	"app/configs"
+	"app/internal/user/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTPProvider_Generate(t *testing.T) {
+	assert := assert.New(t)
+	provider, err := NewOTPProvider(&configs.ApplicationConfig{OTP: configs.OTP{Secret: "secret"}})
+	assert.NoError(err)
+
+	otp, err := provider.Generate(10, entity.OTPPurposePhoneVerification)
+	assert.NoError(err)
+	assert.Len(otp.Code, defaultOTPLength)
+	assert.Regexp(`^[0-9]+$`, otp.Code)
+	assert.Equal(int64(10), otp.UserID)
+	assert.Equal(entity.OTPPurposePhoneVerification, otp.Purpose)
+	assert.Equal(defaultOTPMaxAttempts, otp.MaxAttempts)
+	assert.WithinDuration(time.Now().Add(defaultOTPExpiresSecond*time.Second), otp.ExpiresAt, time.Second)
+	assert.Equal(provider.Hash(otp.Code), otp.CodeHash)
+	assert.NotContains(otp.CodeHash, otp.Code)
+
+	otherSecret, err := NewOTPProvider(&configs.ApplicationConfig{OTP: configs.OTP{Secret: "other", Length: 8}})
+	assert.NoError(err)
+	assert.NotEqual(provider.Hash(otp.Code), otherSecret.Hash(otp.Code), "it should key the hash with the secret")
+
+	otp, err = otherSecret.Generate(10, entity.OTPPurposePhoneVerification)
+	assert.NoError(err)
+	assert.Len(otp.Code, 8)
+}
+
+func TestNewOTPProvider_withoutSecret(t *testing.T) {
+	_, err := NewOTPProvider(&configs.ApplicationConfig{OTP: configs.OTP{Length: 6}})
+	assert.Error(t, err, "it should refuse to hash codes with an empty key")
+}
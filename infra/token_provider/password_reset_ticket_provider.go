@@ -0,0 +1,59 @@
+package tokenprovider
+
+import (
+	"app/configs"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	passwordResetTicketBytes = 32
+
+	// 10 minutes
+	defaultPasswordResetTicketExpiresSecond = 600
+)
+
+var (
+	_ driven.PasswordResetTicketProvider = new(PasswordResetTicketProvider)
+)
+
+type PasswordResetTicketProvider struct {
+	ExpiresSecond int
+}
+
+func NewPasswordResetTicketProvider(conf *configs.ApplicationConfig) *PasswordResetTicketProvider {
+	expiresSecond := conf.PasswordReset.TicketExpiresSecond
+	if expiresSecond <= 0 {
+		expiresSecond = defaultPasswordResetTicketExpiresSecond
+	}
+	return &PasswordResetTicketProvider{
+		ExpiresSecond: expiresSecond,
+	}
+}
+
+// Generate implements driven.PasswordResetTicketProvider.
+func (ptp *PasswordResetTicketProvider) Generate(userID int64) (*entity.PasswordResetTicket, error) {
+	randomBytes := make([]byte, passwordResetTicketBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	ticket := base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	return &entity.PasswordResetTicket{
+		UserID:     userID,
+		Ticket:     ticket,
+		TicketHash: ptp.Hash(ticket),
+		ExpiresAt:  time.Now().Add(time.Second * time.Duration(ptp.ExpiresSecond)),
+	}, nil
+}
+
+// Hash implements driven.PasswordResetTicketProvider.
+func (*PasswordResetTicketProvider) Hash(ticket string) string {
+	sum := sha256.Sum256([]byte(ticket))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,71 @@
+package tokenprovider
+
+import (
+	"app/configs"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	refreshTokenBytes = 32
+
+	// 30 days
+	defaultRefreshExpiresSecond = 2592000
+)
+
+var (
+	_ driven.RefreshTokenProvider = new(RefreshTokenProvider)
+)
+
+type RefreshTokenProvider struct {
+	ExpiresSecond int
+}
+
+func NewRefreshTokenProvider(conf *configs.ApplicationConfig) *RefreshTokenProvider {
+	expiresSecond := conf.JWT.RefreshExpiresSecond
+	if expiresSecond <= 0 {
+		expiresSecond = defaultRefreshExpiresSecond
+	}
+	return &RefreshTokenProvider{
+		ExpiresSecond: expiresSecond,
+	}
+}
+
+// Generate implements driven.RefreshTokenProvider.
+func (rtp *RefreshTokenProvider) Generate(userID int64, familyID string) (*entity.RefreshToken, error) {
+	if familyID == "" {
+		newFamilyID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		familyID = newFamilyID.String()
+	}
+
+	randomBytes := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	return &entity.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		Token:     token,
+		TokenHash: rtp.Hash(token),
+		ExpiresAt: time.Now().Add(time.Second * time.Duration(rtp.ExpiresSecond)),
+	}, nil
+}
+
+// Hash implements driven.RefreshTokenProvider.
+// Only the hash is persisted so a leaked table cannot be replayed.
+func (*RefreshTokenProvider) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
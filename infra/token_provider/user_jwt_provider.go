@@ -6,52 +6,108 @@ import (
 	"app/internal/user/param/response"
 	"app/internal/user/port/driven"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+const (
+	tokenIssuer   = "dating-be"
+	tokenAudience = "dating-be"
+)
+
 var (
-	_ driven.TokenProvider[*entity.User] = new(UserJwtProvider)
+	_ driven.TokenProvider[*entity.AccessTokenSubject] = new(UserJwtProvider)
+	_ driven.TokenValidator[*entity.UserClaims]        = new(UserJwtProvider)
+	_ driven.TokenKeySet                               = new(UserJwtProvider)
 )
 
+// userJwtClaims adds the session the token was issued in and the roles of the user to the registered claims.
+type userJwtClaims struct {
+	jwt.RegisteredClaims
+	SessionID string   `json:"sid,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+}
+
 type UserJwtProvider struct {
+	// KeyID identifies PrivateKey, it is written in the kid header of every generated token.
+	KeyID         string
 	PrivateKey    *rsa.PrivateKey
-	PublicKey     *rsa.PublicKey
+	PublicKeys    map[string]*rsa.PublicKey
 	ExpiresSecond int
 }
 
+// NewUserJwtProvider loads the keyring from conf.JWT.Keys, falling back to the single
+// private_key/public_key pair. Keys without an id are identified by their RFC 7638 thumbprint.
 func NewUserJwtProvider(conf *configs.ApplicationConfig) *UserJwtProvider {
-	secretKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(conf.JWT.PrivateKey))
-	if err != nil {
-		panic(err)
+	keys := conf.JWT.Keys
+	if len(keys) == 0 {
+		keys = []configs.JWTKey{{PrivateKey: conf.JWT.PrivateKey, PublicKey: conf.JWT.PublicKey}}
 	}
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(conf.JWT.PublicKey))
-	if err != nil {
-		panic(err)
-	}
-	return &UserJwtProvider{
-		PrivateKey:    secretKey,
-		PublicKey:     publicKey,
+
+	provider := &UserJwtProvider{
+		PublicKeys:    make(map[string]*rsa.PublicKey, len(keys)),
 		ExpiresSecond: conf.JWT.ExpiresSecond,
 	}
+	for _, key := range keys {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(key.PublicKey))
+		if err != nil {
+			panic(err)
+		}
+		keyID := key.ID
+		if keyID == "" {
+			keyID = thumbprint(publicKey)
+		}
+		provider.PublicKeys[keyID] = publicKey
+
+		if key.PrivateKey == "" || provider.PrivateKey != nil {
+			continue
+		}
+		if conf.JWT.ActiveKeyID != "" && conf.JWT.ActiveKeyID != keyID {
+			continue
+		}
+		secretKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+		if err != nil {
+			panic(err)
+		}
+		provider.KeyID = keyID
+		provider.PrivateKey = secretKey
+	}
+
+	if provider.PrivateKey == nil {
+		panic(fmt.Errorf("jwt signing key %q not found in keyring", conf.JWT.ActiveKeyID))
+	}
+	return provider
 }
 
-func (utp *UserJwtProvider) Generate(user *entity.User) (*response.Token, error) {
+// Generate implements driven.TokenProvider.
+func (utp *UserJwtProvider) Generate(subject *entity.AccessTokenSubject) (*response.Token, error) {
 	jwtID, _ := uuid.NewRandom()
-	claims := jwt.RegisteredClaims{
-		Issuer:    "dating-be",
-		Subject:   fmt.Sprintf("%d", user.ID),
-		Audience:  []string{"dating-be"},
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Second * time.Duration(utp.ExpiresSecond))),
-		NotBefore: jwt.NewNumericDate(time.Now()),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ID:        jwtID.String(),
+	claims := userJwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			Subject:   fmt.Sprintf("%d", subject.User.ID),
+			Audience:  []string{tokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Second * time.Duration(utp.ExpiresSecond))),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        jwtID.String(),
+		},
+		SessionID: subject.SessionID,
+		Roles:     []string{subject.User.Role.String()},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = utp.KeyID
 	tokenString, err := token.SignedString(utp.PrivateKey)
 	if err != nil {
 		return nil, err
@@ -59,7 +115,102 @@ func (utp *UserJwtProvider) Generate(user *entity.User) (*response.Token, error)
 
 	return &response.Token{
 		Token:     tokenString,
-		ExpiresIn: int(time.Hour.Seconds()),
+		ExpiresIn: utp.ExpiresSecond,
 		Type:      "Bearer",
 	}, nil
 }
+
+// Validate implements driven.TokenValidator.
+// It checks the signature, issuer, audience, expiry and not-before of the token.
+func (utp *UserJwtProvider) Validate(tokenString string) (*entity.UserClaims, error) {
+	var claims userJwtClaims
+	_, err := jwt.ParseWithClaims(tokenString, &claims, utp.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithAudience(tokenAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token subject: %w", err)
+	}
+
+	userClaims := &entity.UserClaims{
+		UserID:    userID,
+		TokenID:   claims.ID,
+		SessionID: claims.SessionID,
+		Roles:     []entity.Role{entity.RoleUser},
+	}
+	if len(claims.Roles) > 0 {
+		userClaims.Roles = make([]entity.Role, 0, len(claims.Roles))
+		for _, role := range claims.Roles {
+			userClaims.Roles = append(userClaims.Roles, entity.Role(role))
+		}
+	}
+	if claims.IssuedAt != nil {
+		userClaims.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		userClaims.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return userClaims, nil
+}
+
+// verificationKey picks the public key matching the kid header,
+// tokens issued before kid was introduced are verified with the active key.
+func (utp *UserJwtProvider) verificationKey(token *jwt.Token) (interface{}, error) {
+	keyID, ok := token.Header["kid"].(string)
+	if !ok || keyID == "" {
+		keyID = utp.KeyID
+	}
+
+	publicKey, ok := utp.PublicKeys[keyID]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	return publicKey, nil
+}
+
+// KeySet implements driven.TokenKeySet.
+func (utp *UserJwtProvider) KeySet() *response.JSONWebKeySet {
+	keyIDs := make([]string, 0, len(utp.PublicKeys))
+	for keyID := range utp.PublicKeys {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+
+	keySet := &response.JSONWebKeySet{Keys: make([]response.JSONWebKey, 0, len(keyIDs))}
+	for _, keyID := range keyIDs {
+		publicKey := utp.PublicKeys[keyID]
+		keySet.Keys = append(keySet.Keys, response.JSONWebKey{
+			KeyType:   "RSA",
+			Use:       "sig",
+			Algorithm: jwt.SigningMethodRS256.Alg(),
+			KeyID:     keyID,
+			Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		})
+	}
+	return keySet
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint of publicKey.
+func thumbprint(publicKey *rsa.PublicKey) string {
+	// members must be in lexicographic order without whitespace
+	canonical, _ := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+	})
+	sum := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,213 @@
+package tokenprovider
+
+import (
+	"app/configs"
+	"app/internal/user/entity"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestProvider(t *testing.T) *UserJwtProvider {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyID := thumbprint(&privateKey.PublicKey)
+	return &UserJwtProvider{
+		KeyID:         keyID,
+		PrivateKey:    privateKey,
+		PublicKeys:    map[string]*rsa.PublicKey{keyID: &privateKey.PublicKey},
+		ExpiresSecond: 3600,
+	}
+}
+
+func encodePEM(t *testing.T, key *rsa.PrivateKey) (privatePEM, publicPEM string) {
+	publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	publicPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+	return privatePEM, publicPEM
+}
+
+func signClaims(t *testing.T, key *rsa.PrivateKey, claims jwt.RegisteredClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestUserJwtProvider_Generate(t *testing.T) {
+	provider := newTestProvider(t)
+	provider.ExpiresSecond = 900
+
+	token, err := provider.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 123}})
+	assert.NoError(t, err)
+	assert.Equal(t, 900, token.ExpiresIn, "it should tell the configured lifetime of the token")
+
+	claims, err := provider.Validate(token.Token)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, claims.IssuedAt.Add(900*time.Second), claims.ExpiresAt, time.Second)
+}
+
+func TestUserJwtProvider_Validate(t *testing.T) {
+	provider := newTestProvider(t)
+	otherProvider := newTestProvider(t)
+
+	validToken, err := provider.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 123, Role: entity.RoleAdmin}, SessionID: "session-id"})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	tests := []struct {
+		name          string
+		token         string
+		wantUserID    int64
+		wantSessionID string
+		wantRoles     []entity.Role
+		wantErr       bool
+	}{
+		{
+			name:          "when token generated by provider, it should return the user claims",
+			token:         validToken.Token,
+			wantUserID:    123,
+			wantSessionID: "session-id",
+			wantRoles:     []entity.Role{entity.RoleAdmin},
+			wantErr:       false,
+		},
+		{
+			name:       "when token has no roles, it should return the user role",
+			token:      signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{tokenAudience}, Subject: "123", ID: "token-id", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}),
+			wantUserID: 123,
+			wantRoles:  []entity.Role{entity.RoleUser},
+			wantErr:    false,
+		},
+		{
+			name:    "when token signed with another key, it should return error",
+			token:   signClaims(t, otherProvider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{tokenAudience}, Subject: "123", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}),
+			wantErr: true,
+		},
+		{
+			name:    "when token expired, it should return error",
+			token:   signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{tokenAudience}, Subject: "123", ExpiresAt: jwt.NewNumericDate(now.Add(-time.Minute))}),
+			wantErr: true,
+		},
+		{
+			name:    "when token not valid yet, it should return error",
+			token:   signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{tokenAudience}, Subject: "123", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)), NotBefore: jwt.NewNumericDate(now.Add(time.Minute))}),
+			wantErr: true,
+		},
+		{
+			name:    "when token has no expiry, it should return error",
+			token:   signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{tokenAudience}, Subject: "123"}),
+			wantErr: true,
+		},
+		{
+			name:    "when issuer is different, it should return error",
+			token:   signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: "other", Audience: []string{tokenAudience}, Subject: "123", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}),
+			wantErr: true,
+		},
+		{
+			name:    "when audience is different, it should return error",
+			token:   signClaims(t, provider.PrivateKey, jwt.RegisteredClaims{Issuer: tokenIssuer, Audience: []string{"other"}, Subject: "123", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}),
+			wantErr: true,
+		},
+		{
+			name:    "when token malformed, it should return error",
+			token:   "not-a-token",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.Validate(tt.token)
+			assert := assert.New(t)
+			assert.Equal(tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(tt.wantUserID, got.UserID)
+				assert.Equal(tt.wantSessionID, got.SessionID)
+				assert.Equal(tt.wantRoles, got.Roles)
+				assert.NotEmpty(got.TokenID)
+			}
+		})
+	}
+}
+
+func TestNewUserJwtProvider_Keyring(t *testing.T) {
+	retiredKey := newTestProvider(t)
+	activeKey := newTestProvider(t)
+	retiredPrivatePEM, retiredPublicPEM := encodePEM(t, retiredKey.PrivateKey)
+	activePrivatePEM, activePublicPEM := encodePEM(t, activeKey.PrivateKey)
+
+	assert := assert.New(t)
+
+	t.Run("when only single key pair configured, it should use its thumbprint as kid", func(t *testing.T) {
+		provider := NewUserJwtProvider(&configs.ApplicationConfig{JWT: configs.JWT{
+			PrivateKey: activePrivatePEM,
+			PublicKey:  activePublicPEM,
+		}})
+		assert.Equal(activeKey.KeyID, provider.KeyID)
+
+		token, err := provider.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 1}})
+		assert.NoError(err)
+		parsed, _, err := jwt.NewParser().ParseUnverified(token.Token, &jwt.RegisteredClaims{})
+		assert.NoError(err)
+		assert.Equal(activeKey.KeyID, parsed.Header["kid"])
+	})
+
+	provider := NewUserJwtProvider(&configs.ApplicationConfig{JWT: configs.JWT{
+		ActiveKeyID: "2024-02",
+		Keys: []configs.JWTKey{
+			{ID: "2024-01", PrivateKey: retiredPrivatePEM, PublicKey: retiredPublicPEM},
+			{ID: "2024-02", PrivateKey: activePrivatePEM, PublicKey: activePublicPEM},
+		},
+		ExpiresSecond: 3600,
+	}})
+
+	t.Run("when keyring configured, it should sign with the active key", func(t *testing.T) {
+		token, err := provider.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 1}})
+		assert.NoError(err)
+		parsed, _, err := jwt.NewParser().ParseUnverified(token.Token, &jwt.RegisteredClaims{})
+		assert.NoError(err)
+		assert.Equal("2024-02", parsed.Header["kid"])
+
+		_, err = provider.Validate(token.Token)
+		assert.NoError(err)
+	})
+
+	t.Run("when token signed by retired key, it should still be valid", func(t *testing.T) {
+		retiredKey.KeyID = "2024-01"
+		token, err := retiredKey.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 1}})
+		assert.NoError(err)
+
+		_, err = provider.Validate(token.Token)
+		assert.NoError(err)
+	})
+
+	t.Run("when token kid unknown, it should return error", func(t *testing.T) {
+		unknownKey := newTestProvider(t)
+		token, err := unknownKey.Generate(&entity.AccessTokenSubject{User: &entity.User{ID: 1}})
+		assert.NoError(err)
+
+		_, err = provider.Validate(token.Token)
+		assert.Error(err)
+	})
+
+	t.Run("when key set requested, it should publish every verification key", func(t *testing.T) {
+		keySet := provider.KeySet()
+		assert.Len(keySet.Keys, 2)
+		assert.Equal("2024-01", keySet.Keys[0].KeyID)
+		assert.Equal("2024-02", keySet.Keys[1].KeyID)
+		assert.Equal("RSA", keySet.Keys[1].KeyType)
+		assert.Equal("RS256", keySet.Keys[1].Algorithm)
+		assert.Equal("AQAB", keySet.Keys[1].Exponent)
+	})
+}
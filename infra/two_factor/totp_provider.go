@@ -0,0 +1,139 @@
+package twofactor
+
+import (
+	"app/configs"
+	"app/internal/user/port/driven"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// RFC 6238 defaults understood by every authenticator app
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	totpSecretBytes = 20
+	// accept the previous and next code to tolerate clock drift on the phone
+	totpSkew = 1
+
+	recoveryCodeBytes = 5
+
+	defaultIssuer            = "dating-be"
+	defaultRecoveryCodeCount = 10
+)
+
+var (
+	_ driven.TwoFactorProvider = new(TOTPProvider)
+
+	base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+type TOTPProvider struct {
+	Issuer            string
+	RecoveryCodeCount int
+}
+
+func NewTOTPProvider(conf *configs.ApplicationConfig) *TOTPProvider {
+	provider := &TOTPProvider{
+		Issuer:            conf.MFA.Issuer,
+		RecoveryCodeCount: conf.MFA.RecoveryCodeCount,
+	}
+	if provider.Issuer == "" {
+		provider.Issuer = defaultIssuer
+	}
+	if provider.RecoveryCodeCount <= 0 {
+		provider.RecoveryCodeCount = defaultRecoveryCodeCount
+	}
+	return provider
+}
+
+// GenerateSecret implements driven.TwoFactorProvider.
+func (tp *TOTPProvider) GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// URI implements driven.TwoFactorProvider.
+func (tp *TOTPProvider) URI(secret, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", tp.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + tp.Issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// Validate implements driven.TwoFactorProvider.
+func (tp *TOTPProvider) Validate(secret, code string, now time.Time) bool {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return false
+	}
+
+	counter := now.Unix() / int64(totpPeriod.Seconds())
+	valid := 0
+	for step := -totpSkew; step <= totpSkew; step++ {
+		valid |= subtle.ConstantTimeCompare([]byte(hotp(key, uint64(counter+int64(step)))), []byte(code))
+	}
+	return valid == 1
+}
+
+// GenerateRecoveryCodes implements driven.TwoFactorProvider.
+func (tp *TOTPProvider) GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, tp.RecoveryCodeCount)
+	for i := range codes {
+		randomBytes := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(randomBytes); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(base32NoPadding.EncodeToString(randomBytes))
+		codes[i] = code[:4] + "-" + code[4:]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode implements driven.TwoFactorProvider.
+// Codes are compared case insensitively and without the dash users tend to drop.
+func (tp *TOTPProvider) HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// hotp computes the RFC 4226 code for counter.
+func hotp(key []byte, counter uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
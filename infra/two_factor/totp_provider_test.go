@@ -0,0 +1,102 @@
+package twofactor
+
+import (
+	"app/configs"
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPProvider_Validate(t *testing.T) {
+	// test vectors from RFC 6238 appendix B truncated to 6 digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	provider := NewTOTPProvider(&configs.ApplicationConfig{})
+
+	tests := []struct {
+		name string
+		code string
+		now  time.Time
+		want bool
+	}{
+		{
+			name: "when code matches the current step, it should be valid",
+			code: "287082",
+			now:  time.Unix(59, 0),
+			want: true,
+		},
+		{
+			name: "when code matches the current step at a later time, it should be valid",
+			code: "081804",
+			now:  time.Unix(1111111109, 0),
+			want: true,
+		},
+		{
+			name: "when code is from the previous step, it should tolerate clock drift",
+			code: "081804",
+			now:  time.Unix(1111111109+30, 0),
+			want: true,
+		},
+		{
+			name: "when code is two steps old, it should be invalid",
+			code: "081804",
+			now:  time.Unix(1111111109+60, 0),
+			want: false,
+		},
+		{
+			name: "when code is wrong, it should be invalid",
+			code: "000000",
+			now:  time.Unix(59, 0),
+			want: false,
+		},
+		{
+			name: "when code has wrong length, it should be invalid",
+			code: "94287082",
+			now:  time.Unix(59, 0),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, provider.Validate(secret, tt.code, tt.now))
+		})
+	}
+
+	assert.False(t, provider.Validate("not base32!", "287082", time.Unix(59, 0)))
+}
+
+func TestTOTPProvider_GenerateSecretAndURI(t *testing.T) {
+	assert := assert.New(t)
+	provider := NewTOTPProvider(&configs.ApplicationConfig{MFA: configs.MFA{Issuer: "Dating"}})
+
+	secret, err := provider.GenerateSecret()
+	assert.NoError(err)
+	assert.Len(secret, 32)
+
+	uri, err := url.Parse(provider.URI(secret, "john_doe"))
+	assert.NoError(err)
+	assert.Equal("otpauth", uri.Scheme)
+	assert.Equal("totp", uri.Host)
+	assert.Equal("/Dating:john_doe", uri.Path)
+	assert.Equal(secret, uri.Query().Get("secret"))
+	assert.Equal("Dating", uri.Query().Get("issuer"))
+	assert.Equal("6", uri.Query().Get("digits"))
+	assert.Equal("30", uri.Query().Get("period"))
+}
+
+func TestTOTPProvider_RecoveryCodes(t *testing.T) {
+	assert := assert.New(t)
+	provider := NewTOTPProvider(&configs.ApplicationConfig{MFA: configs.MFA{RecoveryCodeCount: 4}})
+
+	codes, err := provider.GenerateRecoveryCodes()
+	assert.NoError(err)
+	assert.Len(codes, 4)
+	assert.Regexp(`^[a-z2-7]{4}-[a-z2-7]{4}$`, codes[0])
+	assert.NotEqual(codes[0], codes[1])
+
+	assert.Equal(provider.HashRecoveryCode(codes[0]), provider.HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))))
+	assert.NotEqual(provider.HashRecoveryCode(codes[0]), provider.HashRecoveryCode(codes[1]))
+}
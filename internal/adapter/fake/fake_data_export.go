@@ -0,0 +1,165 @@
+package fake
+
+import (
+	profiledriven "app/internal/profile/port/driven"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"time"
+)
+
+var (
+	_ driven.DataExportStore     = new(FakeDataExportStore)
+	_ driven.FileStorage         = new(FakeFileStorage)
+	_ driven.ProfileDataExporter = new(FakeProfileDataExporter)
+
+	_ profiledriven.BlobStorage = new(FakeFileStorage)
+)
+
+type FakeDataExportStore struct {
+	data map[string]*entity.DataExport
+}
+
+func NewFakeDataExportStore() *FakeDataExportStore {
+	return &FakeDataExportStore{
+		data: make(map[string]*entity.DataExport),
+	}
+}
+
+// Create implements driven.DataExportStore.
+func (fds *FakeDataExportStore) Create(ctx context.Context, export *entity.DataExport) error {
+	if val := ctx.Value(ContextType("data_export_error")); val != nil {
+		return errors.New("error")
+	}
+	export.ID = fmt.Sprintf("export-%d", len(fds.data)+1)
+	export.CreatedAt = time.Now()
+	fds.data[export.ID] = export
+	return nil
+}
+
+// GetByID implements driven.DataExportStore.
+func (fds *FakeDataExportStore) GetByID(ctx context.Context, id string) (*entity.DataExport, error) {
+	if export, ok := fds.data[id]; ok {
+		return export, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// ListExpired implements driven.DataExportStore.
+func (fds *FakeDataExportStore) ListExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]*entity.DataExport, error) {
+	if val := ctx.Value(ContextType("list_expired_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	var exports []*entity.DataExport
+	for _, export := range fds.data {
+		if len(exports) >= limit {
+			break
+		}
+		if export.ExpiresAt.Before(expiredBefore) {
+			exports = append(exports, export)
+		}
+	}
+	return exports, nil
+}
+
+// Delete implements driven.DataExportStore.
+func (fds *FakeDataExportStore) Delete(ctx context.Context, ids []string) (int64, error) {
+	if val := ctx.Value(ContextType("delete_data_export_error")); val != nil {
+		return 0, errors.New("error")
+	}
+	var deleted int64
+	for _, id := range ids {
+		if _, ok := fds.data[id]; ok {
+			delete(fds.data, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+type FakeFileStorage struct {
+	files map[string][]byte
+}
+
+func NewFakeFileStorage() *FakeFileStorage {
+	return &FakeFileStorage{
+		files: make(map[string][]byte),
+	}
+}
+
+// Put implements driven.FileStorage.
+func (ffs *FakeFileStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	if val := ctx.Value(ContextType("file_storage_error")); val != nil {
+		return errors.New("error")
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	ffs.files[key] = data
+	return nil
+}
+
+// Open implements driven.FileStorage.
+func (ffs *FakeFileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := ffs.files[key]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete implements driven.FileStorage.
+func (ffs *FakeFileStorage) Delete(ctx context.Context, key string) error {
+	delete(ffs.files, key)
+	return nil
+}
+
+// DeletePrefix implements driven.FileStorage and profiledriven.BlobStorage.
+func (ffs *FakeFileStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	if val := ctx.Value(ContextType("delete_prefix_error")); val != nil {
+		return errors.New("error")
+	}
+	for key := range ffs.files {
+		if strings.HasPrefix(key, prefix) {
+			delete(ffs.files, key)
+		}
+	}
+	return nil
+}
+
+// Keys returns the keys of every stored file.
+func (ffs *FakeFileStorage) Keys() []string {
+	keys := make([]string, 0, len(ffs.files))
+	for key := range ffs.files {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type FakeProfileDataExporter struct{}
+
+func NewFakeProfileDataExporter() *FakeProfileDataExporter {
+	return &FakeProfileDataExporter{}
+}
+
+// ExportProfileData implements driven.ProfileDataExporter, it writes the user ID into profile.json.
+func (fpe *FakeProfileDataExporter) ExportProfileData(ctx context.Context, userID int64, archive *zip.Writer) error {
+	if val := ctx.Value(ContextType("profile_export_error")); val != nil {
+		return errors.New("error")
+	}
+	writer, err := archive.Create("profile.json")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, `{"user_id": %d}`, userID)
+	return err
+}
@@ -0,0 +1,92 @@
+package fake
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"context"
+	"errors"
+	"sort"
+)
+
+var (
+	_ driven.InterestStore = new(FakeInterestStore)
+)
+
+type FakeInterestStore struct {
+	catalog   []*entity.Interest
+	interests map[int64][]int64
+}
+
+func NewFakeInterestStore(catalog ...*entity.Interest) *FakeInterestStore {
+	return &FakeInterestStore{
+		catalog:   catalog,
+		interests: make(map[int64][]int64),
+	}
+}
+
+// ListCatalog implements driven.InterestStore.
+func (fis *FakeInterestStore) ListCatalog(ctx context.Context) ([]*entity.Interest, error) {
+	if val := ctx.Value(ContextType("interest_catalog_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	return fis.catalog, nil
+}
+
+// ListByUserID implements driven.InterestStore.
+func (fis *FakeInterestStore) ListByUserID(ctx context.Context, userID int64) ([]*entity.Interest, error) {
+	selected := make(map[int64]bool)
+	for _, id := range fis.interests[userID] {
+		selected[id] = true
+	}
+
+	interests := []*entity.Interest{}
+	for _, interest := range fis.catalog {
+		if selected[interest.ID] {
+			interests = append(interests, interest)
+		}
+	}
+	return interests, nil
+}
+
+// SetForUser implements driven.InterestStore.
+func (fis *FakeInterestStore) SetForUser(ctx context.Context, userID int64, interestIDs []int64) error {
+	if val := ctx.Value(ContextType("set_interests_error")); val != nil {
+		return errors.New("error")
+	}
+	fis.interests[userID] = interestIDs
+	return nil
+}
+
+// ListSharingUsers implements driven.InterestStore.
+func (fis *FakeInterestStore) ListSharingUsers(ctx context.Context, userID int64, limit int) ([]*entity.SharedInterests, error) {
+	mine := make(map[int64]bool)
+	for _, id := range fis.interests[userID] {
+		mine[id] = true
+	}
+
+	shared := []*entity.SharedInterests{}
+	for otherID, interestIDs := range fis.interests {
+		if otherID == userID {
+			continue
+		}
+		sharing := &entity.SharedInterests{UserID: otherID}
+		for _, id := range interestIDs {
+			if mine[id] {
+				sharing.InterestIDs = append(sharing.InterestIDs, id)
+			}
+		}
+		if len(sharing.InterestIDs) > 0 {
+			shared = append(shared, sharing)
+		}
+	}
+	sort.Slice(shared, func(i, j int) bool {
+		if len(shared[i].InterestIDs) != len(shared[j].InterestIDs) {
+			return len(shared[i].InterestIDs) > len(shared[j].InterestIDs)
+		}
+		return shared[i].UserID < shared[j].UserID
+	})
+	if len(shared) > limit {
+		shared = shared[:limit]
+	}
+	return shared, nil
+}
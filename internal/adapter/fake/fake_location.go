@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	_ driven.LocationStore = new(FakeLocationStore)
+)
+
+type FakeLocationStore struct {
+	data map[int64]entity.Location
+}
+
+func NewFakeLocationStore() *FakeLocationStore {
+	return &FakeLocationStore{
+		data: make(map[int64]entity.Location),
+	}
+}
+
+// GetByUserID implements driven.LocationStore.
+func (fls *FakeLocationStore) GetByUserID(ctx context.Context, userID int64) (*entity.Location, error) {
+	location, ok := fls.data[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &location, nil
+}
+
+// Save implements driven.LocationStore.
+func (fls *FakeLocationStore) Save(ctx context.Context, location *entity.Location) error {
+	if val := ctx.Value(ContextType("save_location_error")); val != nil {
+		return errors.New("error")
+	}
+	location.UpdatedAt = time.Now()
+	fls.data[location.UserID] = *location
+	return nil
+}
+
+// ListNearby implements driven.LocationStore, it compares the distance to every stored location.
+func (fls *FakeLocationStore) ListNearby(ctx context.Context, center *entity.Location, radiusKM float64, limit int) ([]*entity.Location, error) {
+	locations := []*entity.Location{}
+	for _, location := range fls.data {
+		if location.UserID != center.UserID && center.DistanceKM(location) <= radiusKM {
+			location := location
+			locations = append(locations, &location)
+		}
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return center.DistanceKM(*locations[i]) < center.DistanceKM(*locations[j])
+	})
+	if len(locations) > limit {
+		locations = locations[:limit]
+	}
+	return locations, nil
+}
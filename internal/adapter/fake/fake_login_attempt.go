@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	_ driven.LoginAttemptStore = new(FakeLoginAttemptStore)
+)
+
+type FakeLoginAttemptStore struct {
+	data map[string]*entity.LoginAttempt
+}
+
+func NewFakeLoginAttemptStore() *FakeLoginAttemptStore {
+	return &FakeLoginAttemptStore{
+		data: make(map[string]*entity.LoginAttempt),
+	}
+}
+
+// Get implements driven.LoginAttemptStore.
+func (fls *FakeLoginAttemptStore) Get(ctx context.Context, key string) (*entity.LoginAttempt, error) {
+	if val := ctx.Value(ContextType("login_attempt_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	if attempt, ok := fls.data[key]; ok {
+		copied := *attempt
+		return &copied, nil
+	}
+	return &entity.LoginAttempt{Key: key}, nil
+}
+
+// IncrementFailure implements driven.LoginAttemptStore.
+func (fls *FakeLoginAttemptStore) IncrementFailure(ctx context.Context, key string, window time.Duration) (*entity.LoginAttempt, error) {
+	if val := ctx.Value(ContextType("login_attempt_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	now := time.Now()
+	attempt, ok := fls.data[key]
+	if !ok || (window > 0 && now.Sub(attempt.LastFailedAt) > window) {
+		attempt = &entity.LoginAttempt{Key: key}
+		fls.data[key] = attempt
+	}
+	attempt.FailedCount++
+	attempt.LastFailedAt = now
+	copied := *attempt
+	return &copied, nil
+}
+
+// Reset implements driven.LoginAttemptStore.
+func (fls *FakeLoginAttemptStore) Reset(ctx context.Context, keys ...string) error {
+	if val := ctx.Value(ContextType("login_attempt_error")); val != nil {
+		return errors.New("error")
+	}
+	for _, key := range keys {
+		delete(fls.data, key)
+	}
+	return nil
+}
+
+// Set stores attempt as is so tests can simulate past failures.
+func (fls *FakeLoginAttemptStore) Set(attempt *entity.LoginAttempt) {
+	fls.data[attempt.Key] = attempt
+}
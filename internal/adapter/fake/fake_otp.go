@@ -0,0 +1,116 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	_ driven.OTPProvider = new(FakeOTPProvider)
+	_ driven.OTPStore    = new(FakeOTPStore)
+	_ driven.SMSSender   = new(FakeSMSSender)
+)
+
+// FakeOTPCode is the code generated by FakeOTPProvider.
+const FakeOTPCode = "123456"
+
+type FakeOTPProvider struct{}
+
+// Generate implements driven.OTPProvider.
+func (fop *FakeOTPProvider) Generate(userID int64, purpose entity.OTPPurpose) (*entity.OneTimePassword, error) {
+	return &entity.OneTimePassword{
+		UserID:      userID,
+		Purpose:     purpose,
+		Code:        FakeOTPCode,
+		CodeHash:    fop.Hash(FakeOTPCode),
+		MaxAttempts: 3,
+		ExpiresAt:   time.Now().Add(5 * time.Minute),
+	}, nil
+}
+
+// Hash implements driven.OTPProvider.
+func (*FakeOTPProvider) Hash(code string) string {
+	return "hash-" + code
+}
+
+type FakeOTPStore struct {
+	data []*entity.OneTimePassword
+}
+
+func NewFakeOTPStore() *FakeOTPStore {
+	return &FakeOTPStore{}
+}
+
+// Create implements driven.OTPStore.
+func (fos *FakeOTPStore) Create(ctx context.Context, otp *entity.OneTimePassword) error {
+	if val := ctx.Value(ContextType("otp_error")); val != nil {
+		return errors.New("error")
+	}
+	otp.ID = int64(len(fos.data) + 1)
+	otp.CreatedAt = time.Now()
+	fos.data = append(fos.data, otp)
+	return nil
+}
+
+// GetLatest implements driven.OTPStore.
+func (fos *FakeOTPStore) GetLatest(ctx context.Context, userID int64, purpose entity.OTPPurpose) (*entity.OneTimePassword, error) {
+	if val := ctx.Value(ContextType("otp_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	for i := len(fos.data) - 1; i >= 0; i-- {
+		if fos.data[i].UserID == userID && fos.data[i].Purpose == purpose {
+			return fos.data[i], nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// IncrementAttempts implements driven.OTPStore.
+func (fos *FakeOTPStore) IncrementAttempts(ctx context.Context, id int64) error {
+	if otp := fos.get(id); otp != nil {
+		otp.Attempts++
+	}
+	return nil
+}
+
+// Consume implements driven.OTPStore.
+func (fos *FakeOTPStore) Consume(ctx context.Context, id int64) (bool, error) {
+	otp := fos.get(id)
+	if otp == nil || otp.ConsumedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	otp.ConsumedAt = &now
+	return true, nil
+}
+
+func (fos *FakeOTPStore) get(id int64) *entity.OneTimePassword {
+	for _, otp := range fos.data {
+		if otp.ID == id {
+			return otp
+		}
+	}
+	return nil
+}
+
+type FakeSMS struct {
+	PhoneNumber string
+	Message     string
+}
+
+type FakeSMSSender struct {
+	Sent []FakeSMS
+}
+
+// Send implements driven.SMSSender.
+func (fss *FakeSMSSender) Send(ctx context.Context, phoneNumber, message string) error {
+	if val := ctx.Value(ContextType("sms_error")); val != nil {
+		return errors.New("error")
+	}
+	fss.Sent = append(fss.Sent, FakeSMS{PhoneNumber: phoneNumber, Message: message})
+	return nil
+}
@@ -0,0 +1,49 @@
+package fake
+
+import (
+	"app/internal/user/port/driven"
+	"context"
+	"errors"
+)
+
+var (
+	_ driven.PasswordHistoryStore = new(FakePasswordHistoryStore)
+)
+
+type FakePasswordHistoryStore struct {
+	data map[int64][]string
+}
+
+func NewFakePasswordHistoryStore() *FakePasswordHistoryStore {
+	return &FakePasswordHistoryStore{
+		data: make(map[int64][]string),
+	}
+}
+
+// Add implements driven.PasswordHistoryStore.
+func (fps *FakePasswordHistoryStore) Add(ctx context.Context, userID int64, passwordHash string, keep int) error {
+	if val := ctx.Value(ContextType("password_history_error")); val != nil {
+		return errors.New("error")
+	}
+	hashes := append([]string{passwordHash}, fps.data[userID]...)
+	if keep < 0 {
+		keep = 0
+	}
+	if len(hashes) > keep {
+		hashes = hashes[:keep]
+	}
+	fps.data[userID] = hashes
+	return nil
+}
+
+// ListRecent implements driven.PasswordHistoryStore.
+func (fps *FakePasswordHistoryStore) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	if val := ctx.Value(ContextType("password_history_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	hashes := fps.data[userID]
+	if len(hashes) > limit {
+		hashes = hashes[:limit]
+	}
+	return hashes, nil
+}
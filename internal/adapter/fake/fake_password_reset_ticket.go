@@ -0,0 +1,81 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	_ driven.PasswordResetTicketProvider = new(FakePasswordResetTicketProvider)
+	_ driven.PasswordResetTicketStore    = new(FakePasswordResetTicketStore)
+)
+
+type FakePasswordResetTicketProvider struct {
+	counter int
+}
+
+// Generate implements driven.PasswordResetTicketProvider.
+func (fpp *FakePasswordResetTicketProvider) Generate(userID int64) (*entity.PasswordResetTicket, error) {
+	fpp.counter++
+	ticket := fmt.Sprintf("reset-ticket-%d", fpp.counter)
+	return &entity.PasswordResetTicket{
+		UserID:     userID,
+		Ticket:     ticket,
+		TicketHash: fpp.Hash(ticket),
+		ExpiresAt:  time.Now().Add(10 * time.Minute),
+	}, nil
+}
+
+// Hash implements driven.PasswordResetTicketProvider.
+func (*FakePasswordResetTicketProvider) Hash(ticket string) string {
+	return "hash-" + ticket
+}
+
+type FakePasswordResetTicketStore struct {
+	data map[string]*entity.PasswordResetTicket
+}
+
+func NewFakePasswordResetTicketStore() *FakePasswordResetTicketStore {
+	return &FakePasswordResetTicketStore{
+		data: make(map[string]*entity.PasswordResetTicket),
+	}
+}
+
+// Create implements driven.PasswordResetTicketStore.
+func (fps *FakePasswordResetTicketStore) Create(ctx context.Context, ticket *entity.PasswordResetTicket) error {
+	if val := ctx.Value(ContextType("password_reset_ticket_error")); val != nil {
+		return errors.New("error")
+	}
+	ticket.ID = int64(len(fps.data) + 1)
+	ticket.CreatedAt = time.Now()
+	fps.data[ticket.TicketHash] = ticket
+	return nil
+}
+
+// GetByHash implements driven.PasswordResetTicketStore.
+func (fps *FakePasswordResetTicketStore) GetByHash(ctx context.Context, ticketHash string) (*entity.PasswordResetTicket, error) {
+	if ticket, ok := fps.data[ticketHash]; ok {
+		return ticket, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// MarkUsed implements driven.PasswordResetTicketStore.
+func (fps *FakePasswordResetTicketStore) MarkUsed(ctx context.Context, id int64) (bool, error) {
+	for _, ticket := range fps.data {
+		if ticket.ID == id {
+			if ticket.UsedAt != nil {
+				return false, nil
+			}
+			now := time.Now()
+			ticket.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,113 @@
+package fake
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	_ driven.PhotoStore     = new(FakePhotoStore)
+	_ driven.BlobStorage    = new(FakeFileStorage)
+	_ driven.ImageProcessor = new(FakeImageProcessor)
+)
+
+type FakePhotoStore struct {
+	data map[string]entity.Photo
+}
+
+func NewFakePhotoStore() *FakePhotoStore {
+	return &FakePhotoStore{
+		data: make(map[string]entity.Photo),
+	}
+}
+
+// ListByUserID implements driven.PhotoStore.
+func (fps *FakePhotoStore) ListByUserID(ctx context.Context, userID int64) ([]*entity.Photo, error) {
+	if val := ctx.Value(ContextType("photo_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	photos := []*entity.Photo{}
+	for _, photo := range fps.data {
+		if photo.UserID == userID {
+			photo := photo
+			photos = append(photos, &photo)
+		}
+	}
+	sort.Slice(photos, func(i, j int) bool {
+		return photos[i].Position < photos[j].Position
+	})
+	return photos, nil
+}
+
+// GetByID implements driven.PhotoStore.
+func (fps *FakePhotoStore) GetByID(ctx context.Context, id string) (*entity.Photo, error) {
+	photo, ok := fps.data[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &photo, nil
+}
+
+// Create implements driven.PhotoStore.
+func (fps *FakePhotoStore) Create(ctx context.Context, photo *entity.Photo) error {
+	if val := ctx.Value(ContextType("create_photo_error")); val != nil {
+		return errors.New("error")
+	}
+	photo.CreatedAt = time.Now()
+	fps.data[photo.ID] = *photo
+	return nil
+}
+
+// Delete implements driven.PhotoStore.
+func (fps *FakePhotoStore) Delete(ctx context.Context, userID int64, id string) error {
+	deleted, ok := fps.data[id]
+	if !ok || deleted.UserID != userID {
+		return sql.ErrNoRows
+	}
+	delete(fps.data, id)
+
+	for id, photo := range fps.data {
+		if photo.UserID == userID && photo.Position > deleted.Position {
+			photo.Position--
+			fps.data[id] = photo
+		}
+	}
+	return nil
+}
+
+// Reorder implements driven.PhotoStore.
+func (fps *FakePhotoStore) Reorder(ctx context.Context, userID int64, ids []string) error {
+	for position, id := range ids {
+		if photo, ok := fps.data[id]; ok && photo.UserID == userID {
+			photo.Position = position
+			fps.data[id] = photo
+		}
+	}
+	return nil
+}
+
+// FakeImageProcessor returns the content unchanged for every variant, content starting with
+// "invalid" is treated as an image that cannot be decoded.
+type FakeImageProcessor struct{}
+
+func NewFakeImageProcessor() *FakeImageProcessor {
+	return &FakeImageProcessor{}
+}
+
+// Resize implements driven.ImageProcessor.
+func (fip *FakeImageProcessor) Resize(content []byte, variants []entity.PhotoVariant) (map[string][]byte, error) {
+	if bytes.HasPrefix(content, []byte("invalid")) {
+		return nil, entity.ErrUnsupportedImage
+	}
+	result := make(map[string][]byte, len(variants))
+	for _, variant := range variants {
+		result[variant.Name] = content
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	_ driven.PreferencesStore = new(FakePreferencesStore)
+)
+
+type FakePreferencesStore struct {
+	data map[int64]entity.Preferences
+}
+
+func NewFakePreferencesStore() *FakePreferencesStore {
+	return &FakePreferencesStore{
+		data: make(map[int64]entity.Preferences),
+	}
+}
+
+// GetByUserID implements driven.PreferencesStore.
+func (fps *FakePreferencesStore) GetByUserID(ctx context.Context, userID int64) (*entity.Preferences, error) {
+	if val := ctx.Value(ContextType("preferences_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	preferences, ok := fps.data[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &preferences, nil
+}
+
+// Save implements driven.PreferencesStore.
+func (fps *FakePreferencesStore) Save(ctx context.Context, preferences *entity.Preferences) error {
+	if val := ctx.Value(ContextType("save_preferences_error")); val != nil {
+		return errors.New("error")
+	}
+	now := time.Now()
+	if preferences.CreatedAt.IsZero() {
+		preferences.CreatedAt = now
+	}
+	preferences.UpdatedAt = now
+	fps.data[preferences.UserID] = *preferences
+	return nil
+}
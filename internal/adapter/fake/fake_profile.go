@@ -0,0 +1,50 @@
+package fake
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	_ driven.ProfileStore = new(FakeProfileStore)
+)
+
+type FakeProfileStore struct {
+	data map[int64]entity.Profile
+}
+
+func NewFakeProfileStore() *FakeProfileStore {
+	return &FakeProfileStore{
+		data: make(map[int64]entity.Profile),
+	}
+}
+
+// GetByUserID implements driven.ProfileStore.
+func (fps *FakeProfileStore) GetByUserID(ctx context.Context, userID int64) (*entity.Profile, error) {
+	if val := ctx.Value(ContextType("profile_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	profile, ok := fps.data[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &profile, nil
+}
+
+// Save implements driven.ProfileStore.
+func (fps *FakeProfileStore) Save(ctx context.Context, profile *entity.Profile) error {
+	if val := ctx.Value(ContextType("save_profile_error")); val != nil {
+		return errors.New("error")
+	}
+	now := time.Now()
+	if profile.CreatedAt.IsZero() {
+		profile.CreatedAt = now
+	}
+	profile.UpdatedAt = now
+	fps.data[profile.UserID] = *profile
+	return nil
+}
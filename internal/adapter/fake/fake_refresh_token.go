@@ -0,0 +1,106 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	_ driven.RefreshTokenProvider = new(FakeRefreshTokenProvider)
+	_ driven.RefreshTokenStore    = new(FakeRefreshTokenStore)
+)
+
+type FakeRefreshTokenProvider struct {
+	counter int
+}
+
+// Generate implements driven.RefreshTokenProvider.
+func (frp *FakeRefreshTokenProvider) Generate(userID int64, familyID string) (*entity.RefreshToken, error) {
+	frp.counter++
+	if familyID == "" {
+		familyID = fmt.Sprintf("family-%d", frp.counter)
+	}
+	token := fmt.Sprintf("refresh-token-%d", frp.counter)
+	return &entity.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		Token:     token,
+		TokenHash: frp.Hash(token),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil
+}
+
+// Hash implements driven.RefreshTokenProvider.
+func (*FakeRefreshTokenProvider) Hash(token string) string {
+	return "hash-" + token
+}
+
+type FakeRefreshTokenStore struct {
+	data map[string]*entity.RefreshToken
+}
+
+func NewFakeRefreshTokenStore() *FakeRefreshTokenStore {
+	return &FakeRefreshTokenStore{
+		data: make(map[string]*entity.RefreshToken),
+	}
+}
+
+// Create implements driven.RefreshTokenStore.
+func (frs *FakeRefreshTokenStore) Create(ctx context.Context, token *entity.RefreshToken) error {
+	if val := ctx.Value(ContextType("refresh_token_error")); val != nil {
+		return errors.New("error")
+	}
+	token.ID = int64(len(frs.data) + 1)
+	token.CreatedAt = time.Now()
+	frs.data[token.TokenHash] = token
+	return nil
+}
+
+// GetByHash implements driven.RefreshTokenStore.
+func (frs *FakeRefreshTokenStore) GetByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	if token, ok := frs.data[tokenHash]; ok {
+		return token, nil
+	}
+	return nil, errors.New("resource not found")
+}
+
+// MarkUsed implements driven.RefreshTokenStore.
+func (frs *FakeRefreshTokenStore) MarkUsed(ctx context.Context, id int64) (bool, error) {
+	for _, token := range frs.data {
+		if token.ID == id {
+			if token.IsUsed() {
+				return false, nil
+			}
+			now := time.Now()
+			token.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, errors.New("resource not found")
+}
+
+// RevokeFamily implements driven.RefreshTokenStore.
+func (frs *FakeRefreshTokenStore) RevokeFamily(ctx context.Context, familyID string) error {
+	now := time.Now()
+	for _, token := range frs.data {
+		if token.FamilyID == familyID && !token.IsRevoked() {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// RevokeByUserID implements driven.RefreshTokenStore.
+func (frs *FakeRefreshTokenStore) RevokeByUserID(ctx context.Context, userID int64) error {
+	now := time.Now()
+	for _, token := range frs.data {
+		if token.UserID == userID && !token.IsRevoked() {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
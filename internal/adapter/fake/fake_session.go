@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	_ driven.SessionStore = new(FakeSessionStore)
+)
+
+type FakeSessionStore struct {
+	data map[string]*entity.Session
+}
+
+func NewFakeSessionStore() *FakeSessionStore {
+	return &FakeSessionStore{
+		data: make(map[string]*entity.Session),
+	}
+}
+
+// Create implements driven.SessionStore.
+func (fss *FakeSessionStore) Create(ctx context.Context, session *entity.Session) error {
+	if val := ctx.Value(ContextType("session_error")); val != nil {
+		return errors.New("error")
+	}
+	session.LastSeenAt = time.Now()
+	session.CreatedAt = session.LastSeenAt
+	fss.data[session.ID] = session
+	return nil
+}
+
+// GetByID implements driven.SessionStore.
+func (fss *FakeSessionStore) GetByID(ctx context.Context, id string) (*entity.Session, error) {
+	if session, ok := fss.data[id]; ok {
+		copied := *session
+		return &copied, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// ListActiveByUserID implements driven.SessionStore.
+func (fss *FakeSessionStore) ListActiveByUserID(ctx context.Context, userID int64) ([]*entity.Session, error) {
+	if val := ctx.Value(ContextType("session_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	now := time.Now()
+	sessions := make([]*entity.Session, 0)
+	for _, session := range fss.data {
+		if session.UserID == userID && session.IsActive(now) {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
+// Touch implements driven.SessionStore.
+func (fss *FakeSessionStore) Touch(ctx context.Context, session *entity.Session) error {
+	if stored, ok := fss.data[session.ID]; ok {
+		stored.UserAgent = session.UserAgent
+		stored.IPAddress = session.IPAddress
+		stored.ExpiresAt = session.ExpiresAt
+		stored.LastSeenAt = time.Now()
+	}
+	return nil
+}
+
+// Revoke implements driven.SessionStore.
+func (fss *FakeSessionStore) Revoke(ctx context.Context, id string) error {
+	if session, ok := fss.data[id]; ok && !session.IsRevoked() {
+		now := time.Now()
+		session.RevokedAt = &now
+	}
+	return nil
+}
+
+// RevokeByUserID implements driven.SessionStore.
+func (fss *FakeSessionStore) RevokeByUserID(ctx context.Context, userID int64) error {
+	now := time.Now()
+	for _, session := range fss.data {
+		if session.UserID == userID && !session.IsRevoked() {
+			session.RevokedAt = &now
+		}
+	}
+	return nil
+}
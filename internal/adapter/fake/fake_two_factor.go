@@ -0,0 +1,128 @@
+package fake
+
+import (
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	_ driven.TwoFactorProvider = new(FakeTwoFactorProvider)
+	_ driven.RecoveryCodeStore = new(FakeRecoveryCodeStore)
+	_ driven.MFAChallengeStore = new(FakeMFAChallengeStore)
+)
+
+// FakeTOTPCode is the only code accepted by FakeTwoFactorProvider.
+const FakeTOTPCode = "654321"
+
+type FakeTwoFactorProvider struct{}
+
+// GenerateSecret implements driven.TwoFactorProvider.
+func (*FakeTwoFactorProvider) GenerateSecret() (string, error) {
+	return "JBSWY3DPEHPK3PXP", nil
+}
+
+// URI implements driven.TwoFactorProvider.
+func (*FakeTwoFactorProvider) URI(secret, accountName string) string {
+	return fmt.Sprintf("otpauth://totp/fake:%s?secret=%s", accountName, secret)
+}
+
+// Validate implements driven.TwoFactorProvider.
+func (*FakeTwoFactorProvider) Validate(secret, code string, _ time.Time) bool {
+	return secret != "" && code == FakeTOTPCode
+}
+
+// GenerateRecoveryCodes implements driven.TwoFactorProvider.
+func (*FakeTwoFactorProvider) GenerateRecoveryCodes() ([]string, error) {
+	return []string{"recovery-1", "recovery-2", "recovery-3"}, nil
+}
+
+// HashRecoveryCode implements driven.TwoFactorProvider.
+func (*FakeTwoFactorProvider) HashRecoveryCode(code string) string {
+	return "hash-" + code
+}
+
+type FakeRecoveryCodeStore struct {
+	data map[int64]map[string]bool
+}
+
+func NewFakeRecoveryCodeStore() *FakeRecoveryCodeStore {
+	return &FakeRecoveryCodeStore{
+		data: make(map[int64]map[string]bool),
+	}
+}
+
+// Replace implements driven.RecoveryCodeStore.
+func (frs *FakeRecoveryCodeStore) Replace(ctx context.Context, userID int64, codeHashes []string) error {
+	if val := ctx.Value(ContextType("recovery_code_error")); val != nil {
+		return errors.New("error")
+	}
+	codes := make(map[string]bool, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		codes[codeHash] = false
+	}
+	frs.data[userID] = codes
+	return nil
+}
+
+// Consume implements driven.RecoveryCodeStore.
+func (frs *FakeRecoveryCodeStore) Consume(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	used, ok := frs.data[userID][codeHash]
+	if !ok || used {
+		return false, nil
+	}
+	frs.data[userID][codeHash] = true
+	return true, nil
+}
+
+type FakeMFAChallengeStore struct {
+	data map[string]*entity.MFAChallenge
+}
+
+func NewFakeMFAChallengeStore() *FakeMFAChallengeStore {
+	return &FakeMFAChallengeStore{
+		data: make(map[string]*entity.MFAChallenge),
+	}
+}
+
+// Create implements driven.MFAChallengeStore.
+func (fms *FakeMFAChallengeStore) Create(ctx context.Context, challenge *entity.MFAChallenge) error {
+	if val := ctx.Value(ContextType("mfa_challenge_error")); val != nil {
+		return errors.New("error")
+	}
+	challenge.ID = fmt.Sprintf("challenge-%d", len(fms.data)+1)
+	challenge.CreatedAt = time.Now()
+	fms.data[challenge.ID] = challenge
+	return nil
+}
+
+// GetByID implements driven.MFAChallengeStore.
+func (fms *FakeMFAChallengeStore) GetByID(ctx context.Context, id string) (*entity.MFAChallenge, error) {
+	if challenge, ok := fms.data[id]; ok {
+		return challenge, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// IncrementAttempts implements driven.MFAChallengeStore.
+func (fms *FakeMFAChallengeStore) IncrementAttempts(ctx context.Context, id string) error {
+	if challenge, ok := fms.data[id]; ok {
+		challenge.Attempts++
+	}
+	return nil
+}
+
+// Consume implements driven.MFAChallengeStore.
+func (fms *FakeMFAChallengeStore) Consume(ctx context.Context, id string) (bool, error) {
+	challenge, ok := fms.data[id]
+	if !ok || challenge.ConsumedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	challenge.ConsumedAt = &now
+	return true, nil
+}
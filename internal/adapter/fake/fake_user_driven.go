@@ -4,8 +4,10 @@ import (
 	"app/internal/user/entity"
 	"app/internal/user/port/driven"
 	"context"
+	"database/sql"
 	"errors"
 	"math/rand"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 )
@@ -13,47 +15,237 @@ import (
 var (
 	_ driven.UserWriter = new(FakeUserDriven)
 	_ driven.UserGetter = new(FakeUserDriven)
+	_ driven.UserPurger = new(FakeUserDriven)
 )
 
 type ContextType string
 
 type FakeUserDriven struct {
-	data           map[int64]*entity.User
-	dataByUsername map[string]*entity.User
+	data              map[int64]*entity.User
+	dataByUsername    map[string]*entity.User
+	dataByPhoneNumber map[string]*entity.User
+	loginInformation  map[int64]*entity.LoginInformation
+	preferences       map[int64]*entity.Preferences
 }
 
 func NewFakeUserDriven() *FakeUserDriven {
 	return &FakeUserDriven{
-		data:           make(map[int64]*entity.User),
-		dataByUsername: make(map[string]*entity.User),
+		data:              make(map[int64]*entity.User),
+		dataByUsername:    make(map[string]*entity.User),
+		dataByPhoneNumber: make(map[string]*entity.User),
+		loginInformation:  make(map[int64]*entity.LoginInformation),
+		preferences:       make(map[int64]*entity.Preferences),
 	}
 }
 
-func (fud *FakeUserDriven) Create(ctx context.Context, user *entity.User) (id int64, err error) {
+func (fud *FakeUserDriven) Create(ctx context.Context, user *entity.User, preferences *entity.Preferences) (id int64, err error) {
+	if val := ctx.Value(ContextType("create_user_error")); val != nil {
+		return 0, errors.New("error")
+	}
 	user.ID = faker.NewSafeSource(rand.NewSource(1000)).Int63()
 	fud.data[user.ID] = user
 	fud.dataByUsername[user.Username] = user
+	if user.PhoneNumber != "" {
+		fud.dataByPhoneNumber[user.PhoneNumber] = user
+	}
+	if preferences != nil {
+		preferences.UserID = user.ID
+		fud.preferences[user.ID] = preferences
+	}
 	return user.ID, nil
 }
 
+// PreferencesByUserID returns the preferences stored by Create along with the user of userID.
+func (fud *FakeUserDriven) PreferencesByUserID(userID int64) (*entity.Preferences, bool) {
+	preferences, ok := fud.preferences[userID]
+	return preferences, ok
+}
+
 func (fud FakeUserDriven) GetByID(ctx context.Context, id int64) (*entity.User, error) {
-	if user, ok := fud.data[id]; ok {
+	if user, ok := fud.data[id]; ok && !user.IsDeleted() {
 		return user, nil
 	}
-	return nil, errors.New("resource not found")
+	return nil, sql.ErrNoRows
 }
 
-func (*FakeUserDriven) UpdateLoginInformation(ctx context.Context, user *entity.User) error {
+func (fud *FakeUserDriven) UpdateLoginInformation(ctx context.Context, user *entity.User) error {
 	if val := ctx.Value(ContextType("token_error")); val != nil {
 		return errors.New("error")
 	}
+	now := time.Now()
+	loginInformation, ok := fud.loginInformation[user.ID]
+	if !ok {
+		loginInformation = new(entity.LoginInformation)
+		fud.loginInformation[user.ID] = loginInformation
+	}
+	loginInformation.SuccessLoginCount++
+	loginInformation.LastLoginAt = &now
+	return nil
+}
+
+// GetLoginInformation implements driven.UserGetter.
+func (fud *FakeUserDriven) GetLoginInformation(ctx context.Context, userID int64) (*entity.LoginInformation, error) {
+	if loginInformation, ok := fud.loginInformation[userID]; ok {
+		return loginInformation, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// UpdatePassword implements driven.UserWriter.
+func (fud *FakeUserDriven) UpdatePassword(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("update_password_error")); val != nil {
+		return errors.New("error")
+	}
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.Password = user.Password
+	}
+	return nil
+}
+
+// MarkPhoneVerified implements driven.UserWriter.
+func (fud *FakeUserDriven) MarkPhoneVerified(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("mark_phone_verified_error")); val != nil {
+		return errors.New("error")
+	}
+	now := time.Now()
+	user.PhoneVerifiedAt = &now
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.PhoneVerifiedAt = &now
+	}
+	return nil
+}
+
+// UpdateTOTP implements driven.UserWriter.
+func (fud *FakeUserDriven) UpdateTOTP(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("update_totp_error")); val != nil {
+		return errors.New("error")
+	}
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.TOTPSecret = user.TOTPSecret
+		stored.TOTPEnabledAt = user.TOTPEnabledAt
+	}
+	return nil
+}
+
+// UpdateRole implements driven.UserWriter.
+func (fud *FakeUserDriven) UpdateRole(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("update_role_error")); val != nil {
+		return errors.New("error")
+	}
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.Role = user.Role
+	}
+	return nil
+}
+
+// UpdateIdentity implements driven.UserWriter.
+func (fud *FakeUserDriven) UpdateIdentity(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("update_identity_error")); val != nil {
+		return errors.New("error")
+	}
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.Gender = user.Gender
+		stored.ShowGender = user.ShowGender
+		stored.Orientation = user.Orientation
+	}
 	return nil
 }
 
 // GetByUsername implements driven.UserGetter.
 func (fud *FakeUserDriven) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
-	if user, ok := fud.dataByUsername[username]; ok {
+	if user, ok := fud.dataByUsername[username]; ok && !user.IsDeleted() {
+		return user, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// GetByPhoneNumber implements driven.UserGetter.
+func (fud *FakeUserDriven) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
+	if user, ok := fud.dataByPhoneNumber[phoneNumber]; ok && !user.IsDeleted() {
 		return user, nil
 	}
-	return nil, errors.New("resource not found")
+	return nil, sql.ErrNoRows
+}
+
+// GetDeletedByID implements driven.UserGetter.
+func (fud *FakeUserDriven) GetDeletedByID(ctx context.Context, id int64) (*entity.User, error) {
+	if user, ok := fud.data[id]; ok && user.IsDeleted() {
+		return user, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// GetDeletedByUsername implements driven.UserGetter.
+func (fud *FakeUserDriven) GetDeletedByUsername(ctx context.Context, username string) (*entity.User, error) {
+	if user, ok := fud.dataByUsername[username]; ok && user.IsDeleted() {
+		return user, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// GetDeletedByPhoneNumber implements driven.UserGetter.
+func (fud *FakeUserDriven) GetDeletedByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
+	if user, ok := fud.dataByPhoneNumber[phoneNumber]; ok && user.IsDeleted() {
+		return user, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// SoftDelete implements driven.UserWriter.
+func (fud *FakeUserDriven) SoftDelete(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("soft_delete_error")); val != nil {
+		return errors.New("error")
+	}
+	now := time.Now()
+	user.DeletedAt = &now
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.DeletedAt = &now
+	}
+	return nil
+}
+
+// Restore implements driven.UserWriter.
+func (fud *FakeUserDriven) Restore(ctx context.Context, user *entity.User) error {
+	if val := ctx.Value(ContextType("restore_error")); val != nil {
+		return errors.New("error")
+	}
+	if stored, ok := fud.data[user.ID]; ok {
+		stored.DeletedAt = nil
+	}
+	return nil
+}
+
+// ListPurgeable implements driven.UserPurger.
+func (fud *FakeUserDriven) ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	if val := ctx.Value(ContextType("list_purgeable_error")); val != nil {
+		return nil, errors.New("error")
+	}
+	var userIDs []int64
+	for id, user := range fud.data {
+		if len(userIDs) >= limit {
+			break
+		}
+		if user.IsDeleted() && user.DeletedAt.Before(deletedBefore) {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, nil
+}
+
+// Purge implements driven.UserPurger.
+func (fud *FakeUserDriven) Purge(ctx context.Context, userIDs []int64, deletedBefore time.Time) (int64, error) {
+	if val := ctx.Value(ContextType("purge_error")); val != nil {
+		return 0, errors.New("error")
+	}
+	var purged int64
+	for _, id := range userIDs {
+		user, ok := fud.data[id]
+		if ok && user.IsDeleted() && user.DeletedAt.Before(deletedBefore) {
+			delete(fud.data, id)
+			delete(fud.dataByUsername, user.Username)
+			delete(fud.dataByPhoneNumber, user.PhoneNumber)
+			purged++
+		}
+	}
+	return purged, nil
 }
@@ -7,13 +7,16 @@ import (
 	"errors"
 )
 
-var _ driven.TokenProvider[*entity.User] = new(FakeTokenProvider)
+var (
+	_ driven.TokenProvider[*entity.AccessTokenSubject] = new(FakeTokenProvider)
+	_ driven.TokenValidator[*entity.UserClaims]        = new(FakeTokenProvider)
+)
 
 type FakeTokenProvider struct{}
 
 // Generate implements driven.TokenProvider.
-func (*FakeTokenProvider) Generate(user *entity.User) (*response.Token, error) {
-	if user.Username == "wrongUsername" {
+func (*FakeTokenProvider) Generate(subject *entity.AccessTokenSubject) (*response.Token, error) {
+	if subject.User.Username == "wrongUsername" {
 		return nil, errors.New("invalid")
 	}
 	return &response.Token{
@@ -23,6 +26,13 @@ func (*FakeTokenProvider) Generate(user *entity.User) (*response.Token, error) {
 	}, nil
 }
 
-func (*FakeTokenProvider) ValidateToken(tokenString string) (map[string]interface{}, error) {
-	return nil, nil
+// Validate implements driven.TokenValidator.
+func (*FakeTokenProvider) Validate(tokenString string) (*entity.UserClaims, error) {
+	if tokenString != "1231313213213131" {
+		return nil, errors.New("invalid")
+	}
+	return &entity.UserClaims{
+		UserID:  1,
+		TokenID: "fake-token-id",
+	}, nil
 }
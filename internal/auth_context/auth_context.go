@@ -0,0 +1,28 @@
+package authcontext
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type claimsKey struct{}
+
+// WithClaims returns a copy of ctx carrying the authenticated caller.
+func WithClaims(ctx context.Context, claims *entity.UserClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the authenticated caller stored by the authentication middleware.
+func ClaimsFromContext(ctx context.Context) (*entity.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*entity.UserClaims)
+	return claims, ok && claims != nil
+}
+
+// UserIDFromContext returns the ID of the authenticated caller.
+func UserIDFromContext(ctx context.Context) (int64, bool) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return claims.UserID, true
+}
@@ -0,0 +1,28 @@
+package customerror
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+type AccountLockedError struct {
+	retryAfter time.Duration
+}
+
+func NewAccountLockedError(retryAfter time.Duration) *AccountLockedError {
+	return &AccountLockedError{retryAfter: retryAfter}
+}
+
+func (ale AccountLockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %d seconds", ale.RetryAfterSeconds())
+}
+
+func (ale AccountLockedError) RetryAfter() time.Duration {
+	return ale.retryAfter
+}
+
+// RetryAfterSeconds rounds up so clients never retry too early.
+func (ale AccountLockedError) RetryAfterSeconds() int {
+	return int(math.Ceil(ale.retryAfter.Seconds()))
+}
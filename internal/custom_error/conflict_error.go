@@ -0,0 +1,13 @@
+package customerror
+
+type ConflictError struct {
+	message string
+}
+
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{message: message}
+}
+
+func (ce ConflictError) Error() string {
+	return ce.message
+}
@@ -0,0 +1,13 @@
+package customerror
+
+type ForbiddenError struct {
+	message string
+}
+
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{message: message}
+}
+
+func (fe ForbiddenError) Error() string {
+	return fe.message
+}
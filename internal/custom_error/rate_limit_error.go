@@ -0,0 +1,28 @@
+package customerror
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+type RateLimitError struct {
+	retryAfter time.Duration
+}
+
+func NewRateLimitError(retryAfter time.Duration) *RateLimitError {
+	return &RateLimitError{retryAfter: retryAfter}
+}
+
+func (rle RateLimitError) Error() string {
+	return fmt.Sprintf("too many requests, retry in %d seconds", rle.RetryAfterSeconds())
+}
+
+// RetryAfterSeconds rounds up and never returns zero, a client retrying right away would be refused again.
+func (rle RateLimitError) RetryAfterSeconds() int {
+	seconds := int(math.Ceil(rle.retryAfter.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
@@ -0,0 +1,13 @@
+package customerror
+
+type UnauthorizedError struct {
+	message string
+}
+
+func NewUnauthorizedError(message string) *UnauthorizedError {
+	return &UnauthorizedError{message: message}
+}
+
+func (ue UnauthorizedError) Error() string {
+	return ue.message
+}
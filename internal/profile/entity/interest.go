@@ -0,0 +1,71 @@
+package entity
+
+import (
+	customerror "app/internal/custom_error"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Interest is an entry of the curated catalog users pick their interests from, the catalog is
+// only changed through migrations.
+type Interest struct {
+	ID       int64
+	Slug     string
+	Name     string
+	Category string
+}
+
+// InterestPolicy holds the configurable limits of user interests.
+type InterestPolicy struct {
+	MaxInterests int
+}
+
+// SharedInterests is another user having some of the interests of a user in common.
+type SharedInterests struct {
+	UserID      int64
+	InterestIDs []int64
+}
+
+// ValidateInterestSelection checks that ids only refers to interests of catalog and stays within the policy,
+// it returns the ids without duplicates in their original order.
+func (policy InterestPolicy) ValidateInterestSelection(ids []int64, catalog []*Interest) ([]int64, error) {
+	known := make(map[int64]bool, len(catalog))
+	for _, interest := range catalog {
+		known[interest.ID] = true
+	}
+
+	selection := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	var unknown []int64
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if !known[id] {
+			unknown = append(unknown, id)
+			continue
+		}
+		selection = append(selection, id)
+	}
+
+	validationError := customerror.NewValidationError()
+	if len(unknown) > 0 {
+		sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+		names := make([]string, 0, len(unknown))
+		for _, id := range unknown {
+			names = append(names, strconv.FormatInt(id, 10))
+		}
+		// joined without commas, the error formatter splits reasons on them
+		validationError.AddError("interest_ids", "unknown interests "+strings.Join(names, " and "))
+	}
+	if len(seen) > policy.MaxInterests {
+		validationError.AddError("interest_ids", fmt.Sprintf("can only have up to %d interests", policy.MaxInterests))
+	}
+	if validationError.HasError() {
+		return nil, validationError
+	}
+	return selection, nil
+}
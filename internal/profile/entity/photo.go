@@ -0,0 +1,123 @@
+package entity
+
+import (
+	customerror "app/internal/custom_error"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnsupportedImage is returned by the image processor when an upload cannot be decoded as an image.
+var ErrUnsupportedImage = errors.New("unsupported image")
+
+// PhotoContentTypes are the sniffed content types accepted for uploads.
+var PhotoContentTypes = []string{"image/jpeg", "image/png"}
+
+// PhotoVariant is a size every uploaded photo is stored in, scaled down to fit MaxSize pixels on its longest side.
+type PhotoVariant struct {
+	Name    string
+	MaxSize int
+}
+
+var (
+	PhotoVariantLarge     = PhotoVariant{Name: "large", MaxSize: 1600}
+	PhotoVariantMedium    = PhotoVariant{Name: "medium", MaxSize: 800}
+	PhotoVariantThumbnail = PhotoVariant{Name: "thumbnail", MaxSize: 200}
+
+	PhotoVariants = []PhotoVariant{PhotoVariantLarge, PhotoVariantMedium, PhotoVariantThumbnail}
+)
+
+func PhotoVariantFromName(name string) (PhotoVariant, bool) {
+	for _, variant := range PhotoVariants {
+		if variant.Name == name {
+			return variant, true
+		}
+	}
+	return PhotoVariant{}, false
+}
+
+// PhotoPolicy holds the configurable limits of profile photos.
+type PhotoPolicy struct {
+	MaxPhotos      int
+	MaxUploadBytes int64
+}
+
+// Photo is one of the ordered profile photos of a user, the first one is the primary photo.
+type Photo struct {
+	ID        string
+	UserID    int64
+	Position  int
+	CreatedAt time.Time
+}
+
+// NewPhoto returns a photo placed after the existing ones of userID, it fails once the user has as many
+// photos as the policy allows.
+func NewPhoto(userID int64, existing []*Photo, policy *PhotoPolicy) (*Photo, error) {
+	if len(existing) >= policy.MaxPhotos {
+		return nil, customerror.NewValidationErrorWithMessage("photo", fmt.Sprintf("can only have up to %d photos", policy.MaxPhotos))
+	}
+	return &Photo{
+		ID:       uuid.NewString(),
+		UserID:   userID,
+		Position: len(existing),
+	}, nil
+}
+
+func (photo Photo) IsPrimary() bool {
+	return photo.Position == 0
+}
+
+// FileKey is where the variant of the photo is stored.
+func (photo Photo) FileKey(variant PhotoVariant) string {
+	return fmt.Sprintf("%s%s/%s.jpg", PhotoKeyPrefix(photo.UserID), photo.ID, variant.Name)
+}
+
+// PhotoKeyPrefix is the common prefix of the file keys of every photo of userID.
+func PhotoKeyPrefix(userID int64) string {
+	return fmt.Sprintf("photos/%d/", userID)
+}
+
+// ValidateUpload checks the sniffed content type and the size of an upload before it is decoded.
+func (policy PhotoPolicy) ValidateUpload(contentType string, size int64) error {
+	validationError := customerror.NewValidationError()
+	if size == 0 {
+		validationError.AddError("photo", "is required")
+	}
+	if size > policy.MaxUploadBytes {
+		validationError.AddError("photo", fmt.Sprintf("must be at most %d bytes", policy.MaxUploadBytes))
+	}
+
+	supported := false
+	for _, photoContentType := range PhotoContentTypes {
+		supported = supported || photoContentType == contentType
+	}
+	if size > 0 && !supported {
+		validationError.AddError("photo", "must be a JPEG or PNG image")
+	}
+
+	if validationError.HasError() {
+		return validationError
+	}
+	return nil
+}
+
+// ValidatePhotoOrder checks that ids lists every photo of photos exactly once.
+func ValidatePhotoOrder(photos []*Photo, ids []string) error {
+	remaining := make(map[string]bool, len(photos))
+	for _, photo := range photos {
+		remaining[photo.ID] = true
+	}
+
+	for _, id := range ids {
+		if !remaining[id] {
+			return customerror.NewValidationErrorWithMessage("photo_ids", "must list every photo exactly once")
+		}
+		delete(remaining, id)
+	}
+	if len(remaining) > 0 {
+		return customerror.NewValidationErrorWithMessage("photo_ids", "must list every photo exactly once")
+	}
+	return nil
+}
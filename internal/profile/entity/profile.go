@@ -0,0 +1,140 @@
+package entity
+
+import (
+	customerror "app/internal/custom_error"
+	"app/internal/profile/param/request"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// BirthdateLayout is the format birthdates are sent and returned in.
+	BirthdateLayout = "2006-01-02"
+
+	MinimumAge = 18
+	maximumAge = 120
+
+	bioMaxLen       = 500
+	jobMaxLen       = 100
+	educationMaxLen = 100
+
+	heightMinCM = 100
+	heightMaxCM = 250
+)
+
+// Profile is the dating profile of a user, it only exists once the user filled in a birthdate.
+type Profile struct {
+	UserID    int64
+	Birthdate time.Time
+	Bio       string
+	Job       string
+	Education string
+	// HeightCM is the height in centimeters, zero when not filled in.
+	HeightCM  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewProfile returns the empty profile of userID, it is not valid until Update sets a birthdate.
+func NewProfile(userID int64) *Profile {
+	return &Profile{UserID: userID}
+}
+
+// Update applies the fields of param that are set and validates the whole profile against now,
+// the profile is left unchanged when it is not valid.
+func (profile *Profile) Update(param *request.UpdateProfile, now time.Time) error {
+	updated := *profile
+	validationError := customerror.NewValidationError()
+
+	if param.Birthdate != nil {
+		birthdate, err := time.Parse(BirthdateLayout, *param.Birthdate)
+		if err != nil {
+			validationError.AddError("birthdate", "must be a date in YYYY-MM-DD format")
+		}
+		updated.Birthdate = birthdate
+	}
+	if param.Bio != nil {
+		updated.Bio = *param.Bio
+	}
+	if param.Job != nil {
+		updated.Job = *param.Job
+	}
+	if param.Education != nil {
+		updated.Education = *param.Education
+	}
+	if param.HeightCM != nil {
+		updated.HeightCM = *param.HeightCM
+	}
+
+	if !validationError.HasError() {
+		validationError.Merge(updated.validateBirthdate(now))
+	}
+	validationError.Merge(updated.validateTexts())
+	validationError.Merge(updated.validateHeight())
+	if validationError.HasError() {
+		return validationError
+	}
+
+	*profile = updated
+	return nil
+}
+
+// Age is the age in full years at now.
+func (profile Profile) Age(now time.Time) int {
+	return AgeAt(profile.Birthdate, now)
+}
+
+// AgeAt is the age in full years at now of someone born on birthdate.
+func AgeAt(birthdate, now time.Time) int {
+	age := now.Year() - birthdate.Year()
+	if now.Month() < birthdate.Month() || now.Month() == birthdate.Month() && now.Day() < birthdate.Day() {
+		age--
+	}
+	return age
+}
+
+func (profile Profile) validateBirthdate(now time.Time) error {
+	if profile.Birthdate.IsZero() {
+		return customerror.NewValidationErrorWithMessage("birthdate", "is required")
+	}
+
+	age := profile.Age(now)
+	if age < MinimumAge {
+		return customerror.NewValidationErrorWithMessage("birthdate", fmt.Sprintf("must be at least %d years old", MinimumAge))
+	}
+	if age > maximumAge {
+		return customerror.NewValidationErrorWithMessage("birthdate", fmt.Sprintf("must be at most %d years old", maximumAge))
+	}
+	return nil
+}
+
+func (profile Profile) validateTexts() error {
+	validationError := customerror.NewValidationError()
+	fields := []struct {
+		name   string
+		value  string
+		maxLen int
+	}{
+		{"bio", profile.Bio, bioMaxLen},
+		{"job", profile.Job, jobMaxLen},
+		{"education", profile.Education, educationMaxLen},
+	}
+	for _, field := range fields {
+		if utf8.RuneCountInString(field.value) > field.maxLen {
+			validationError.AddError(field.name, fmt.Sprintf("must be at most %d characters in length", field.maxLen))
+		}
+	}
+
+	if validationError.HasError() {
+		return validationError
+	}
+	return nil
+}
+
+func (profile Profile) validateHeight() error {
+	if profile.HeightCM != 0 && (profile.HeightCM < heightMinCM || profile.HeightCM > heightMaxCM) {
+		return customerror.NewValidationErrorWithMessage("height_cm", fmt.Sprintf("must be between %d and %d", heightMinCM, heightMaxCM))
+	}
+	return nil
+}
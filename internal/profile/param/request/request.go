@@ -0,0 +1,37 @@
+package request
+
+// UpdateProfile is a partial update, nil fields are left unchanged.
+type UpdateProfile struct {
+	// Birthdate is a date in YYYY-MM-DD format.
+	Birthdate *string
+	Bio       *string
+	Job       *string
+	Education *string
+	// HeightCM is the height in centimeters, 0 clears it.
+	HeightCM *int
+}
+
+type UploadPhoto struct {
+	Content []byte
+	// ContentType is sniffed from Content rather than taken from the client.
+	ContentType string
+}
+
+type DeletePhoto struct {
+	PhotoID string
+}
+
+type ReorderPhotos struct {
+	// PhotoIDs lists every photo in the new order, the first one becomes the primary photo.
+	PhotoIDs []string
+}
+
+type OpenPhoto struct {
+	PhotoID string
+	Variant string
+}
+
+type SetInterests struct {
+	// InterestIDs replaces every interest of the user, an empty list removes them all.
+	InterestIDs []int64
+}
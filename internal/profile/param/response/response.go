@@ -0,0 +1,37 @@
+package response
+
+import (
+	"io"
+	"time"
+)
+
+type Profile struct {
+	UserID    int64     `json:"user_id"`
+	Birthdate time.Time `json:"birthdate"`
+	Age       int       `json:"age"`
+	Bio       string    `json:"bio"`
+	Job       string    `json:"job"`
+	Education string    `json:"education"`
+	HeightCM  int       `json:"height_cm"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Photo struct {
+	ID        string    `json:"id"`
+	Position  int       `json:"position"`
+	Primary   bool      `json:"primary"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PhotoFile is a stored variant of a photo, the caller closes Content.
+type PhotoFile struct {
+	ContentType string
+	Content     io.ReadCloser
+}
+
+type Interest struct {
+	ID       int64  `json:"id"`
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
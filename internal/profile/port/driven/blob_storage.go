@@ -0,0 +1,17 @@
+package driven
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStorage keeps binary objects such as photos by key, keys are slash separated paths
+// such as "photos/1/9b2f.../thumbnail.jpg".
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Open returns fs.ErrNotExist when nothing is stored under key, the caller closes the reader.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every object whose key starts with prefix, prefix has to end with a slash.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
@@ -0,0 +1,9 @@
+package driven
+
+import "app/internal/profile/entity"
+
+type ImageProcessor interface {
+	// Resize decodes content and encodes it as a JPEG for every variant, scaled down to fit the variant.
+	// It returns entity.ErrUnsupportedImage when content cannot be decoded or is too large to decode.
+	Resize(content []byte, variants []entity.PhotoVariant) (map[string][]byte, error)
+}
@@ -0,0 +1,18 @@
+package driven
+
+import (
+	"app/internal/profile/entity"
+	"context"
+)
+
+type InterestStore interface {
+	// ListCatalog returns every interest users can pick, ordered by category and name.
+	ListCatalog(ctx context.Context) ([]*entity.Interest, error)
+	// ListByUserID returns the interests of userID ordered by category and name.
+	ListByUserID(ctx context.Context, userID int64) ([]*entity.Interest, error)
+	// SetForUser replaces every interest of userID with interestIDs.
+	SetForUser(ctx context.Context, userID int64, interestIDs []int64) error
+	// ListSharingUsers returns up to limit other users having interests in common with userID,
+	// the ones sharing the most interests first, deleted accounts are left out.
+	ListSharingUsers(ctx context.Context, userID int64, limit int) ([]*entity.SharedInterests, error)
+}
@@ -0,0 +1,20 @@
+package driven
+
+import (
+	"app/internal/profile/entity"
+	"context"
+)
+
+type PhotoStore interface {
+	// ListByUserID returns the photos of userID ordered by position.
+	ListByUserID(ctx context.Context, userID int64) ([]*entity.Photo, error)
+	// GetByID returns sql.ErrNoRows when the owner of the photo is deleted, deleted accounts are hidden right away.
+	GetByID(ctx context.Context, id string) (*entity.Photo, error)
+	// Create fails with a unique violation when another photo already holds the position.
+	Create(ctx context.Context, photo *entity.Photo) error
+	// Delete removes the photo of userID and moves the following photos up, it returns
+	// sql.ErrNoRows when userID has no such photo.
+	Delete(ctx context.Context, userID int64, id string) error
+	// Reorder moves the photos of userID to the position of their id in ids.
+	Reorder(ctx context.Context, userID int64, ids []string) error
+}
@@ -0,0 +1,13 @@
+package driven
+
+import (
+	"app/internal/profile/entity"
+	"context"
+)
+
+type ProfileStore interface {
+	// GetByUserID returns sql.ErrNoRows when the user has no profile yet.
+	GetByUserID(ctx context.Context, userID int64) (*entity.Profile, error)
+	// Save creates or replaces the profile of profile.UserID.
+	Save(ctx context.Context, profile *entity.Profile) error
+}
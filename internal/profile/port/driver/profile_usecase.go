@@ -0,0 +1,26 @@
+package driver
+
+import (
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"context"
+)
+
+type ProfileUsecase interface {
+	GetMyProfile(ctx context.Context) (*response.Profile, error)
+	UpdateMyProfile(ctx context.Context, params *request.UpdateProfile) (*response.Profile, error)
+}
+
+type PhotoUsecase interface {
+	UploadPhoto(ctx context.Context, params *request.UploadPhoto) (*response.Photo, error)
+	ListMyPhotos(ctx context.Context) ([]*response.Photo, error)
+	DeletePhoto(ctx context.Context, params *request.DeletePhoto) error
+	ReorderPhotos(ctx context.Context, params *request.ReorderPhotos) ([]*response.Photo, error)
+	OpenPhoto(ctx context.Context, params *request.OpenPhoto) (*response.PhotoFile, error)
+}
+
+type InterestUsecase interface {
+	ListInterests(ctx context.Context) ([]*response.Interest, error)
+	ListMyInterests(ctx context.Context) ([]*response.Interest, error)
+	SetMyInterests(ctx context.Context, params *request.SetInterests) ([]*response.Interest, error)
+}
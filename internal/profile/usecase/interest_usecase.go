@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driven"
+	"context"
+)
+
+type InterestUsecase struct {
+	interestStore  driven.InterestStore
+	interestPolicy *entity.InterestPolicy
+}
+
+func NewInterestUsecase(interestStore driven.InterestStore, interestPolicy *entity.InterestPolicy) *InterestUsecase {
+	return &InterestUsecase{
+		interestStore:  interestStore,
+		interestPolicy: interestPolicy,
+	}
+}
+
+// ListInterests returns the catalog of interests users can pick from.
+func (iu InterestUsecase) ListInterests(ctx context.Context) ([]*response.Interest, error) {
+	interests, err := iu.interestStore.ListCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toInterestResponses(interests), nil
+}
+
+// ListMyInterests returns the interests of the authenticated user.
+func (iu InterestUsecase) ListMyInterests(ctx context.Context) ([]*response.Interest, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	interests, err := iu.interestStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toInterestResponses(interests), nil
+}
+
+// SetMyInterests replaces the interests of the authenticated user with the ones of params.
+func (iu InterestUsecase) SetMyInterests(ctx context.Context, params *request.SetInterests) ([]*response.Interest, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	catalog, err := iu.interestStore.ListCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	interestIDs, err := iu.interestPolicy.ValidateInterestSelection(params.InterestIDs, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	err = iu.interestStore.SetForUser(ctx, userID, interestIDs)
+	if err != nil {
+		return nil, err
+	}
+	return iu.ListMyInterests(ctx)
+}
+
+func toInterestResponses(interests []*entity.Interest) []*response.Interest {
+	result := make([]*response.Interest, 0, len(interests))
+	for _, interest := range interests {
+		result = append(result, &response.Interest{
+			ID:       interest.ID,
+			Slug:     interest.Slug,
+			Name:     interest.Name,
+			Category: interest.Category,
+		})
+	}
+	return result
+}
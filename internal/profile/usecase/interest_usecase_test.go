@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/usecase"
+	userentity "app/internal/user/entity"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newInterestCatalog() []*entity.Interest {
+	return []*entity.Interest{
+		{ID: 1, Slug: "hiking", Name: "Hiking", Category: "Outdoors"},
+		{ID: 2, Slug: "coffee", Name: "Coffee", Category: "Food & Drink"},
+		{ID: 3, Slug: "jazz", Name: "Jazz", Category: "Music"},
+		{ID: 4, Slug: "chess", Name: "Chess", Category: "Games"},
+	}
+}
+
+func TestInterestUsecase_SetMyInterests(t *testing.T) {
+	userCtx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		params     *request.SetInterests
+		wantErr    error
+		wantErrMsg string
+		wantIDs    []int64
+	}{
+		{
+			name:    "when no authenticated user, it should return unauthorized error",
+			ctx:     context.Background(),
+			params:  &request.SetInterests{InterestIDs: []int64{1}},
+			wantErr: new(customerror.UnauthorizedError),
+		},
+		{
+			name:       "when interests are not in the catalog, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.SetInterests{InterestIDs: []int64{99, 10, 9}},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "interest_ids: unknown interests 9 and 10 and 99",
+		},
+		{
+			name:       "when there are more interests than allowed, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.SetInterests{InterestIDs: []int64{1, 2, 3, 4}},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "interest_ids: can only have up to 3 interests",
+		},
+		{
+			name:    "when an interest is listed twice, it should only count it once",
+			ctx:     userCtx,
+			params:  &request.SetInterests{InterestIDs: []int64{3, 1, 3, 2}},
+			wantIDs: []int64{1, 2, 3},
+		},
+		{
+			name:    "when the list is empty, it should remove every interest",
+			ctx:     userCtx,
+			params:  &request.SetInterests{InterestIDs: []int64{}},
+			wantIDs: []int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iu := usecase.NewInterestUsecase(fake.NewFakeInterestStore(newInterestCatalog()...), &entity.InterestPolicy{MaxInterests: 3})
+			got, err := iu.SetMyInterests(tt.ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErr != nil {
+				assert.Nil(got)
+				assert.IsType(tt.wantErr, err)
+				if tt.wantErrMsg != "" {
+					assert.EqualError(err, tt.wantErrMsg)
+				}
+				return
+			}
+			assert.NoError(err)
+			gotIDs := []int64{}
+			for _, interest := range got {
+				gotIDs = append(gotIDs, interest.ID)
+			}
+			assert.Equal(tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestInterestUsecase_listInterests(t *testing.T) {
+	assert := assert.New(t)
+	ctx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	iu := usecase.NewInterestUsecase(fake.NewFakeInterestStore(newInterestCatalog()...), &entity.InterestPolicy{MaxInterests: 3})
+
+	catalog, err := iu.ListInterests(ctx)
+	assert.NoError(err)
+	assert.Len(catalog, 4)
+	assert.Equal("hiking", catalog[0].Slug)
+
+	mine, err := iu.ListMyInterests(ctx)
+	assert.NoError(err)
+	assert.Empty(mine)
+
+	_, err = iu.SetMyInterests(ctx, &request.SetInterests{InterestIDs: []int64{4}})
+	assert.NoError(err)
+	mine, err = iu.ListMyInterests(ctx)
+	assert.NoError(err)
+	assert.Equal("Chess", mine[0].Name)
+
+	_, err = iu.ListInterests(context.WithValue(ctx, fake.ContextType("interest_catalog_error"), true))
+	assert.Error(err)
+	_, err = iu.SetMyInterests(context.WithValue(ctx, fake.ContextType("set_interests_error"), true), &request.SetInterests{InterestIDs: []int64{1}})
+	assert.Error(err)
+}
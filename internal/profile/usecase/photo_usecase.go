@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driven"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+)
+
+type PhotoUsecase struct {
+	photoStore     driven.PhotoStore
+	blobStorage    driven.BlobStorage
+	imageProcessor driven.ImageProcessor
+	photoPolicy    *entity.PhotoPolicy
+}
+
+func NewPhotoUsecase(
+	photoStore driven.PhotoStore,
+	blobStorage driven.BlobStorage,
+	imageProcessor driven.ImageProcessor,
+	photoPolicy *entity.PhotoPolicy,
+) *PhotoUsecase {
+	return &PhotoUsecase{
+		photoStore:     photoStore,
+		blobStorage:    blobStorage,
+		imageProcessor: imageProcessor,
+		photoPolicy:    photoPolicy,
+	}
+}
+
+// UploadPhoto stores every variant of an uploaded image and adds it after the existing photos,
+// the first photo of a user becomes the primary one.
+func (pu PhotoUsecase) UploadPhoto(ctx context.Context, params *request.UploadPhoto) (*response.Photo, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	err := pu.photoPolicy.ValidateUpload(params.ContentType, int64(len(params.Content)))
+	if err != nil {
+		return nil, err
+	}
+
+	photos, err := pu.photoStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	photo, err := entity.NewPhoto(userID, photos, pu.photoPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := pu.imageProcessor.Resize(params.Content, entity.PhotoVariants)
+	if errors.Is(err, entity.ErrUnsupportedImage) {
+		return nil, customerror.NewValidationErrorWithMessage("photo", "must be a JPEG or PNG image of at most 40 megapixels")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.storeVariants(ctx, photo, variants)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.photoStore.Create(ctx, photo)
+	if err != nil {
+		pu.deleteVariants(ctx, photo)
+		return nil, err
+	}
+	return toPhotoResponse(photo), nil
+}
+
+// ListMyPhotos returns the photos of the authenticated user in their order.
+func (pu PhotoUsecase) ListMyPhotos(ctx context.Context) ([]*response.Photo, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+	return pu.listPhotos(ctx, userID)
+}
+
+// DeletePhoto removes a photo of the authenticated user, the next photo becomes primary when it was the primary one.
+func (pu PhotoUsecase) DeletePhoto(ctx context.Context, params *request.DeletePhoto) error {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	photo, err := pu.photoStore.GetByID(ctx, params.PhotoID)
+	if err != nil {
+		return err
+	}
+	if photo.UserID != userID {
+		return sql.ErrNoRows
+	}
+
+	err = pu.photoStore.Delete(ctx, userID, photo.ID)
+	if err != nil {
+		return err
+	}
+
+	// the photo is already gone for everyone, files left behind only cost storage
+	pu.deleteVariants(ctx, photo)
+	return nil
+}
+
+// ReorderPhotos puts the photos of the authenticated user in the order of params, the first one becomes primary.
+func (pu PhotoUsecase) ReorderPhotos(ctx context.Context, params *request.ReorderPhotos) ([]*response.Photo, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	photos, err := pu.photoStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = entity.ValidatePhotoOrder(photos, params.PhotoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.photoStore.Reorder(ctx, userID, params.PhotoIDs)
+	if err != nil {
+		return nil, err
+	}
+	return pu.listPhotos(ctx, userID)
+}
+
+// OpenPhoto returns a variant of any photo to an authenticated user, photos are shown to other users.
+func (pu PhotoUsecase) OpenPhoto(ctx context.Context, params *request.OpenPhoto) (*response.PhotoFile, error) {
+	if _, ok := authcontext.UserIDFromContext(ctx); !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	variant, ok := entity.PhotoVariantFromName(params.Variant)
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	photo, err := pu.photoStore.GetByID(ctx, params.PhotoID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := pu.blobStorage.Open(ctx, photo.FileKey(variant))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &response.PhotoFile{
+		ContentType: "image/jpeg",
+		Content:     content,
+	}, nil
+}
+
+func (pu PhotoUsecase) listPhotos(ctx context.Context, userID int64) ([]*response.Photo, error) {
+	photos, err := pu.photoStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*response.Photo, 0, len(photos))
+	for _, photo := range photos {
+		result = append(result, toPhotoResponse(photo))
+	}
+	return result, nil
+}
+
+// storeVariants puts every variant of photo, the ones already stored are removed again when one fails.
+func (pu PhotoUsecase) storeVariants(ctx context.Context, photo *entity.Photo, variants map[string][]byte) error {
+	for _, variant := range entity.PhotoVariants {
+		err := pu.blobStorage.Put(ctx, photo.FileKey(variant), bytes.NewReader(variants[variant.Name]))
+		if err != nil {
+			pu.deleteVariants(ctx, photo)
+			return err
+		}
+	}
+	return nil
+}
+
+func (pu PhotoUsecase) deleteVariants(ctx context.Context, photo *entity.Photo) {
+	for _, variant := range entity.PhotoVariants {
+		_ = pu.blobStorage.Delete(ctx, photo.FileKey(variant))
+	}
+}
+
+func toPhotoResponse(photo *entity.Photo) *response.Photo {
+	return &response.Photo{
+		ID:        photo.ID,
+		Position:  photo.Position,
+		Primary:   photo.IsPrimary(),
+		CreatedAt: photo.CreatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/usecase"
+	userentity "app/internal/user/entity"
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newPhotoUsecase() (*usecase.PhotoUsecase, *fake.FakePhotoStore) {
+	photoStore := fake.NewFakePhotoStore()
+	pu := usecase.NewPhotoUsecase(
+		photoStore,
+		fake.NewFakeFileStorage(),
+		fake.NewFakeImageProcessor(),
+		&entity.PhotoPolicy{MaxPhotos: 2, MaxUploadBytes: 16},
+	)
+	return pu, photoStore
+}
+
+func TestPhotoUsecase_UploadPhoto(t *testing.T) {
+	userCtx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		params     *request.UploadPhoto
+		wantErr    error
+		wantErrMsg string
+	}{
+		{
+			name:    "when no authenticated user, it should return unauthorized error",
+			ctx:     context.Background(),
+			params:  &request.UploadPhoto{Content: []byte("image"), ContentType: "image/jpeg"},
+			wantErr: new(customerror.UnauthorizedError),
+		},
+		{
+			name:       "when upload is empty, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UploadPhoto{ContentType: "image/jpeg"},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "photo: is required",
+		},
+		{
+			name:       "when upload is too large, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UploadPhoto{Content: []byte("image larger than limit"), ContentType: "image/jpeg"},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "photo: must be at most 16 bytes",
+		},
+		{
+			name:       "when upload is not an image, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UploadPhoto{Content: []byte("GIF89a"), ContentType: "image/gif"},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "photo: must be a JPEG or PNG image",
+		},
+		{
+			name:       "when image cannot be decoded, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UploadPhoto{Content: []byte("invalid"), ContentType: "image/png"},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "photo: must be a JPEG or PNG image of at most 40 megapixels",
+		},
+		{
+			name:   "when image is valid, it should store it as the primary photo",
+			ctx:    userCtx,
+			params: &request.UploadPhoto{Content: []byte("image"), ContentType: "image/png"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pu, _ := newPhotoUsecase()
+			got, err := pu.UploadPhoto(tt.ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErr != nil {
+				assert.Nil(got)
+				assert.IsType(tt.wantErr, err)
+				if tt.wantErrMsg != "" {
+					assert.EqualError(err, tt.wantErrMsg)
+				}
+				return
+			}
+			assert.NoError(err)
+			assert.NotEmpty(got.ID)
+			assert.Zero(got.Position)
+			assert.True(got.Primary)
+		})
+	}
+}
+
+func TestPhotoUsecase_manageMyPhotos(t *testing.T) {
+	assert := assert.New(t)
+	ctx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	otherCtx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 2})
+	pu, _ := newPhotoUsecase()
+
+	first, err := pu.UploadPhoto(ctx, &request.UploadPhoto{Content: []byte("first"), ContentType: "image/jpeg"})
+	assert.NoError(err)
+	second, err := pu.UploadPhoto(ctx, &request.UploadPhoto{Content: []byte("second"), ContentType: "image/jpeg"})
+	assert.NoError(err)
+	assert.Equal(1, second.Position)
+	assert.False(second.Primary)
+
+	_, err = pu.UploadPhoto(ctx, &request.UploadPhoto{Content: []byte("third"), ContentType: "image/jpeg"})
+	assert.EqualError(err, "photo: can only have up to 2 photos")
+
+	_, err = pu.ReorderPhotos(ctx, &request.ReorderPhotos{PhotoIDs: []string{second.ID}})
+	assert.EqualError(err, "photo_ids: must list every photo exactly once", "it should refuse an order missing a photo")
+	_, err = pu.ReorderPhotos(ctx, &request.ReorderPhotos{PhotoIDs: []string{second.ID, second.ID}})
+	assert.EqualError(err, "photo_ids: must list every photo exactly once", "it should refuse an order listing a photo twice")
+
+	photos, err := pu.ReorderPhotos(ctx, &request.ReorderPhotos{PhotoIDs: []string{second.ID, first.ID}})
+	assert.NoError(err)
+	assert.Equal([]string{second.ID, first.ID}, []string{photos[0].ID, photos[1].ID})
+	assert.True(photos[0].Primary)
+
+	file, err := pu.OpenPhoto(otherCtx, &request.OpenPhoto{PhotoID: first.ID, Variant: entity.PhotoVariantThumbnail.Name})
+	assert.NoError(err, "it should show photos to other users")
+	content, _ := io.ReadAll(file.Content)
+	assert.Equal("first", string(content))
+	assert.Equal("image/jpeg", file.ContentType)
+
+	_, err = pu.OpenPhoto(otherCtx, &request.OpenPhoto{PhotoID: first.ID, Variant: "original"})
+	assert.ErrorIs(err, sql.ErrNoRows)
+
+	err = pu.DeletePhoto(otherCtx, &request.DeletePhoto{PhotoID: second.ID})
+	assert.ErrorIs(err, sql.ErrNoRows, "it should not delete the photo of another user")
+
+	err = pu.DeletePhoto(ctx, &request.DeletePhoto{PhotoID: second.ID})
+	assert.NoError(err)
+
+	photos, err = pu.ListMyPhotos(ctx)
+	assert.NoError(err)
+	assert.Len(photos, 1)
+	assert.Equal(first.ID, photos[0].ID)
+	assert.True(photos[0].Primary, "it should make the next photo primary")
+
+	_, err = pu.OpenPhoto(ctx, &request.OpenPhoto{PhotoID: second.ID, Variant: entity.PhotoVariantLarge.Name})
+	assert.ErrorIs(err, sql.ErrNoRows)
+
+	_, err = pu.ListMyPhotos(context.WithValue(ctx, fake.ContextType("photo_error"), true))
+	assert.Error(err)
+}
+
+func TestPhotoUsecase_UploadPhoto_failedSaveRemovesFiles(t *testing.T) {
+	assert := assert.New(t)
+	ctx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	fileStorage := fake.NewFakeFileStorage()
+	pu := usecase.NewPhotoUsecase(fake.NewFakePhotoStore(), fileStorage, fake.NewFakeImageProcessor(), &entity.PhotoPolicy{MaxPhotos: 6, MaxUploadBytes: 16})
+
+	_, err := pu.UploadPhoto(context.WithValue(ctx, fake.ContextType("create_photo_error"), true), &request.UploadPhoto{Content: []byte("image"), ContentType: "image/jpeg"})
+	assert.Error(err)
+	assert.Empty(fileStorage.Keys())
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"app/internal/profile/entity"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driven"
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+)
+
+type ProfileExportUsecase struct {
+	profileStore  driven.ProfileStore
+	photoStore    driven.PhotoStore
+	interestStore driven.InterestStore
+	blobStorage   driven.BlobStorage
+}
+
+func NewProfileExportUsecase(
+	profileStore driven.ProfileStore,
+	photoStore driven.PhotoStore,
+	interestStore driven.InterestStore,
+	blobStorage driven.BlobStorage,
+) *ProfileExportUsecase {
+	return &ProfileExportUsecase{
+		profileStore:  profileStore,
+		photoStore:    photoStore,
+		interestStore: interestStore,
+		blobStorage:   blobStorage,
+	}
+}
+
+// ExportProfileData writes the profile, interests and photos of userID into the data export archive,
+// a profile the user never created is left out. Photos are copied in their largest variant.
+func (pe ProfileExportUsecase) ExportProfileData(ctx context.Context, userID int64, archive *zip.Writer) error {
+	interests, err := pe.interestStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = writeArchiveJSON(archive, "interests.json", toInterestResponses(interests))
+	if err != nil {
+		return err
+	}
+
+	photos, err := pe.photoStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	photoResponses := make([]*response.Photo, 0, len(photos))
+	for _, photo := range photos {
+		photoResponses = append(photoResponses, toPhotoResponse(photo))
+	}
+	err = writeArchiveJSON(archive, "photos.json", photoResponses)
+	if err != nil {
+		return err
+	}
+
+	profile, err := pe.profileStore.GetByUserID(ctx, userID)
+	if err == nil {
+		err = writeArchiveJSON(archive, "profile.json", toProfileResponse(profile, time.Now()))
+	} else if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return pe.archivePhotos(ctx, archive, photos)
+}
+
+// archivePhotos copies the largest variant of every photo under photos/ in the archive.
+func (pe ProfileExportUsecase) archivePhotos(ctx context.Context, archive *zip.Writer, photos []*entity.Photo) error {
+	for _, photo := range photos {
+		content, err := pe.blobStorage.Open(ctx, photo.FileKey(entity.PhotoVariantLarge))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		writer, err := archive.Create(fmt.Sprintf("photos/%d-%s.jpg", photo.Position, photo.ID))
+		if err == nil {
+			_, err = io.Copy(writer, content)
+		}
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, content interface{}) error {
+	writer, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(content)
+}
@@ -0,0 +1,74 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	"app/internal/profile/entity"
+	"app/internal/profile/usecase"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileExportUsecase_ExportProfileData(t *testing.T) {
+	assert := assert.New(t)
+	profileStore := fake.NewFakeProfileStore()
+	photoStore := fake.NewFakePhotoStore()
+	interestStore := fake.NewFakeInterestStore(&entity.Interest{ID: 7, Slug: "hiking", Name: "Hiking", Category: "outdoors"})
+	blobStorage := fake.NewFakeFileStorage()
+	pe := usecase.NewProfileExportUsecase(profileStore, photoStore, interestStore, blobStorage)
+
+	assert.NoError(interestStore.SetForUser(context.Background(), 1, []int64{7}))
+	photo := &entity.Photo{ID: "photo-1", UserID: 1}
+	assert.NoError(photoStore.Create(context.Background(), photo))
+	assert.NoError(blobStorage.Put(context.Background(), photo.FileKey(entity.PhotoVariantLarge), strings.NewReader("large photo")))
+
+	export := func(ctx context.Context, userID int64) (map[string]string, error) {
+		var buf bytes.Buffer
+		archive := zip.NewWriter(&buf)
+		if err := pe.ExportProfileData(ctx, userID, archive); err != nil {
+			return nil, err
+		}
+		assert.NoError(archive.Close())
+
+		reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(err)
+		files := make(map[string]string)
+		for _, f := range reader.File {
+			content, err := f.Open()
+			assert.NoError(err)
+			data, _ := io.ReadAll(content)
+			content.Close()
+			files[f.Name] = string(data)
+		}
+		return files, nil
+	}
+
+	t.Run("when the user never created a profile, it should leave it out", func(t *testing.T) {
+		files, err := export(context.Background(), 1)
+		assert.NoError(err)
+		assert.NotContains(files, "profile.json")
+		assert.Contains(files["interests.json"], "hiking")
+	})
+
+	t.Run("when the user has a profile, it should archive the profile and the photos", func(t *testing.T) {
+		assert.NoError(profileStore.Save(context.Background(), &entity.Profile{UserID: 1, Bio: "Coffee first", Birthdate: time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC)}))
+
+		files, err := export(context.Background(), 1)
+		assert.NoError(err)
+		assert.Contains(files["profile.json"], "Coffee first")
+		assert.Contains(files["interests.json"], "hiking")
+		assert.Contains(files["photos.json"], "photo-1")
+		assert.Equal("large photo", files["photos/0-photo-1.jpg"])
+	})
+
+	t.Run("when reading the profile fails, it should return error", func(t *testing.T) {
+		_, err := export(context.WithValue(context.Background(), fake.ContextType("profile_error"), true), 1)
+		assert.Error(err)
+	})
+}
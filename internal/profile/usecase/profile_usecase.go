@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/entity"
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type ProfileUsecase struct {
+	profileStore driven.ProfileStore
+}
+
+func NewProfileUsecase(profileStore driven.ProfileStore) *ProfileUsecase {
+	return &ProfileUsecase{
+		profileStore: profileStore,
+	}
+}
+
+// GetMyProfile returns the profile of the authenticated user.
+func (pu ProfileUsecase) GetMyProfile(ctx context.Context) (*response.Profile, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	profile, err := pu.profileStore.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileResponse(profile, time.Now()), nil
+}
+
+// UpdateMyProfile changes the fields of params that are set, the profile is created on the first update.
+func (pu ProfileUsecase) UpdateMyProfile(ctx context.Context, params *request.UpdateProfile) (*response.Profile, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	profile, err := pu.profileStore.GetByUserID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		profile = entity.NewProfile(userID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = profile.Update(params, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.profileStore.Save(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileResponse(profile, now), nil
+}
+
+func toProfileResponse(profile *entity.Profile, now time.Time) *response.Profile {
+	return &response.Profile{
+		UserID:    profile.UserID,
+		Birthdate: profile.Birthdate,
+		Age:       profile.Age(now),
+		Bio:       profile.Bio,
+		Job:       profile.Job,
+		Education: profile.Education,
+		HeightCM:  profile.HeightCM,
+		UpdatedAt: profile.UpdatedAt,
+	}
+}
@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/profile/param/request"
+	"app/internal/profile/usecase"
+	userentity "app/internal/user/entity"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strPtr(value string) *string {
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func birthdateForAge(age int) string {
+	return time.Now().AddDate(-age, 0, 0).Format("2006-01-02")
+}
+
+func TestProfileUsecase_UpdateMyProfile(t *testing.T) {
+	userCtx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		params     *request.UpdateProfile
+		wantErr    error
+		wantErrMsg string
+	}{
+		{
+			name:    "when no authenticated user, it should return unauthorized error",
+			ctx:     context.Background(),
+			params:  &request.UpdateProfile{Birthdate: strPtr(birthdateForAge(20))},
+			wantErr: new(customerror.UnauthorizedError),
+		},
+		{
+			name:       "when profile is created without birthdate, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateProfile{Bio: strPtr("hello")},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "birthdate: is required",
+		},
+		{
+			name:       "when birthdate is not a date, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateProfile{Birthdate: strPtr("04/03/1995")},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "birthdate: must be a date in YYYY-MM-DD format",
+		},
+		{
+			name:       "when user is younger than 18, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateProfile{Birthdate: strPtr(time.Now().AddDate(-18, 0, 1).Format("2006-01-02"))},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "birthdate: must be at least 18 years old",
+		},
+		{
+			name:       "when birthdate is not realistic, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateProfile{Birthdate: strPtr("1850-01-01")},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "birthdate: must be at most 120 years old",
+		},
+		{
+			name: "when fields are too long, it should return every validation error",
+			ctx:  userCtx,
+			params: &request.UpdateProfile{
+				Birthdate: strPtr(birthdateForAge(25)),
+				Bio:       strPtr(strings.Repeat("a", 501)),
+				Job:       strPtr(strings.Repeat("a", 101)),
+				Education: strPtr(strings.Repeat("a", 101)),
+				HeightCM:  intPtr(300),
+			},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "bio: must be at most 500 characters in length;education: must be at most 100 characters in length;height_cm: must be between 100 and 250;job: must be at most 100 characters in length",
+		},
+		{
+			name:   "when user is exactly 18, it should create the profile",
+			ctx:    userCtx,
+			params: &request.UpdateProfile{Birthdate: strPtr(birthdateForAge(18)), Bio: strPtr("hello"), HeightCM: intPtr(170)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pu := usecase.NewProfileUsecase(fake.NewFakeProfileStore())
+			got, err := pu.UpdateMyProfile(tt.ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErr != nil {
+				assert.Nil(got)
+				assert.IsType(tt.wantErr, err)
+				if tt.wantErrMsg != "" {
+					want, actual := strings.Split(tt.wantErrMsg, ";"), strings.Split(err.Error(), ";")
+					assert.ElementsMatch(want, actual)
+				}
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(int64(1), got.UserID)
+			assert.Equal(18, got.Age)
+			assert.Equal("hello", got.Bio)
+			assert.Equal(170, got.HeightCM)
+		})
+	}
+}
+
+func TestProfileUsecase_partialUpdate(t *testing.T) {
+	assert := assert.New(t)
+	ctx := authcontext.WithClaims(context.Background(), &userentity.UserClaims{UserID: 1})
+	pu := usecase.NewProfileUsecase(fake.NewFakeProfileStore())
+
+	_, err := pu.GetMyProfile(ctx)
+	assert.ErrorIs(err, sql.ErrNoRows, "it should not find a profile that was never filled in")
+
+	_, err = pu.UpdateMyProfile(ctx, &request.UpdateProfile{Birthdate: strPtr(birthdateForAge(30)), Job: strPtr("engineer"), HeightCM: intPtr(180)})
+	assert.NoError(err)
+
+	_, err = pu.UpdateMyProfile(ctx, &request.UpdateProfile{Bio: strPtr("hello"), HeightCM: intPtr(0)})
+	assert.NoError(err, "it should not require the birthdate again")
+
+	_, err = pu.UpdateMyProfile(ctx, &request.UpdateProfile{Birthdate: strPtr(birthdateForAge(10)), Bio: strPtr("changed")})
+	assert.IsType(new(customerror.ValidationError), err)
+
+	got, err := pu.GetMyProfile(ctx)
+	assert.NoError(err)
+	assert.Equal(30, got.Age, "it should keep the profile unchanged when an update is not valid")
+	assert.Equal("engineer", got.Job)
+	assert.Equal("hello", got.Bio)
+	assert.Zero(got.HeightCM)
+
+	_, err = pu.GetMyProfile(context.WithValue(ctx, fake.ContextType("profile_error"), true))
+	assert.Error(err)
+	_, err = pu.UpdateMyProfile(context.WithValue(ctx, fake.ContextType("save_profile_error"), true), &request.UpdateProfile{Bio: strPtr("hi")})
+	assert.Error(err)
+}
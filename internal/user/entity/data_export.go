@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// DataExport is an archive of everything stored about a user, only its owner can download it until it expires.
+type DataExport struct {
+	ID        string
+	UserID    int64
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (de DataExport) IsExpired(now time.Time) bool {
+	return !now.Before(de.ExpiresAt)
+}
+
+// FileKey is where the archive is kept in the file storage.
+func (de DataExport) FileKey() string {
+	return fmt.Sprintf("%s%s.zip", DataExportKeyPrefix(de.UserID), de.ID)
+}
+
+// DataExportKeyPrefix is the common prefix of the file keys of every export of userID.
+func DataExportKeyPrefix(userID int64) string {
+	return fmt.Sprintf("exports/%d/", userID)
+}
+
+// LoginInformation is the login bookkeeping of a user, LastLoginAt is nil until the first login.
+type LoginInformation struct {
+	SuccessLoginCount int
+	LastLoginAt       *time.Time
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"strings"
+)
+
+// Gender is the gender identity of a user, the api.v1.Gender enum names the values in upper case with a GENDER_ prefix.
+type Gender string
+
+const (
+	GenderWoman       Gender = "woman"
+	GenderMan         Gender = "man"
+	GenderNonBinary   Gender = "non_binary"
+	GenderGenderqueer Gender = "genderqueer"
+	GenderGenderfluid Gender = "genderfluid"
+	GenderAgender     Gender = "agender"
+	GenderTwoSpirit   Gender = "two_spirit"
+)
+
+// Genders lists every gender identity the app knows about, UserPolicy.Genders picks the ones users are offered.
+var Genders = []Gender{
+	GenderWoman,
+	GenderMan,
+	GenderNonBinary,
+	GenderGenderqueer,
+	GenderGenderfluid,
+	GenderAgender,
+	GenderTwoSpirit,
+}
+
+// Orientation is the sexual orientation of a user, OrientationUnspecified when they would rather not say.
+type Orientation string
+
+const (
+	OrientationUnspecified Orientation = ""
+	OrientationStraight    Orientation = "straight"
+	OrientationGay         Orientation = "gay"
+	OrientationLesbian     Orientation = "lesbian"
+	OrientationBisexual    Orientation = "bisexual"
+	OrientationPansexual   Orientation = "pansexual"
+	OrientationAsexual     Orientation = "asexual"
+	OrientationQueer       Orientation = "queer"
+	OrientationQuestioning Orientation = "questioning"
+)
+
+var orientations = []Orientation{
+	OrientationStraight,
+	OrientationGay,
+	OrientationLesbian,
+	OrientationBisexual,
+	OrientationPansexual,
+	OrientationAsexual,
+	OrientationQueer,
+	OrientationQuestioning,
+}
+
+// GenderFromString parses one of Genders, ignoring case.
+func GenderFromString(value string) (Gender, bool) {
+	lowerValue := strings.ToLower(value)
+	for _, gender := range Genders {
+		if string(gender) == lowerValue {
+			return gender, true
+		}
+	}
+	return "", false
+}
+
+func (g Gender) String() string {
+	return string(g)
+}
+
+// OrientationFromString parses an orientation ignoring case, an empty value is OrientationUnspecified.
+func OrientationFromString(value string) (Orientation, bool) {
+	lowerValue := strings.ToLower(value)
+	if lowerValue == "" {
+		return OrientationUnspecified, true
+	}
+	for _, orientation := range orientations {
+		if string(orientation) == lowerValue {
+			return orientation, true
+		}
+	}
+	return OrientationUnspecified, false
+}
+
+func (o Orientation) String() string {
+	return string(o)
+}
+
+// choices lists values the way validation messages do: "a or b or c", without commas since
+// the error formatter splits the reasons of a field on them.
+func choices[T ~string](values []T) string {
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, string(value))
+	}
+	return strings.Join(names, " or ")
+}
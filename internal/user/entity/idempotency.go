@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord remembers the request sent with an Idempotency-Key and, once it succeeded, its response.
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	// Response is nil while the first request with the key is still being processed.
+	Response  []byte
+	ExpiresAt time.Time
+}
+
+func (ir IdempotencyRecord) IsCompleted() bool {
+	return ir.Response != nil
+}
+
+// IdempotencyPolicy tells how long a response is replayed and how long a key stays reserved
+// by a request that never finished, e.g. when the instance processing it stopped.
+// Responses are stored as they are, so Operations must only list operations that return no secret
+// like a token, a reset ticket or recovery codes, those would otherwise be kept in plaintext.
+type IdempotencyPolicy struct {
+	TTL         time.Duration
+	LockTimeout time.Duration
+	Operations  map[string]struct{}
+}
+
+// Covers reports whether calls of operation are deduplicated by their Idempotency-Key.
+func (ip IdempotencyPolicy) Covers(operation string) bool {
+	_, ok := ip.Operations[operation]
+	return ok
+}
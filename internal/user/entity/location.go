@@ -0,0 +1,176 @@
+package entity
+
+import (
+	customerror "app/internal/custom_error"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// LocationGeohashPrecision is the number of geohash characters stored, a cell of about 5 meters.
+	LocationGeohashPrecision = 9
+
+	// EarthRadiusKM is the mean radius of the earth used for distances.
+	EarthRadiusKM = 6371.0088
+
+	// minGeohashCoveragePrecision keeps the coverage to nine cells of about 625 by 1250 kilometers,
+	// a shorter prefix would make a lookup read most of the locations.
+	minGeohashCoveragePrecision = 2
+
+	geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+// Location is the last known position of a user.
+type Location struct {
+	UserID    int64
+	Latitude  float64
+	Longitude float64
+	// Geohash indexes the position so nearby users share a prefix, see GeohashCoverage.
+	Geohash   string
+	UpdatedAt time.Time
+}
+
+func NewLocation(userID int64, latitude, longitude float64) (*Location, error) {
+	validationError := customerror.NewValidationError()
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		validationError.AddError("latitude", "must be between -90 and 90")
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		validationError.AddError("longitude", "must be between -180 and 180")
+	}
+	if validationError.HasError() {
+		return nil, validationError
+	}
+
+	return &Location{
+		UserID:    userID,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Geohash:   EncodeGeohash(latitude, longitude, LocationGeohashPrecision),
+	}, nil
+}
+
+// DistanceKM is the great-circle distance to other in kilometers.
+func (location Location) DistanceKM(other Location) float64 {
+	return HaversineDistanceKM(location.Latitude, location.Longitude, other.Latitude, other.Longitude)
+}
+
+// HaversineDistanceKM is the great-circle distance in kilometers between two points given in degrees.
+func HaversineDistanceKM(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	phi1, phi2 := toRadians(latitude1), toRadians(latitude2)
+	deltaPhi := toRadians(latitude2 - latitude1)
+	deltaLambda := toRadians(longitude2 - longitude1)
+
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * EarthRadiusKM * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// EncodeGeohash returns the geohash of a point with precision characters.
+func EncodeGeohash(latitude, longitude float64, precision int) string {
+	minLatitude, maxLatitude := -90.0, 90.0
+	minLongitude, maxLongitude := -180.0, 180.0
+
+	var geohash strings.Builder
+	bits, char, evenBit := 0, 0, true
+	for geohash.Len() < precision {
+		// bits alternate between longitude and latitude, starting with longitude
+		if evenBit {
+			middle := (minLongitude + maxLongitude) / 2
+			char <<= 1
+			if longitude >= middle {
+				char |= 1
+				minLongitude = middle
+			} else {
+				maxLongitude = middle
+			}
+		} else {
+			middle := (minLatitude + maxLatitude) / 2
+			char <<= 1
+			if latitude >= middle {
+				char |= 1
+				minLatitude = middle
+			} else {
+				maxLatitude = middle
+			}
+		}
+		evenBit = !evenBit
+
+		bits++
+		if bits == 5 {
+			geohash.WriteByte(geohashAlphabet[char])
+			bits, char = 0, 0
+		}
+	}
+	return geohash.String()
+}
+
+// GeohashCoverage returns the geohash prefixes whose cells cover every point within radiusKM of a point:
+// the cell of the point and its eight neighbours, at the longest precision whose cells are still at least
+// radiusKM wide. Points found through the prefixes still need a distance check, the cells cover a square.
+// The precision never drops below minGeohashCoveragePrecision, a radius wider than those cells is only
+// covered as far as the cells reach.
+func GeohashCoverage(latitude, longitude, radiusKM float64) []string {
+	precision := max(geohashPrecisionFor(latitude, radiusKM), minGeohashCoveragePrecision)
+	cellHeight, cellWidth := geohashCellSize(precision)
+
+	seen := make(map[string]bool, 9)
+	prefixes := make([]string, 0, 9)
+	for _, deltaLatitude := range []float64{-cellHeight, 0, cellHeight} {
+		for _, deltaLongitude := range []float64{-cellWidth, 0, cellWidth} {
+			neighbourLatitude := latitude + deltaLatitude
+			if neighbourLatitude > 90 || neighbourLatitude < -90 {
+				// there is nothing past a pole, a circle reaching over one is only covered on its own side
+				continue
+			}
+			prefix := EncodeGeohash(neighbourLatitude, wrapLongitude(longitude+deltaLongitude), precision)
+			if !seen[prefix] {
+				seen[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// geohashPrecisionFor is the longest precision whose cells are at least radiusKM high and wide at latitude.
+func geohashPrecisionFor(latitude, radiusKM float64) int {
+	kmPerDegreeLatitude := math.Pi * EarthRadiusKM / 180
+	// the cells narrow towards the poles, the side of the cell closer to a pole is the narrowest
+	kmPerDegreeLongitude := kmPerDegreeLatitude * math.Cos(toRadians(math.Min(89, math.Abs(latitude)+radiusKM/kmPerDegreeLatitude)))
+
+	precision := 0
+	for next := 1; next <= LocationGeohashPrecision; next++ {
+		cellHeight, cellWidth := geohashCellSize(next)
+		if cellHeight*kmPerDegreeLatitude < radiusKM || cellWidth*kmPerDegreeLongitude < radiusKM {
+			break
+		}
+		precision = next
+	}
+	return precision
+}
+
+// geohashCellSize is the height and width in degrees of the cells of a geohash precision.
+func geohashCellSize(precision int) (float64, float64) {
+	bits := precision * 5
+	longitudeBits := (bits + 1) / 2
+	latitudeBits := bits / 2
+	return 180 / math.Pow(2, float64(latitudeBits)), 360 / math.Pow(2, float64(longitudeBits))
+}
+
+func wrapLongitude(longitude float64) float64 {
+	if longitude >= 180 {
+		return longitude - 360
+	}
+	if longitude < -180 {
+		return longitude + 360
+	}
+	return longitude
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// LoginAttempt counts consecutive failed logins of a key, e.g. a username or a client IP.
+type LoginAttempt struct {
+	Key          string
+	FailedCount  int
+	LastFailedAt time.Time
+}
+
+// LoginThrottlePolicy describes how long a key has to wait after failing to log in.
+// The first FreeAttempts failures are not delayed, after that the delay doubles from BaseDelay
+// on every failure until LockoutThreshold is reached and the key is locked for Lockout.
+// Failures older than Window are forgotten.
+type LoginThrottlePolicy struct {
+	FreeAttempts     int
+	BaseDelay        time.Duration
+	LockoutThreshold int
+	Lockout          time.Duration
+	Window           time.Duration
+}
+
+type LoginThrottle struct {
+	Username LoginThrottlePolicy
+	ClientIP LoginThrottlePolicy
+}
+
+// RetryAfter returns how long attempt has to wait before trying again, zero when it is allowed now.
+func (p LoginThrottlePolicy) RetryAfter(attempt *LoginAttempt, now time.Time) time.Duration {
+	if attempt == nil || attempt.FailedCount == 0 {
+		return 0
+	}
+	if p.Window > 0 && now.Sub(attempt.LastFailedAt) > p.Window {
+		return 0
+	}
+
+	retryAfter := attempt.LastFailedAt.Add(p.delay(attempt.FailedCount)).Sub(now)
+	if retryAfter < 0 {
+		return 0
+	}
+	return retryAfter
+}
+
+func (p LoginThrottlePolicy) delay(failedCount int) time.Duration {
+	if p.LockoutThreshold > 0 && failedCount >= p.LockoutThreshold {
+		return p.Lockout
+	}
+	if failedCount <= p.FreeAttempts {
+		return 0
+	}
+
+	// cap the shift so a long streak cannot overflow
+	exponent := failedCount - p.FreeAttempts - 1
+	if exponent > 30 {
+		exponent = 30
+	}
+	delay := p.BaseDelay << exponent
+	if p.Lockout > 0 && delay > p.Lockout {
+		return p.Lockout
+	}
+	return delay
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// MFAChallenge is issued instead of a token when the password is correct but the
+// user has two-factor authentication enabled. It is completed with a TOTP or recovery code.
+type MFAChallenge struct {
+	ID         string
+	UserID     int64
+	Attempts   int
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (mc MFAChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(mc.ExpiresAt)
+}
+
+func (mc MFAChallenge) IsConsumed() bool {
+	return mc.ConsumedAt != nil
+}
+
+// IsUsable reports whether the challenge can still be completed.
+func (mc MFAChallenge) IsUsable(now time.Time, maxAttempts int) bool {
+	return !mc.IsExpired(now) && !mc.IsConsumed() && mc.Attempts < maxAttempts
+}
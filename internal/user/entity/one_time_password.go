@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+type OTPPurpose string
+
+const (
+	OTPPurposePhoneVerification OTPPurpose = "phone_verification"
+	OTPPurposePasswordReset     OTPPurpose = "password_reset"
+)
+
+// OneTimePassword is a short numeric code sent to the user's phone.
+// Only the hash of Code is persisted and a code can be guessed at most MaxAttempts times.
+type OneTimePassword struct {
+	ID          int64
+	UserID      int64
+	Purpose     OTPPurpose
+	Code        string
+	CodeHash    string
+	Attempts    int
+	MaxAttempts int
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func (otp OneTimePassword) IsExpired(now time.Time) bool {
+	return !now.Before(otp.ExpiresAt)
+}
+
+func (otp OneTimePassword) IsConsumed() bool {
+	return otp.ConsumedAt != nil
+}
+
+func (otp OneTimePassword) HasAttemptsLeft() bool {
+	return otp.Attempts < otp.MaxAttempts
+}
+
+// IsUsable reports whether the code can still be checked against user input.
+func (otp OneTimePassword) IsUsable(now time.Time) bool {
+	return !otp.IsExpired(now) && !otp.IsConsumed() && otp.HasAttemptsLeft()
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// PasswordResetTicket is handed out once the password reset code is verified,
+// it allows a single password change within a short time.
+type PasswordResetTicket struct {
+	ID         int64
+	UserID     int64
+	Ticket     string
+	TicketHash string
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+}
+
+func (prt PasswordResetTicket) IsExpired(now time.Time) bool {
+	return !now.Before(prt.ExpiresAt)
+}
+
+func (prt PasswordResetTicket) IsUsed() bool {
+	return prt.UsedAt != nil
+}
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	customerror "app/internal/custom_error"
+	"app/internal/user/param/request"
+	"fmt"
+	"time"
+)
+
+const (
+	// PreferencesMinAge is the age floor of the age range, nobody younger can be shown.
+	PreferencesMinAge = 18
+	preferencesMaxAge = 120
+
+	maxDistanceMinKM = 1
+	maxDistanceMaxKM = 500
+
+	defaultAgeRange      = 10
+	defaultMaxDistanceKM = 50
+)
+
+// Preferences describe who a user wants to see, every user has them from signup on.
+type Preferences struct {
+	UserID int64
+	// InterestedIn holds at least one gender and never the same one twice.
+	InterestedIn []Gender
+	MinAge       int
+	MaxAge       int
+	// MaxDistanceKM is how far away, in kilometers, the people shown can be.
+	MaxDistanceKM int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewDefaultPreferences returns the preferences user starts with at signup: the genders matching their
+// orientation, aged 18 to 28 and at most 50 km away.
+func NewDefaultPreferences(user *User, policy *UserPolicy) *Preferences {
+	return &Preferences{
+		UserID:        user.ID,
+		InterestedIn:  defaultInterestedIn(user, policy),
+		MinAge:        PreferencesMinAge,
+		MaxAge:        PreferencesMinAge + defaultAgeRange,
+		MaxDistanceKM: defaultMaxDistanceKM,
+	}
+}
+
+// Update applies the fields of param that are set and validates the whole preferences,
+// they are left unchanged when they are not valid.
+func (preferences *Preferences) Update(param *request.UpdatePreferences, policy *UserPolicy) error {
+	updated := *preferences
+	validationError := customerror.NewValidationError()
+
+	if param.InterestedIn != nil {
+		interestedIn, err := interestedInFromStrings(param.InterestedIn, policy)
+		validationError.Merge(err)
+		updated.InterestedIn = interestedIn
+	}
+	if param.MinAge != nil {
+		updated.MinAge = *param.MinAge
+	}
+	if param.MaxAge != nil {
+		updated.MaxAge = *param.MaxAge
+	}
+	if param.MaxDistanceKM != nil {
+		updated.MaxDistanceKM = *param.MaxDistanceKM
+	}
+
+	validationError.Merge(updated.validateAgeRange())
+	validationError.Merge(updated.validateMaxDistance())
+	if validationError.HasError() {
+		return validationError
+	}
+
+	*preferences = updated
+	return nil
+}
+
+// IsInterestedIn reports whether gender is one of the genders the user wants to see.
+func (preferences Preferences) IsInterestedIn(gender Gender) bool {
+	for _, interestedIn := range preferences.InterestedIn {
+		if interestedIn == gender {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsAge reports whether age is within the age range.
+func (preferences Preferences) AcceptsAge(age int) bool {
+	return age >= preferences.MinAge && age <= preferences.MaxAge
+}
+
+func (preferences Preferences) validateAgeRange() error {
+	validationError := customerror.NewValidationError()
+	if preferences.MinAge < PreferencesMinAge || preferences.MinAge > preferencesMaxAge {
+		validationError.AddError("min_age", fmt.Sprintf("must be between %d and %d", PreferencesMinAge, preferencesMaxAge))
+	}
+	if preferences.MaxAge < PreferencesMinAge || preferences.MaxAge > preferencesMaxAge {
+		validationError.AddError("max_age", fmt.Sprintf("must be between %d and %d", PreferencesMinAge, preferencesMaxAge))
+	}
+	if !validationError.HasError() && preferences.MinAge > preferences.MaxAge {
+		validationError.AddError("min_age", "must not be greater than max_age")
+	}
+
+	if validationError.HasError() {
+		return validationError
+	}
+	return nil
+}
+
+func (preferences Preferences) validateMaxDistance() error {
+	if preferences.MaxDistanceKM < maxDistanceMinKM || preferences.MaxDistanceKM > maxDistanceMaxKM {
+		return customerror.NewValidationErrorWithMessage("max_distance_km", fmt.Sprintf("must be between %d and %d", maxDistanceMinKM, maxDistanceMaxKM))
+	}
+	return nil
+}
+
+// defaultInterestedIn guesses the genders user wants to see from their orientation, a man or a woman who
+// did not tell is assumed to be straight. Everyone else starts with every offered gender.
+func defaultInterestedIn(user *User, policy *UserPolicy) []Gender {
+	isBinary := user.Gender == GenderMan || user.Gender == GenderWoman
+	switch {
+	case isBinary && (user.Orientation == OrientationUnspecified || user.Orientation == OrientationStraight):
+		if user.Gender == GenderMan {
+			return []Gender{GenderWoman}
+		}
+		return []Gender{GenderMan}
+	case isBinary && user.Orientation == OrientationGay:
+		return []Gender{user.Gender}
+	case user.Orientation == OrientationLesbian:
+		return []Gender{GenderWoman}
+	}
+	return append([]Gender{}, policy.OfferedGenders()...)
+}
+
+// interestedInFromStrings parses the interested in genders, a gender listed twice is only kept once.
+func interestedInFromStrings(values []string, policy *UserPolicy) ([]Gender, error) {
+	if len(values) == 0 {
+		return nil, customerror.NewValidationErrorWithMessage("interested_in", "must have at least one gender")
+	}
+
+	genders := make([]Gender, 0, len(values))
+	seen := make(map[Gender]bool, len(values))
+	for _, value := range values {
+		gender, ok := policy.genderFromString(value)
+		if !ok {
+			return nil, customerror.NewValidationErrorWithMessage("interested_in", "can only be "+choices(policy.OfferedGenders()))
+		}
+		if !seen[gender] {
+			seen[gender] = true
+			genders = append(genders, gender)
+		}
+	}
+	return genders, nil
+}
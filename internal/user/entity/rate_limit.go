@@ -0,0 +1,66 @@
+package entity
+
+import "time"
+
+// RateLimit allows Requests calls every Period as a token bucket, the bucket holds up to Requests tokens
+// so a client that stayed idle can spend them in a burst. A zero Requests disables the limit.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (rl RateLimit) Enabled() bool {
+	return rl.Requests > 0 && rl.Period > 0
+}
+
+// Interval is how long the bucket takes to refill one token.
+func (rl RateLimit) Interval() time.Duration {
+	return rl.Period / time.Duration(rl.Requests)
+}
+
+// BurstTolerance is how far ahead of now the bucket may be full and still hold a token.
+func (rl RateLimit) BurstTolerance() time.Duration {
+	return rl.Interval() * time.Duration(rl.Requests-1)
+}
+
+// RateLimitPolicy limits the calls of one operation by client IP and by authenticated user,
+// a call has to fit in both.
+type RateLimitPolicy struct {
+	PerIP   RateLimit
+	PerUser RateLimit
+}
+
+// RateLimits holds the policy of every operation, operations without their own policy use Default.
+type RateLimits struct {
+	Default    RateLimitPolicy
+	Operations map[string]RateLimitPolicy
+}
+
+func (rl RateLimits) For(operation string) RateLimitPolicy {
+	if policy, ok := rl.Operations[operation]; ok {
+		return policy
+	}
+	return rl.Default
+}
+
+// TokenBucket is the state of a RateLimit for one key. Instead of counting tokens it only keeps when
+// the bucket is full again, every token taken pushes FullAt one refill interval further.
+type TokenBucket struct {
+	FullAt time.Time
+}
+
+// Take takes a token from the bucket at now. When no token is left nothing is taken and it returns
+// how long until the next one, otherwise zero.
+func (b *TokenBucket) Take(limit RateLimit, now time.Time) time.Duration {
+	interval := limit.Interval()
+	fullAt := b.FullAt
+	if fullAt.Before(now) {
+		fullAt = now
+	}
+
+	if wait := fullAt.Sub(now) - limit.BurstTolerance(); wait > 0 {
+		return wait
+	}
+	b.FullAt = fullAt.Add(interval)
+	return 0
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// RefreshToken is an opaque, single-use token that can be exchanged for a new access token.
+// Every rotation stays in the same family, so replaying an already used token lets us
+// revoke the whole chain issued from the original login.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	Token     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (rt RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(rt.ExpiresAt)
+}
+
+func (rt RefreshToken) IsUsed() bool {
+	return rt.UsedAt != nil
+}
+
+func (rt RefreshToken) IsRevoked() bool {
+	return rt.RevokedAt != nil
+}
@@ -0,0 +1,49 @@
+package entity
+
+// Role decides what a user is allowed to do besides using their own account.
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// Permission is checked by the authorization middleware before an operation runs.
+type Permission string
+
+const (
+	// PermissionModerateUsers allows acting on the accounts and content of other users.
+	PermissionModerateUsers Permission = "users:moderate"
+	// PermissionManageRoles allows granting and revoking roles.
+	PermissionManageRoles Permission = "roles:manage"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleUser:      {},
+	RoleModerator: {PermissionModerateUsers},
+	RoleAdmin:     {PermissionModerateUsers, PermissionManageRoles},
+}
+
+// RoleFromString returns the role named value, ok is false for unknown roles.
+func RoleFromString(value string) (role Role, ok bool) {
+	role = Role(value)
+	_, ok = rolePermissions[role]
+	return role, ok
+}
+
+func (r Role) Can(permission Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Role) String() string {
+	if r == "" {
+		return string(RoleUser)
+	}
+	return string(r)
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// Session is a device the user is signed in on. It is started by every login and shares its ID
+// with the refresh token family, so it lives as long as the family keeps being refreshed.
+type Session struct {
+	ID         string
+	UserID     int64
+	DeviceName string
+	UserAgent  string
+	IPAddress  string
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (s Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+func (s Session) IsActive(now time.Time) bool {
+	return !s.IsRevoked() && now.Before(s.ExpiresAt)
+}
+
+// AccessTokenSubject is what an access token is issued for.
+type AccessTokenSubject struct {
+	User      *User
+	SessionID string
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "net"
+
+// TrustedProxies are the networks of the reverse proxies in front of the service,
+// only they are believed when they forward the address of the client in a header.
+type TrustedProxies []*net.IPNet
+
+// Contains reports whether ip belongs to one of the trusted proxies, a nil ip never does.
+func (tp TrustedProxies) Contains(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range tp {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -24,13 +24,8 @@ const (
 	usernameMaxLen = 50
 )
 
-type Gender string
-
-const (
-	genderUnknown Gender = ""
-	genderMale    Gender = "male"
-	genderFemale  Gender = "female"
-)
+// e164Pattern matches a phone number in E.164 format, a plus sign followed by up to 15 digits.
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
 
 type User struct {
 	ID          int64
@@ -38,18 +33,31 @@ type User struct {
 	Username    string
 	PhoneNumber string
 	Gender      Gender
+	// ShowGender tells whether other users can see Gender.
+	ShowGender  bool
+	Orientation Orientation
+	Role        Role
 	Password    string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// PhoneVerifiedAt is nil until the phone number is confirmed with a one-time password.
+	PhoneVerifiedAt *time.Time
+	// TOTPSecret is set on enrollment, two-factor authentication only applies once TOTPEnabledAt is set.
+	TOTPSecret    string
+	TOTPEnabledAt *time.Time
+	// DeletedAt is set when the owner deletes the account, it can be restored until the grace period ends.
+	DeletedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-func NewUser(param *request.CreateUser) (*User, error) {
+// NewUser validates a signup, the gender has to be one of the identities offered by policy.
+func NewUser(param *request.CreateUser, policy *UserPolicy) (*User, error) {
 	user := &User{
 		Name:        param.Name,
 		PhoneNumber: param.PhoneNumber,
 		Password:    param.Password,
 		Username:    strings.ToLower(param.Username),
-		Gender:      genderUnknown.fromString(param.Gender),
+		ShowGender:  param.ShowGender == nil || *param.ShowGender,
+		Role:        RoleUser,
 	}
 
 	validationError := customerror.NewValidationError()
@@ -57,8 +65,12 @@ func NewUser(param *request.CreateUser) (*User, error) {
 		validationError.Merge(err)
 	}
 
-	if user.Gender == genderUnknown {
-		validationError.AddError("gender", "can only male and female")
+	if err := user.changeGender(param.Gender, policy); err != nil {
+		validationError.Merge(err)
+	}
+
+	if err := user.changeOrientation(param.Orientation); err != nil {
+		validationError.Merge(err)
 	}
 
 	if err := user.validatePhoneNumber(); err != nil {
@@ -80,6 +92,101 @@ func NewUser(param *request.CreateUser) (*User, error) {
 	return user, nil
 }
 
+// IsPhoneNumber reports whether a login identifier is a phone number rather than a username.
+// Usernames cannot contain '+' so the two never overlap.
+func IsPhoneNumber(identifier string) bool {
+	return e164Pattern.MatchString(identifier)
+}
+
+// ChangePassword replaces the password after checking the same rules as NewUser,
+// the caller is responsible for encrypting it before storing.
+func (user *User) ChangePassword(password string) error {
+	changed := *user
+	changed.Password = password
+	if err := changed.validatePassword(); err != nil {
+		return err
+	}
+
+	user.Password = password
+	return nil
+}
+
+func (user User) IsPhoneVerified() bool {
+	return user.PhoneVerifiedAt != nil
+}
+
+func (user User) IsTOTPEnabled() bool {
+	return user.TOTPSecret != "" && user.TOTPEnabledAt != nil
+}
+
+// ChangeRole grants role to the user, replacing the previous one.
+func (user *User) ChangeRole(value string) error {
+	role, ok := RoleFromString(value)
+	if !ok {
+		return customerror.NewValidationErrorWithMessage("role", "can only be "+choices([]Role{RoleUser, RoleModerator, RoleAdmin}))
+	}
+
+	user.Role = role
+	return nil
+}
+
+// UpdateIdentity applies the fields of param that are set, the user is left unchanged when one is not valid.
+func (user *User) UpdateIdentity(param *request.UpdateIdentity, policy *UserPolicy) error {
+	updated := *user
+	validationError := customerror.NewValidationError()
+
+	if param.Gender != nil {
+		validationError.Merge(updated.changeGender(*param.Gender, policy))
+	}
+	if param.ShowGender != nil {
+		updated.ShowGender = *param.ShowGender
+	}
+	if param.Orientation != nil {
+		validationError.Merge(updated.changeOrientation(*param.Orientation))
+	}
+	if validationError.HasError() {
+		return validationError
+	}
+
+	*user = updated
+	return nil
+}
+
+func (user User) IsDeleted() bool {
+	return user.DeletedAt != nil
+}
+
+// PurgeAt is when a deleted account stops being restorable and is removed for good.
+func (user User) PurgeAt(gracePeriod time.Duration) time.Time {
+	if user.DeletedAt == nil {
+		return time.Time{}
+	}
+	return user.DeletedAt.Add(gracePeriod)
+}
+
+// CanRestore reports whether a deleted account is still within its grace period.
+func (user User) CanRestore(now time.Time, gracePeriod time.Duration) bool {
+	return user.IsDeleted() && now.Before(user.PurgeAt(gracePeriod))
+}
+
+func (user *User) changeGender(value string, policy *UserPolicy) error {
+	gender, ok := policy.genderFromString(value)
+	if !ok {
+		return customerror.NewValidationErrorWithMessage("gender", "can only be "+choices(policy.OfferedGenders()))
+	}
+	user.Gender = gender
+	return nil
+}
+
+func (user *User) changeOrientation(value string) error {
+	orientation, ok := OrientationFromString(value)
+	if !ok {
+		return customerror.NewValidationErrorWithMessage("orientation", "can only be "+choices(orientations))
+	}
+	user.Orientation = orientation
+	return nil
+}
+
 func (user User) validateUsername() error {
 	validationError := customerror.NewValidationError()
 
@@ -171,22 +278,3 @@ func strongPassword(password string) bool {
 
 	return hasCapital && hasLowercase && hasNumber && hasSpecialChar
 }
-
-func (g Gender) fromString(value string) Gender {
-	lowerValue := strings.ToLower(value)
-	if lowerValue == "male" {
-		return genderMale
-	} else if lowerValue == "female" {
-		return genderFemale
-	}
-	return genderUnknown
-}
-
-func (g Gender) String() string {
-	if g == genderMale {
-		return "male"
-	} else if g == genderFemale {
-		return "female"
-	}
-	return ""
-}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// UserClaims is the authenticated caller extracted from a verified access token.
+type UserClaims struct {
+	UserID  int64
+	TokenID string
+	// SessionID is empty for tokens issued before sessions were recorded.
+	SessionID string
+	// Roles is empty for tokens issued before roles existed, they are treated as RoleUser.
+	Roles     []Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// HasPermission reports whether one of the roles of the caller grants permission.
+func (claims UserClaims) HasPermission(permission Permission) bool {
+	for _, role := range claims.Roles {
+		if role.Can(permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// UserPolicy holds the configurable account rules applied by the user usecases.
+type UserPolicy struct {
+	// RequireVerifiedPhone refuses to issue tokens until the phone number is verified.
+	RequireVerifiedPhone bool
+	// ConcealSignupConflicts answers a signup with a taken username or phone number as if it succeeded.
+	ConcealSignupConflicts bool
+	// MFAChallengeTTL and MFAMaxAttempts bound how long and how often a two-factor login can be completed.
+	MFAChallengeTTL time.Duration
+	MFAMaxAttempts  int
+	// PasswordHistorySize is how many of the latest passwords, the current one included, cannot be reused.
+	PasswordHistorySize int
+	// DeletionGracePeriod is how long a deleted account can be restored by logging in before it is purged.
+	DeletionGracePeriod time.Duration
+	// DataExportTTL is how long the download link of a data export stays valid.
+	DataExportTTL time.Duration
+	// Genders are the gender identities users can pick, in the order they are offered. All of Genders when empty.
+	Genders []Gender
+}
+
+// OfferedGenders returns the gender identities users can pick.
+func (policy UserPolicy) OfferedGenders() []Gender {
+	if len(policy.Genders) == 0 {
+		return Genders
+	}
+	return policy.Genders
+}
+
+// OffersGender reports whether gender is one of the identities users can pick.
+func (policy UserPolicy) OffersGender(gender Gender) bool {
+	for _, offered := range policy.OfferedGenders() {
+		if offered == gender {
+			return true
+		}
+	}
+	return false
+}
+
+// genderFromString parses one of the offered gender identities.
+func (policy UserPolicy) genderFromString(value string) (Gender, bool) {
+	gender, ok := GenderFromString(value)
+	return gender, ok && policy.OffersGender(gender)
+}
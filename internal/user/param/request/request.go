@@ -6,9 +6,102 @@ type CreateUser struct {
 	Name        string
 	Password    string
 	Gender      string
+	// ShowGender defaults to true when nil.
+	ShowGender  *bool
+	Orientation string
+}
+
+type UpdateIdentity struct {
+	Gender     *string
+	ShowGender *bool
+	// Orientation is removed when it points to an empty string.
+	Orientation *string
 }
 
 type GenerateUserToken struct {
-	Username string
+	// Identifier is either the username or the phone number in E.164 format.
+	Identifier string
+	Password   string
+	ClientIP   string
+	// DeviceName is chosen by the client, e.g. "Pixel 8", to tell sessions apart.
+	DeviceName string
+	UserAgent  string
+}
+
+type SendPhoneVerification struct {
+	PhoneNumber string
+}
+
+type VerifyPhoneNumber struct {
+	PhoneNumber string
+	Code        string
+}
+
+type RequestPasswordReset struct {
+	// Identifier is either the username or the phone number in E.164 format.
+	Identifier string
+}
+
+type VerifyPasswordReset struct {
+	Identifier string
+	Code       string
+}
+
+type ResetPassword struct {
+	Ticket   string
 	Password string
 }
+
+type ConfirmTOTP struct {
+	Code string
+}
+
+type CompleteMFALogin struct {
+	ChallengeID string
+	// Code is either the current TOTP code or one of the recovery codes.
+	Code       string
+	ClientIP   string
+	DeviceName string
+	UserAgent  string
+}
+
+type ChangePassword struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
+type RefreshUserToken struct {
+	RefreshToken string
+	ClientIP     string
+	UserAgent    string
+}
+
+type RevokeSession struct {
+	SessionID string
+}
+
+type Logout struct {
+	RefreshToken string
+}
+
+type DownloadDataExport struct {
+	ExportID string
+}
+
+type ChangeUserRole struct {
+	UserID int64
+	Role   string
+}
+
+type UpdatePreferences struct {
+	// InterestedIn replaces the genders when it is not nil, it cannot be emptied.
+	InterestedIn  []string
+	MinAge        *int
+	MaxAge        *int
+	MaxDistanceKM *int
+}
+
+type UpdateLocation struct {
+	Latitude  float64
+	Longitude float64
+}
@@ -1,7 +1,108 @@
 package response
 
+import (
+	"io"
+	"time"
+)
+
+// User is the public representation of entity.User, it never carries the password hash or 2FA secrets.
+type User struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Username         string    `json:"username"`
+	PhoneNumber      string    `json:"phone_number"`
+	Gender           string    `json:"gender"`
+	ShowGender       bool      `json:"show_gender"`
+	Orientation      string    `json:"orientation"`
+	Role             string    `json:"role"`
+	PhoneVerified    bool      `json:"phone_verified"`
+	TwoFactorEnabled bool      `json:"two_factor_enabled"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type Preferences struct {
+	InterestedIn  []string  `json:"interested_in"`
+	MinAge        int       `json:"min_age"`
+	MaxAge        int       `json:"max_age"`
+	MaxDistanceKM int       `json:"max_distance_km"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Location struct {
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Geohash   string    `json:"geohash"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Token struct {
-	Token     string
+	Token            string
+	ExpiresIn        int
+	Type             string
+	RefreshToken     string
+	RefreshExpiresIn int
+	// MFAChallengeID is set instead of the tokens when the login still needs a second factor.
+	MFAChallengeID        string
+	MFAChallengeExpiresIn int
+}
+
+type Session struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	// Current is true for the session of the token used for the request.
+	Current bool `json:"current"`
+}
+
+type LoginInformation struct {
+	SuccessLoginCount int        `json:"success_login_count"`
+	LastLoginAt       *time.Time `json:"last_login_at"`
+}
+
+type DataExport struct {
+	ID        string
+	ExpiresAt time.Time
+}
+
+// DataExportFile is the archive of a data export, the caller closes Content.
+type DataExportFile struct {
+	Name    string
+	Content io.ReadCloser
+}
+
+// AccountDeletion tells when a deleted account is purged, logging in before then restores it.
+type AccountDeletion struct {
+	PurgeAt time.Time
+}
+
+type PasswordResetTicket struct {
+	Ticket    string
 	ExpiresIn int
-	Type      string
+}
+
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+type TOTPRecoveryCodes struct {
+	Codes []string
+}
+
+// JSONWebKey is an RSA public key as described in RFC 7517.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
 }
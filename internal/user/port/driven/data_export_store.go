@@ -0,0 +1,17 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"time"
+)
+
+type DataExportStore interface {
+	// Create stores the export and fills its random ID.
+	Create(ctx context.Context, export *entity.DataExport) error
+	GetByID(ctx context.Context, id string) (*entity.DataExport, error)
+	// ListExpired returns up to limit exports that expired before expiredBefore, the oldest first.
+	ListExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]*entity.DataExport, error)
+	// Delete removes the exports of ids, it returns how many were removed.
+	Delete(ctx context.Context, ids []string) (int64, error)
+}
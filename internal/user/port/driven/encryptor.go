@@ -1,6 +1,8 @@
 package driven
 
 type Encyptor interface {
-	Encrypt(data []byte, cost int) ([]byte, error)
+	Encrypt(data []byte) ([]byte, error)
 	CompareEncryptedAndData(encrypted, data []byte) error
+	// NeedsRehash reports whether encrypted was produced with another algorithm or cost than the current one.
+	NeedsRehash(encrypted []byte) bool
 }
@@ -0,0 +1,16 @@
+package driven
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage keeps files by key, keys are slash separated paths such as "exports/1/archive.zip".
+type FileStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Open returns fs.ErrNotExist when no file is stored under key, the caller closes the reader.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every file whose key starts with prefix, prefix has to end with a slash.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
@@ -0,0 +1,17 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"time"
+)
+
+type IdempotencyStore interface {
+	// Reserve saves record unless an unexpired record already holds its key, that record is returned then
+	// and nil otherwise.
+	Reserve(ctx context.Context, record *entity.IdempotencyRecord) (*entity.IdempotencyRecord, error)
+	// Complete attaches the response to the record of key and keeps it until expiresAt.
+	Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error
+	// Release deletes the record of key so the request can be sent again.
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,16 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type LocationStore interface {
+	// GetByUserID returns sql.ErrNoRows when the user never shared a location.
+	GetByUserID(ctx context.Context, userID int64) (*entity.Location, error)
+	// Save creates or replaces the location of location.UserID.
+	Save(ctx context.Context, location *entity.Location) error
+	// ListNearby returns up to limit locations of other users within radiusKM of center, the closest first,
+	// deleted accounts are left out.
+	ListNearby(ctx context.Context, center *entity.Location, radiusKM float64, limit int) ([]*entity.Location, error)
+}
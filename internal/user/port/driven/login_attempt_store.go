@@ -0,0 +1,16 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"time"
+)
+
+type LoginAttemptStore interface {
+	// Get returns an attempt with zero FailedCount when the key never failed.
+	Get(ctx context.Context, key string) (*entity.LoginAttempt, error)
+	// IncrementFailure atomically counts a failure of key, restarting from one when the previous
+	// failure is older than window.
+	IncrementFailure(ctx context.Context, key string, window time.Duration) (*entity.LoginAttempt, error)
+	Reset(ctx context.Context, keys ...string) error
+}
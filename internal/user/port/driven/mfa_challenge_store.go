@@ -0,0 +1,15 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type MFAChallengeStore interface {
+	// Create stores the challenge and fills its random ID.
+	Create(ctx context.Context, challenge *entity.MFAChallenge) error
+	GetByID(ctx context.Context, id string) (*entity.MFAChallenge, error)
+	IncrementAttempts(ctx context.Context, id string) error
+	// Consume flags the challenge as completed and reports false when it was already completed.
+	Consume(ctx context.Context, id string) (bool, error)
+}
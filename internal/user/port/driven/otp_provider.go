@@ -0,0 +1,8 @@
+package driven
+
+import "app/internal/user/entity"
+
+type OTPProvider interface {
+	Generate(userID int64, purpose entity.OTPPurpose) (*entity.OneTimePassword, error)
+	Hash(code string) string
+}
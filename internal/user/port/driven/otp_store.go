@@ -0,0 +1,15 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type OTPStore interface {
+	Create(ctx context.Context, otp *entity.OneTimePassword) error
+	// GetLatest returns the most recently created code of userID for purpose, older codes are superseded.
+	GetLatest(ctx context.Context, userID int64, purpose entity.OTPPurpose) (*entity.OneTimePassword, error)
+	IncrementAttempts(ctx context.Context, id int64) error
+	// Consume flags the code as consumed and reports false when it was already consumed.
+	Consume(ctx context.Context, id int64) (bool, error)
+}
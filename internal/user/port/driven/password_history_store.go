@@ -0,0 +1,10 @@
+package driven
+
+import "context"
+
+type PasswordHistoryStore interface {
+	// Add records the hash of a replaced password and forgets all but the keep most recent ones of userID.
+	Add(ctx context.Context, userID int64, passwordHash string, keep int) error
+	// ListRecent returns up to limit hashes of replaced passwords, most recent first.
+	ListRecent(ctx context.Context, userID int64, limit int) ([]string, error)
+}
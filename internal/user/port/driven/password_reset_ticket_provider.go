@@ -0,0 +1,8 @@
+package driven
+
+import "app/internal/user/entity"
+
+type PasswordResetTicketProvider interface {
+	Generate(userID int64) (*entity.PasswordResetTicket, error)
+	Hash(ticket string) string
+}
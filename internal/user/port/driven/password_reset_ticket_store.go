@@ -0,0 +1,13 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type PasswordResetTicketStore interface {
+	Create(ctx context.Context, ticket *entity.PasswordResetTicket) error
+	GetByHash(ctx context.Context, ticketHash string) (*entity.PasswordResetTicket, error)
+	// MarkUsed flags the ticket as used and reports false when it was already used.
+	MarkUsed(ctx context.Context, id int64) (bool, error)
+}
@@ -0,0 +1,13 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type PreferencesStore interface {
+	// GetByUserID returns sql.ErrNoRows when the user has no preferences stored.
+	GetByUserID(ctx context.Context, userID int64) (*entity.Preferences, error)
+	// Save creates or replaces the preferences of preferences.UserID.
+	Save(ctx context.Context, preferences *entity.Preferences) error
+}
@@ -0,0 +1,13 @@
+package driven
+
+import (
+	"archive/zip"
+	"context"
+)
+
+// ProfileDataExporter adds what the profile domain stores about a user to their data export.
+type ProfileDataExporter interface {
+	// ExportProfileData writes the records and photos of userID into archive,
+	// the records the user never created are left out.
+	ExportProfileData(ctx context.Context, userID int64, archive *zip.Writer) error
+}
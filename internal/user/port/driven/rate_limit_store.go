@@ -0,0 +1,13 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"time"
+)
+
+type RateLimitStore interface {
+	// Take atomically takes a token from the bucket of key, it returns how long to wait for the next
+	// token when the bucket is empty and zero when the call is allowed.
+	Take(ctx context.Context, key string, limit entity.RateLimit) (time.Duration, error)
+}
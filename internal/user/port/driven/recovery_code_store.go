@@ -0,0 +1,10 @@
+package driven
+
+import "context"
+
+type RecoveryCodeStore interface {
+	// Replace discards every recovery code of userID and stores codeHashes instead.
+	Replace(ctx context.Context, userID int64, codeHashes []string) error
+	// Consume marks the code as used and reports false when it does not exist or was already used.
+	Consume(ctx context.Context, userID int64, codeHash string) (bool, error)
+}
@@ -0,0 +1,9 @@
+package driven
+
+import "app/internal/user/entity"
+
+type RefreshTokenProvider interface {
+	// Generate creates a new refresh token in familyID, an empty familyID starts a new family.
+	Generate(userID int64, familyID string) (*entity.RefreshToken, error)
+	Hash(token string) string
+}
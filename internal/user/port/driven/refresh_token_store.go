@@ -0,0 +1,15 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type RefreshTokenStore interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	// MarkUsed flags the token as used and reports false when it was already used.
+	MarkUsed(ctx context.Context, id int64) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeByUserID(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,17 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+)
+
+type SessionStore interface {
+	Create(ctx context.Context, session *entity.Session) error
+	GetByID(ctx context.Context, id string) (*entity.Session, error)
+	// ListActiveByUserID returns the sessions that are neither revoked nor expired, most recently seen first.
+	ListActiveByUserID(ctx context.Context, userID int64) ([]*entity.Session, error)
+	// Touch records the client address, user agent and expiry of the latest refresh as seen now.
+	Touch(ctx context.Context, session *entity.Session) error
+	Revoke(ctx context.Context, id string) error
+	RevokeByUserID(ctx context.Context, userID int64) error
+}
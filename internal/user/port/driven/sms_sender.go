@@ -0,0 +1,7 @@
+package driven
+
+import "context"
+
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
@@ -0,0 +1,8 @@
+package driven
+
+import "app/internal/user/param/response"
+
+type TokenKeySet interface {
+	// KeySet returns every public key that can verify the issued tokens.
+	KeySet() *response.JSONWebKeySet
+}
@@ -0,0 +1,19 @@
+package driven
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"time"
+)
+
+type TokenRevocationStore interface {
+	// Revoke rejects the token with tokenID until expiresAt, after that the token is expired anyway.
+	// Passing a session ID instead rejects every token issued in that session.
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// RevokeUser rejects every token of userID issued before issuedBefore.
+	// Token issue times only have a precision of seconds, so issuedBefore is truncated to the second
+	// and tokens issued within that second, e.g. by a login right after the revocation, stay valid.
+	// Revoking their sessions as well rejects the ones issued before the revocation.
+	RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time) error
+	IsRevoked(ctx context.Context, claims *entity.UserClaims) (bool, error)
+}
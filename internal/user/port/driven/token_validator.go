@@ -0,0 +1,5 @@
+package driven
+
+type TokenValidator[T any] interface {
+	Validate(token string) (T, error)
+}
@@ -0,0 +1,12 @@
+package driven
+
+import "time"
+
+type TwoFactorProvider interface {
+	GenerateSecret() (string, error)
+	// URI builds the otpauth:// URI rendered as a QR code by authenticator apps.
+	URI(secret, accountName string) string
+	Validate(secret, code string, now time.Time) bool
+	GenerateRecoveryCodes() ([]string, error)
+	HashRecoveryCode(code string) string
+}
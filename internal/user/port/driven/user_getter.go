@@ -5,6 +5,13 @@ import (
 	"context"
 )
 
+// UserGetter hides deleted accounts, only the GetDeleted lookups return them.
 type UserGetter interface {
+	GetByID(ctx context.Context, id int64) (*entity.User, error)
 	GetByUsername(ctx context.Context, username string) (*entity.User, error)
+	GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error)
+	GetDeletedByID(ctx context.Context, id int64) (*entity.User, error)
+	GetDeletedByUsername(ctx context.Context, username string) (*entity.User, error)
+	GetDeletedByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error)
+	GetLoginInformation(ctx context.Context, userID int64) (*entity.LoginInformation, error)
 }
@@ -0,0 +1,14 @@
+package driven
+
+import (
+	"context"
+	"time"
+)
+
+type UserPurger interface {
+	// ListPurgeable returns the IDs of up to limit users deleted before deletedBefore, oldest deletion first.
+	ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error)
+	// Purge permanently removes the users of userIDs that are still deleted before deletedBefore together with
+	// every row that belongs to them, it returns how many users were removed.
+	Purge(ctx context.Context, userIDs []int64, deletedBefore time.Time) (int64, error)
+}
@@ -6,6 +6,17 @@ import (
 )
 
 type UserWriter interface {
-	Create(ctx context.Context, user *entity.User) (id int64, err error)
+	// Create stores user and, unless nil, its preferences in one transaction, the UserID of preferences is set
+	// to the new id.
+	Create(ctx context.Context, user *entity.User, preferences *entity.Preferences) (id int64, err error)
 	UpdateLoginInformation(ctx context.Context, user *entity.User) error
+	UpdatePassword(ctx context.Context, user *entity.User) error
+	MarkPhoneVerified(ctx context.Context, user *entity.User) error
+	UpdateTOTP(ctx context.Context, user *entity.User) error
+	UpdateRole(ctx context.Context, user *entity.User) error
+	// UpdateIdentity stores the gender, show gender flag and orientation of user.
+	UpdateIdentity(ctx context.Context, user *entity.User) error
+	// SoftDelete sets DeletedAt of user, Restore clears it.
+	SoftDelete(ctx context.Context, user *entity.User) error
+	Restore(ctx context.Context, user *entity.User) error
 }
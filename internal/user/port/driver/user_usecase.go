@@ -6,7 +6,48 @@ import (
 	"context"
 )
 
+type UserReaderUsecase interface {
+	GetMe(ctx context.Context) (*response.User, error)
+}
+
 type UserWriterUsecase interface {
 	CreateUser(ctx context.Context, params *request.CreateUser) (id int64, err error)
 	GenerateUserToken(ctx context.Context, params *request.GenerateUserToken) (*response.Token, error)
+	RefreshUserToken(ctx context.Context, params *request.RefreshUserToken) (*response.Token, error)
+	Logout(ctx context.Context, params *request.Logout) error
+	LogoutAll(ctx context.Context) error
+	SendPhoneVerification(ctx context.Context, params *request.SendPhoneVerification) error
+	VerifyPhoneNumber(ctx context.Context, params *request.VerifyPhoneNumber) error
+	RequestPasswordReset(ctx context.Context, params *request.RequestPasswordReset) error
+	VerifyPasswordReset(ctx context.Context, params *request.VerifyPasswordReset) (*response.PasswordResetTicket, error)
+	ResetPassword(ctx context.Context, params *request.ResetPassword) error
+	ChangePassword(ctx context.Context, params *request.ChangePassword) error
+	EnrollTOTP(ctx context.Context) (*response.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, params *request.ConfirmTOTP) (*response.TOTPRecoveryCodes, error)
+	CompleteMFALogin(ctx context.Context, params *request.CompleteMFALogin) (*response.Token, error)
+	ListSessions(ctx context.Context) ([]*response.Session, error)
+	RevokeSession(ctx context.Context, params *request.RevokeSession) error
+	DeleteAccount(ctx context.Context) (*response.AccountDeletion, error)
+	ChangeUserRole(ctx context.Context, params *request.ChangeUserRole) error
+	UpdateMyIdentity(ctx context.Context, params *request.UpdateIdentity) (*response.User, error)
+}
+
+type DataExportUsecase interface {
+	RequestDataExport(ctx context.Context) (*response.DataExport, error)
+	DownloadDataExport(ctx context.Context, params *request.DownloadDataExport) (*response.DataExportFile, error)
+}
+
+type PreferencesUsecase interface {
+	GetMyPreferences(ctx context.Context) (*response.Preferences, error)
+	UpdateMyPreferences(ctx context.Context, params *request.UpdatePreferences) (*response.Preferences, error)
+}
+
+type LocationUsecase interface {
+	GetMyLocation(ctx context.Context) (*response.Location, error)
+	UpdateMyLocation(ctx context.Context, params *request.UpdateLocation) (*response.Location, error)
+}
+
+type UserPurgeUsecase interface {
+	PurgeDeletedUsers(ctx context.Context) (int64, error)
+	PurgeExpiredDataExports(ctx context.Context) (int64, error)
 }
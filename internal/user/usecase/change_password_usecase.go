@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"context"
+	"fmt"
+)
+
+// ChangePassword replaces the password of the authenticated user once the current one is confirmed,
+// every other session is signed out while the one used for the request stays signed in.
+func (uu UserWriterUsecase) ChangePassword(ctx context.Context, params *request.ChangePassword) error {
+	claims, ok := authcontext.ClaimsFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = uu.encryptor.CompareEncryptedAndData([]byte(user.Password), []byte(params.CurrentPassword))
+	if err != nil {
+		return customerror.NewValidationErrorWithMessage("current_password", "wrong password")
+	}
+
+	err = uu.validateNewPassword(ctx, user, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = uu.storeNewPassword(ctx, user, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	return uu.revokeOtherSessions(ctx, claims)
+}
+
+// validateNewPassword applies the password rules of entity.User and refuses the recently used passwords.
+func (uu UserWriterUsecase) validateNewPassword(ctx context.Context, user *entity.User, password string) error {
+	changed := *user
+	err := changed.ChangePassword(password)
+	if err != nil {
+		return err
+	}
+
+	if uu.userPolicy.PasswordHistorySize <= 0 {
+		return nil
+	}
+
+	hashes, err := uu.passwordHistoryStore.ListRecent(ctx, user.ID, uu.userPolicy.PasswordHistorySize-1)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range append([]string{user.Password}, hashes...) {
+		if uu.encryptor.CompareEncryptedAndData([]byte(hash), []byte(password)) == nil {
+			return customerror.NewValidationErrorWithMessage("password",
+				fmt.Sprintf("must differ from your last %d passwords", uu.userPolicy.PasswordHistorySize))
+		}
+	}
+	return nil
+}
+
+// storeNewPassword saves the hash of password and keeps the replaced hash in the password history.
+func (uu UserWriterUsecase) storeNewPassword(ctx context.Context, user *entity.User, password string) error {
+	encryptedPassword, err := uu.encryptor.Encrypt([]byte(password))
+	if err != nil {
+		return err
+	}
+
+	previousPassword := user.Password
+	updated := *user
+	updated.Password = string(encryptedPassword)
+	err = uu.userWriter.UpdatePassword(ctx, &updated)
+	if err != nil {
+		return err
+	}
+
+	if uu.userPolicy.PasswordHistorySize > 1 {
+		err = uu.passwordHistoryStore.Add(ctx, user.ID, previousPassword, uu.userPolicy.PasswordHistorySize-1)
+		if err != nil {
+			return err
+		}
+	}
+
+	user.Password = updated.Password
+	return nil
+}
+
+// revokeOtherSessions ends every session of the user except the one of claims,
+// tokens that do not belong to a session cannot be told apart so every session is revoked then.
+func (uu UserWriterUsecase) revokeOtherSessions(ctx context.Context, claims *entity.UserClaims) error {
+	if claims.SessionID == "" {
+		return uu.revokeAllSessions(ctx, claims.UserID)
+	}
+
+	sessions, err := uu.sessionStore.ListActiveByUserID(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == claims.SessionID {
+			continue
+		}
+		if err := uu.endSession(ctx, session.ID, session.ExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
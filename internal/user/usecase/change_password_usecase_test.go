@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_ChangePassword(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	sessionStore := fake.NewFakeSessionStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		sessionStore,
+		fake.NewFakePasswordHistoryStore(),
+		&entity.UserPolicy{PasswordHistorySize: 3},
+	)
+
+	password := "Old-Passw0rd"
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	login := func(password string) (string, error) {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   password,
+		})
+		if err != nil {
+			return "", err
+		}
+		return token.RefreshToken, nil
+	}
+	currentRefreshToken, err := login(password)
+	assert.NoError(err)
+	otherRefreshToken, err := login(password)
+	assert.NoError(err)
+
+	sessions, err := sessionStore.ListActiveByUserID(context.Background(), user.ID)
+	assert.NoError(err)
+	assert.Len(sessions, 2)
+	// the fake refresh token provider names the family of the first login family-1
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID, SessionID: "family-1"})
+
+	changePassword := func(newPassword string) error {
+		err := uu.ChangePassword(ctx, &request.ChangePassword{CurrentPassword: password, NewPassword: newPassword})
+		if err == nil {
+			password = newPassword
+		}
+		return err
+	}
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		err := uu.ChangePassword(context.Background(), &request.ChangePassword{CurrentPassword: password, NewPassword: "New-Passw0rd"})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when current password is wrong, it should return validation error", func(t *testing.T) {
+		err := uu.ChangePassword(ctx, &request.ChangePassword{CurrentPassword: "Wrong-Passw0rd", NewPassword: "New-Passw0rd"})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.Contains(err.Error(), "current_password")
+	})
+
+	t.Run("when new password is weak, it should return validation error", func(t *testing.T) {
+		err := changePassword("weak")
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when new password is the current one, it should return validation error", func(t *testing.T) {
+		err := changePassword(password)
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when password changed, it should only keep the current session signed in", func(t *testing.T) {
+		err := changePassword("New-Passw0rd")
+		assert.NoError(err)
+
+		_, err = login("Old-Passw0rd")
+		assert.IsType(new(customerror.ValidationError), err)
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: otherRefreshToken})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: currentRefreshToken})
+		assert.NoError(err)
+	})
+
+	t.Run("when password was used recently, it should refuse it until it leaves the history", func(t *testing.T) {
+		err := changePassword("Old-Passw0rd")
+		assert.IsType(new(customerror.ValidationError), err)
+
+		assert.NoError(changePassword("Third-Passw0rd"))
+		assert.NoError(changePassword("Fourth-Passw0rd"))
+		assert.NoError(changePassword("Old-Passw0rd"))
+
+		_, err = login("Old-Passw0rd")
+		assert.NoError(err)
+	})
+}
@@ -4,22 +4,64 @@ import (
 	"app/internal/user/entity"
 	"app/internal/user/param/request"
 	"context"
+	"database/sql"
+	"errors"
 )
 
-const (
-	costEncryption = 10
-)
-
+// CreateUser registers a new user. When the policy conceals signup conflicts, a taken username or phone number
+// is answered like a successful signup and the returned id is always 0, two signups racing for the same
+// identifier can still end in a unique violation.
 func (uu UserWriterUsecase) CreateUser(ctx context.Context, params *request.CreateUser) (id int64, err error) {
-	user, err := entity.NewUser(params)
+	user, err := entity.NewUser(params, uu.userPolicy)
 	if err != nil {
 		return id, err
 	}
 
-	encryptedPassword, err := uu.encryptor.Encrypt([]byte(user.Password), costEncryption)
+	// hashed before the lookup so a taken identifier costs as much as a new account
+	encryptedPassword, err := uu.encryptor.Encrypt([]byte(user.Password))
 	if err != nil {
 		return id, err
 	}
 	user.Password = string(encryptedPassword)
-	return uu.userWriter.Create(ctx, user)
+
+	if !uu.userPolicy.ConcealSignupConflicts {
+		return uu.createUser(ctx, user)
+	}
+
+	taken, err := uu.isIdentifierTaken(ctx, user)
+	if err != nil || taken {
+		return id, err
+	}
+	_, err = uu.createUser(ctx, user)
+	return id, err
+}
+
+// createUser stores user along with the default preferences derived from its gender and orientation.
+func (uu UserWriterUsecase) createUser(ctx context.Context, user *entity.User) (int64, error) {
+	return uu.userWriter.Create(ctx, user, entity.NewDefaultPreferences(user, uu.userPolicy))
+}
+
+// isIdentifierTaken reports whether the username or phone number of user belongs to another account,
+// accounts waiting to be purged still hold theirs.
+func (uu UserWriterUsecase) isIdentifierTaken(ctx context.Context, user *entity.User) (bool, error) {
+	lookups := []struct {
+		get        func(context.Context, string) (*entity.User, error)
+		identifier string
+	}{
+		{uu.userGetter.GetByUsername, user.Username},
+		{uu.userGetter.GetDeletedByUsername, user.Username},
+		{uu.userGetter.GetByPhoneNumber, user.PhoneNumber},
+		{uu.userGetter.GetDeletedByPhoneNumber, user.PhoneNumber},
+	}
+
+	for _, lookup := range lookups {
+		_, err := lookup.get(ctx, lookup.identifier)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+	}
+	return false, nil
 }
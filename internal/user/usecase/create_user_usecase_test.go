@@ -3,6 +3,8 @@ package usecase_test
 import (
 	"app/infra/encryption"
 	"app/internal/adapter/fake"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
 	"app/internal/user/param/request"
 	"app/internal/user/usecase"
 	"context"
@@ -38,7 +40,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "username: must be between 5 and 50 characters in length",
@@ -50,7 +52,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "username: must be between 5 and 50 characters in length",
@@ -62,7 +64,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "username: can only contain letter, numbers, underscores, and dashes",
@@ -77,7 +79,20 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				Gender:      "123",
 			}},
 			wantErr:    true,
-			wantErrMsg: "gender: can only male and female",
+			wantErrMsg: "gender: can only be woman or man or non_binary or genderqueer or genderfluid or agender or two_spirit",
+		},
+		{
+			name: "when orientation is unknown, it should return error",
+			args: args{context.Background(), &request.CreateUser{
+				Username:    "12adsas123_-",
+				PhoneNumber: "+628123123123",
+				Name:        faker.Name(),
+				Password:    generateRandomPassword(12),
+				Gender:      "woman",
+				Orientation: "robot",
+			}},
+			wantErr:    true,
+			wantErrMsg: "orientation: can only be straight or gay or lesbian or bisexual or pansexual or asexual or queer or questioning",
 		},
 		{
 			name: "when phoneNumber less than 10 character it should return error",
@@ -86,7 +101,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+62123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "phoneNumber: must be between 10 and 15 characters in length",
@@ -98,7 +113,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+621231231231233333",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "phoneNumber: must be between 10 and 15 characters in length",
@@ -110,7 +125,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "0812311231231",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "phoneNumber: must start with '+62' and only containt number",
@@ -122,7 +137,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+62abc3123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "phoneNumber: must start with '+62' and only containt number",
@@ -134,7 +149,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        generateRandomString(2, ""),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "name: must be between 3 and 255 characters in length",
@@ -146,7 +161,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        generateRandomString(256, ""),
 				Password:    generateRandomPassword(12),
-				Gender:      "male",
+				Gender:      "man",
 			}},
 			wantErr:    true,
 			wantErrMsg: "name: must be between 3 and 255 characters in length",
@@ -158,7 +173,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    "aA2.",
-				Gender:      "female",
+				Gender:      "woman",
 			}},
 			wantErr:    true,
 			wantErrMsg: "password: must be between 6 and 64 characters in length",
@@ -170,7 +185,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(65),
-				Gender:      "female",
+				Gender:      "woman",
 			}},
 			wantErr:    true,
 			wantErrMsg: "password: must be between 6 and 64 characters in length",
@@ -182,7 +197,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    "abcAdasd.D",
-				Gender:      "female",
+				Gender:      "woman",
 			}},
 			wantErr:    true,
 			wantErrMsg: "password: containing at least 1 capital characters AND 1 number AND 1 special (nonalpha-numeric) characters",
@@ -194,7 +209,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    "abc123.asd",
-				Gender:      "female",
+				Gender:      "woman",
 			}},
 			wantErr:    true,
 			wantErrMsg: "password: containing at least 1 capital characters AND 1 number AND 1 special (nonalpha-numeric) characters",
@@ -206,7 +221,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    "Asd123ASd",
-				Gender:      "female",
+				Gender:      "woman",
 			}},
 			wantErr:    true,
 			wantErrMsg: "password: containing at least 1 capital characters AND 1 number AND 1 special (nonalpha-numeric) characters",
@@ -221,7 +236,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				Gender:      "",
 			}},
 			wantErr:    true,
-			wantErrMsg: "username: must be between 5 and 50 characters in length,can only contain letter, numbers, underscores, and dashes;gender: can only male and female;phoneNumber: must be between 10 and 15 characters in length,must start with '+62' and only containt number;name: must be between 3 and 255 characters in length;password: must be between 6 and 64 characters in length,containing at least 1 capital characters AND 1 number AND 1 special (nonalpha-numeric) characters",
+			wantErrMsg: "username: must be between 5 and 50 characters in length,can only contain letter, numbers, underscores, and dashes;gender: can only be woman or man or non_binary or genderqueer or genderfluid or agender or two_spirit;phoneNumber: must be between 10 and 15 characters in length,must start with '+62' and only containt number;name: must be between 3 and 255 characters in length;password: must be between 6 and 64 characters in length,containing at least 1 capital characters AND 1 number AND 1 special (nonalpha-numeric) characters",
 		},
 		{
 			name: "when all field valid, it should return id and saved",
@@ -230,14 +245,14 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 				PhoneNumber: "+628123123123",
 				Name:        faker.Name(),
 				Password:    generateRandomPassword(12),
-				Gender:      "MALE",
+				Gender:      "MAN",
 			}},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uu := usecase.NewUserWriterUsecase(fakeUserDriven, bcrypt, fakeUserDriven, nil)
+			uu := usecase.NewUserWriterUsecase(fakeUserDriven, bcrypt, fakeUserDriven, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &entity.UserPolicy{})
 			gotID, err := uu.CreateUser(tt.args.ctx, tt.args.param)
 			assert := assert.New(t)
 			if tt.wantErr {
@@ -273,7 +288,7 @@ func sortErrorMessage(message1, message2 string) (string, string) {
 func TestCreateUser_withPasswordEncrypted(t *testing.T) {
 	fakeUserDriven := fake.NewFakeUserDriven()
 	bcrypt := new(encryption.BcryptEncryption)
-	uu := usecase.NewUserWriterUsecase(fakeUserDriven, bcrypt, fakeUserDriven, nil)
+	uu := usecase.NewUserWriterUsecase(fakeUserDriven, bcrypt, fakeUserDriven, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &entity.UserPolicy{})
 	assert := assert.New(t)
 
 	userParam := &request.CreateUser{
@@ -281,7 +296,7 @@ func TestCreateUser_withPasswordEncrypted(t *testing.T) {
 		PhoneNumber: "+628123123123",
 		Name:        faker.Name(),
 		Password:    generateRandomPassword(12),
-		Gender:      "FEMALE",
+		Gender:      "WOMAN",
 	}
 	gotID, err := uu.CreateUser(context.Background(), userParam)
 	assert.NoError(err)
@@ -293,6 +308,11 @@ func TestCreateUser_withPasswordEncrypted(t *testing.T) {
 
 	err = bcrypt.CompareEncryptedAndData([]byte(user.Password), []byte(userParam.Password))
 	assert.NoError(err)
+
+	preferences, ok := fakeUserDriven.PreferencesByUserID(gotID)
+	assert.True(ok, "it should store the default preferences along with the user")
+	assert.Equal([]entity.Gender{entity.GenderMan}, preferences.InterestedIn)
+	assert.Equal(entity.PreferencesMinAge, preferences.MinAge)
 }
 
 func generateRandomString(length int, charset string) string {
@@ -342,3 +362,144 @@ func strongPassword(password string) bool {
 
 	return hasCapital && hasLowercase && hasNumber && hasSpecialChar
 }
+
+func TestCreateUser_concealSignupConflicts(t *testing.T) {
+	fakeUserDriven := fake.NewFakeUserDriven()
+	bcrypt := new(encryption.BcryptEncryption)
+	uu := usecase.NewUserWriterUsecase(fakeUserDriven, bcrypt, fakeUserDriven, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &entity.UserPolicy{ConcealSignupConflicts: true})
+
+	existing := &request.CreateUser{
+		Username:    "ads123d-s123-_",
+		PhoneNumber: "+628123123123",
+		Name:        faker.Name(),
+		Password:    generateRandomPassword(12),
+		Gender:      "WOMAN",
+	}
+	gotID, err := uu.CreateUser(context.Background(), existing)
+	assert.NoError(t, err)
+	assert.Zero(t, gotID, "it should not return the id of the new account")
+
+	saved, err := fakeUserDriven.GetByUsername(context.Background(), existing.Username)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		param *request.CreateUser
+	}{
+		{
+			name: "when username is taken, it should answer like a new signup and keep the existing account",
+			param: &request.CreateUser{
+				Username:    strings.ToUpper(existing.Username),
+				PhoneNumber: "+628123123999",
+				Name:        faker.Name(),
+				Password:    generateRandomPassword(12),
+				Gender:      "MAN",
+			},
+		},
+		{
+			name: "when phone number is taken, it should answer like a new signup and keep the existing account",
+			param: &request.CreateUser{
+				Username:    "another-user",
+				PhoneNumber: existing.PhoneNumber,
+				Name:        faker.Name(),
+				Password:    generateRandomPassword(12),
+				Gender:      "MAN",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			gotID, err := uu.CreateUser(context.Background(), tt.param)
+			assert.NoError(err)
+			assert.Zero(gotID)
+
+			user, err := fakeUserDriven.GetByPhoneNumber(context.Background(), existing.PhoneNumber)
+			assert.NoError(err)
+			assert.Same(saved, user)
+			_, err = fakeUserDriven.GetByUsername(context.Background(), "another-user")
+			assert.Error(err)
+		})
+	}
+}
+
+func TestCreateUser_genderIdentity(t *testing.T) {
+	policy := &entity.UserPolicy{Genders: []entity.Gender{entity.GenderWoman, entity.GenderMan, entity.GenderNonBinary}}
+	hidden := false
+	tests := []struct {
+		name             string
+		gender           string
+		orientation      string
+		showGender       *bool
+		wantErrMsg       string
+		wantInterestedIn []entity.Gender
+	}{
+		{
+			name:       "when gender is known but not offered, it should return validation error",
+			gender:     "agender",
+			wantErrMsg: "gender: can only be woman or man or non_binary",
+		},
+		{
+			name:             "when a woman does not tell her orientation, it should default to men",
+			gender:           "woman",
+			wantInterestedIn: []entity.Gender{entity.GenderMan},
+		},
+		{
+			name:             "when a man is gay, it should default to men",
+			gender:           "man",
+			orientation:      "gay",
+			wantInterestedIn: []entity.Gender{entity.GenderMan},
+		},
+		{
+			name:             "when a non-binary user is lesbian, it should default to women",
+			gender:           "non_binary",
+			orientation:      "lesbian",
+			wantInterestedIn: []entity.Gender{entity.GenderWoman},
+		},
+		{
+			name:             "when a woman is bisexual, it should default to every offered gender",
+			gender:           "woman",
+			orientation:      "bisexual",
+			showGender:       &hidden,
+			wantInterestedIn: []entity.Gender{entity.GenderWoman, entity.GenderMan, entity.GenderNonBinary},
+		},
+		{
+			name:             "when a non-binary user does not tell their orientation, it should default to every offered gender",
+			gender:           "non_binary",
+			wantInterestedIn: []entity.Gender{entity.GenderWoman, entity.GenderMan, entity.GenderNonBinary},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserDriven := fake.NewFakeUserDriven()
+			uu := usecase.NewUserWriterUsecase(fakeUserDriven, new(encryption.BcryptEncryption), fakeUserDriven, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, policy)
+
+			gotID, err := uu.CreateUser(context.Background(), &request.CreateUser{
+				Username:    "identity",
+				PhoneNumber: "+628123123123",
+				Name:        faker.Name(),
+				Password:    generateRandomPassword(12),
+				Gender:      tt.gender,
+				ShowGender:  tt.showGender,
+				Orientation: tt.orientation,
+			})
+
+			assert := assert.New(t)
+			if tt.wantErrMsg != "" {
+				assert.IsType(new(customerror.ValidationError), err)
+				assert.EqualError(err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(err)
+			user, err := fakeUserDriven.GetByID(context.Background(), gotID)
+			assert.NoError(err)
+			assert.Equal(entity.Gender(tt.gender), user.Gender)
+			assert.Equal(entity.Orientation(tt.orientation), user.Orientation)
+			assert.Equal(tt.showGender == nil, user.ShowGender, "it should show the gender unless asked not to")
+
+			preferences, ok := fakeUserDriven.PreferencesByUserID(gotID)
+			assert.True(ok)
+			assert.Equal(tt.wantInterestedIn, preferences.InterestedIn)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driven"
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"time"
+)
+
+type DataExportUsecase struct {
+	userGetter       driven.UserGetter
+	sessionStore     driven.SessionStore
+	preferencesStore driven.PreferencesStore
+	locationStore    driven.LocationStore
+	profileExporter  driven.ProfileDataExporter
+	dataExportStore  driven.DataExportStore
+	fileStorage      driven.FileStorage
+	userPolicy       *entity.UserPolicy
+}
+
+func NewDataExportUsecase(
+	userGetter driven.UserGetter,
+	sessionStore driven.SessionStore,
+	preferencesStore driven.PreferencesStore,
+	locationStore driven.LocationStore,
+	profileExporter driven.ProfileDataExporter,
+	dataExportStore driven.DataExportStore,
+	fileStorage driven.FileStorage,
+	userPolicy *entity.UserPolicy,
+) *DataExportUsecase {
+	return &DataExportUsecase{
+		userGetter:       userGetter,
+		sessionStore:     sessionStore,
+		preferencesStore: preferencesStore,
+		locationStore:    locationStore,
+		profileExporter:  profileExporter,
+		dataExportStore:  dataExportStore,
+		fileStorage:      fileStorage,
+		userPolicy:       userPolicy,
+	}
+}
+
+// RequestDataExport builds a ZIP archive of the records and photos stored about the authenticated user,
+// it can be downloaded by the same user until it expires.
+func (de DataExportUsecase) RequestDataExport(ctx context.Context) (*response.DataExport, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	user, err := de.userGetter.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := de.buildArchive(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &entity.DataExport{
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(de.userPolicy.DataExportTTL),
+	}
+	err = de.dataExportStore.Create(ctx, export)
+	if err != nil {
+		return nil, err
+	}
+
+	err = de.fileStorage.Put(ctx, export.FileKey(), bytes.NewReader(archive))
+	if err != nil {
+		// the key of the archive needs the ID of the export, drop the export that has no archive
+		_, _ = de.dataExportStore.Delete(ctx, []string{export.ID})
+		return nil, err
+	}
+
+	return &response.DataExport{
+		ID:        export.ID,
+		ExpiresAt: export.ExpiresAt,
+	}, nil
+}
+
+// DownloadDataExport opens the archive of an export of the authenticated user,
+// exports of other users are reported as not found.
+func (de DataExportUsecase) DownloadDataExport(ctx context.Context, params *request.DownloadDataExport) (*response.DataExportFile, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	export, err := de.dataExportStore.GetByID(ctx, params.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	if export.IsExpired(time.Now()) {
+		return nil, customerror.NewForbiddenError("download link has expired")
+	}
+
+	content, err := de.fileStorage.Open(ctx, export.FileKey())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.DataExportFile{
+		Name:    "data-export-" + export.CreatedAt.Format("2006-01-02") + ".zip",
+		Content: content,
+	}, nil
+}
+
+// buildArchive writes a JSON file per record of the user followed by what the profile domain stores,
+// password hashes, token hashes and second factor secrets are left out.
+func (de DataExportUsecase) buildArchive(ctx context.Context, user *entity.User) ([]byte, error) {
+	files, err := de.collectRecords(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range files {
+		writer, err := archive.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return nil, err
+		}
+	}
+
+	err = de.profileExporter.ExportProfileData(ctx, user.ID, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type archiveRecord struct {
+	name    string
+	content interface{}
+}
+
+// collectRecords reads every table of the user domain holding data of the user, the records the user never created are skipped.
+func (de DataExportUsecase) collectRecords(ctx context.Context, user *entity.User) ([]archiveRecord, error) {
+	loginInformation, err := de.userGetter.GetLoginInformation(ctx, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		loginInformation, err = new(entity.LoginInformation), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := de.sessionStore.ListActiveByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	sessionResponses := make([]*response.Session, 0, len(sessions))
+	for _, session := range sessions {
+		sessionResponses = append(sessionResponses, &response.Session{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			LastSeenAt: session.LastSeenAt,
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	records := []archiveRecord{
+		{name: "user.json", content: toUserResponse(user)},
+		{name: "login_information.json", content: &response.LoginInformation{
+			SuccessLoginCount: loginInformation.SuccessLoginCount,
+			LastLoginAt:       loginInformation.LastLoginAt,
+		}},
+		{name: "sessions.json", content: sessionResponses},
+	}
+
+	preferences, err := de.preferencesStore.GetByUserID(ctx, user.ID)
+	if err == nil {
+		records = append(records, archiveRecord{name: "preferences.json", content: toPreferencesResponse(preferences)})
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	location, err := de.locationStore.GetByUserID(ctx, user.ID)
+	if err == nil {
+		records = append(records, archiveRecord{name: "location.json", content: toLocationResponse(location)})
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataExportUsecase(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	sessionStore := fake.NewFakeSessionStore()
+	preferencesStore := fake.NewFakePreferencesStore()
+	locationStore := fake.NewFakeLocationStore()
+	fileStorage := fake.NewFakeFileStorage()
+	dataExportStore := fake.NewFakeDataExportStore()
+	de := usecase.NewDataExportUsecase(
+		fakeUserDriven,
+		sessionStore,
+		preferencesStore,
+		locationStore,
+		fake.NewFakeProfileDataExporter(),
+		dataExportStore,
+		fileStorage,
+		&entity.UserPolicy{DataExportTTL: time.Hour},
+	)
+
+	user := &entity.User{
+		Username:    faker.Username(),
+		Name:        faker.Name(),
+		PhoneNumber: "+6281234567890",
+		Password:    "$2a$04$hash",
+		TOTPSecret:  "secret",
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+	assert.NoError(fakeUserDriven.UpdateLoginInformation(context.Background(), user))
+	assert.NoError(sessionStore.Create(context.Background(), &entity.Session{
+		ID:         "family-1",
+		UserID:     user.ID,
+		DeviceName: "Pixel 8",
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}))
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+
+	assert.NoError(preferencesStore.Save(context.Background(), &entity.Preferences{UserID: user.ID, InterestedIn: []entity.Gender{entity.GenderWoman}, MinAge: 25, MaxAge: 35, MaxDistanceKM: 40}))
+	assert.NoError(locationStore.Save(context.Background(), &entity.Location{UserID: user.ID, Latitude: -6.2, Longitude: 106.8, Geohash: "qqguwv"}))
+
+	var exportID string
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		_, err := de.RequestDataExport(context.Background())
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		_, err = de.DownloadDataExport(context.Background(), &request.DownloadDataExport{ExportID: "export-1"})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when the profile data cannot be exported, it should return error", func(t *testing.T) {
+		_, err := de.RequestDataExport(context.WithValue(ctx, fake.ContextType("profile_export_error"), true))
+		assert.Error(err)
+	})
+
+	t.Run("when storing the archive fails, it should return error and drop the export", func(t *testing.T) {
+		_, err := de.RequestDataExport(context.WithValue(ctx, fake.ContextType("file_storage_error"), true))
+		assert.Error(err)
+
+		exports, err := dataExportStore.ListExpired(context.Background(), time.Now().Add(2*time.Hour), 10)
+		assert.NoError(err)
+		assert.Empty(exports)
+	})
+
+	t.Run("when export requested, it should archive the records of the user", func(t *testing.T) {
+		export, err := de.RequestDataExport(ctx)
+		assert.NoError(err)
+		assert.WithinDuration(time.Now().Add(time.Hour), export.ExpiresAt, time.Minute)
+		exportID = export.ID
+
+		file, err := de.DownloadDataExport(ctx, &request.DownloadDataExport{ExportID: exportID})
+		assert.NoError(err)
+		defer file.Content.Close()
+		content, _ := io.ReadAll(file.Content)
+
+		archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		assert.NoError(err)
+		files := make(map[string]string)
+		for _, f := range archive.File {
+			reader, err := f.Open()
+			assert.NoError(err)
+			data, _ := io.ReadAll(reader)
+			reader.Close()
+			files[f.Name] = string(data)
+		}
+
+		assert.Contains(files["user.json"], user.Username)
+		assert.NotContains(files["user.json"], user.Password)
+		assert.NotContains(files["user.json"], user.TOTPSecret)
+		assert.Contains(files["sessions.json"], "Pixel 8")
+		assert.Contains(files["profile.json"], fmt.Sprint(user.ID))
+		assert.Contains(files["preferences.json"], string(entity.GenderWoman))
+		assert.Contains(files["location.json"], "qqguwv")
+
+		var loginInformation map[string]interface{}
+		assert.NoError(json.Unmarshal([]byte(files["login_information.json"]), &loginInformation))
+		assert.EqualValues(1, loginInformation["success_login_count"])
+	})
+
+	t.Run("when export belongs to another user, it should return not found", func(t *testing.T) {
+		otherCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID + 1})
+		_, err := de.DownloadDataExport(otherCtx, &request.DownloadDataExport{ExportID: exportID})
+		assert.ErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("when export is expired, it should return forbidden error", func(t *testing.T) {
+		export, err := dataExportStore.GetByID(context.Background(), exportID)
+		assert.NoError(err)
+		export.ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, err = de.DownloadDataExport(ctx, &request.DownloadDataExport{ExportID: exportID})
+		assert.IsType(new(customerror.ForbiddenError), err)
+	})
+}
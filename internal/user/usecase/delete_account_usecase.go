@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/response"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// DeleteAccount hides the authenticated user and signs every session out,
+// the account is purged once the grace period ends unless the owner logs in again before then.
+func (uu UserWriterUsecase) DeleteAccount(ctx context.Context) (*response.AccountDeletion, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.userWriter.SoftDelete(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.revokeAllSessions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.AccountDeletion{
+		PurgeAt: user.PurgeAt(uu.userPolicy.DeletionGracePeriod),
+	}, nil
+}
+
+// getLoginUser looks up the user logging in, deleted accounts are only found while they can still be restored.
+func (uu UserWriterUsecase) getLoginUser(ctx context.Context, identifier string) (*entity.User, error) {
+	user, err := uu.getUserByIdentifier(ctx, identifier)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return user, err
+	}
+
+	if entity.IsPhoneNumber(identifier) {
+		user, err = uu.userGetter.GetDeletedByPhoneNumber(ctx, identifier)
+	} else {
+		user, err = uu.userGetter.GetDeletedByUsername(ctx, identifier)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.CanRestore(time.Now(), uu.userPolicy.DeletionGracePeriod) {
+		return nil, sql.ErrNoRows
+	}
+	return user, nil
+}
+
+// getMFAUser looks up the user of a login challenge, a deleted account is only restored
+// once the second factor passed so it is found while it can still be restored.
+func (uu UserWriterUsecase) getMFAUser(ctx context.Context, userID int64) (*entity.User, error) {
+	user, err := uu.userGetter.GetByID(ctx, userID)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return user, err
+	}
+
+	user, err = uu.userGetter.GetDeletedByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.CanRestore(time.Now(), uu.userPolicy.DeletionGracePeriod) {
+		return nil, sql.ErrNoRows
+	}
+	return user, nil
+}
+
+// restoreAccount cancels the pending deletion of user, it is a no-op for accounts that are not deleted.
+func (uu UserWriterUsecase) restoreAccount(ctx context.Context, user *entity.User) error {
+	if !user.IsDeleted() {
+		return nil
+	}
+
+	err := uu.userWriter.Restore(ctx, user)
+	if err != nil {
+		return err
+	}
+	user.DeletedAt = nil
+	return nil
+}
@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_DeleteAccount(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	gracePeriod := 30 * 24 * time.Hour
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		&entity.UserPolicy{DeletionGracePeriod: gracePeriod},
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username:    faker.Username(),
+		Name:        faker.Name(),
+		PhoneNumber: "+6281234567890",
+		Password:    string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+
+	login := func(identifier string) (string, error) {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: identifier,
+			Password:   validPassword,
+		})
+		if err != nil {
+			return "", err
+		}
+		return token.RefreshToken, nil
+	}
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		_, err := uu.DeleteAccount(context.Background())
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when soft delete fails, it should return error", func(t *testing.T) {
+		_, err := uu.DeleteAccount(context.WithValue(ctx, fake.ContextType("soft_delete_error"), true))
+		assert.Error(err)
+	})
+
+	t.Run("when account deleted, it should hide it and sign every session out", func(t *testing.T) {
+		refreshToken, err := login(user.Username)
+		assert.NoError(err)
+
+		deletion, err := uu.DeleteAccount(ctx)
+		assert.NoError(err)
+		assert.WithinDuration(time.Now().Add(gracePeriod), deletion.PurgeAt, time.Minute)
+
+		_, err = fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.ErrorIs(err, sql.ErrNoRows)
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when deleted account logs in with a wrong password, it should stay deleted", func(t *testing.T) {
+		_, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   "Wrong-Passw0rd",
+		})
+		assert.IsType(new(customerror.ValidationError), err)
+
+		_, err = fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.ErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("when deleted account logs in during the grace period, it should restore it", func(t *testing.T) {
+		_, err := login(user.PhoneNumber)
+		assert.NoError(err)
+
+		restored, err := fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.NoError(err)
+		assert.False(restored.IsDeleted())
+	})
+
+	t.Run("when deleted account logs in after the grace period, it should refuse the login", func(t *testing.T) {
+		_, err := uu.DeleteAccount(ctx)
+		assert.NoError(err)
+		deletedAt := time.Now().Add(-gracePeriod - time.Hour)
+		user.DeletedAt = &deletedAt
+
+		_, err = login(user.Username)
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+}
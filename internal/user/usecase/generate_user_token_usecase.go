@@ -2,23 +2,67 @@ package usecase
 
 import (
 	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
 	"app/internal/user/param/request"
 	"app/internal/user/param/response"
 	"context"
+	"strings"
+	"sync"
+	"time"
 )
 
 func (uu UserWriterUsecase) GenerateUserToken(ctx context.Context, params *request.GenerateUserToken) (*response.Token, error) {
-	user, err := uu.userGetter.GetByUsername(ctx, params.Username)
+	user, lookupErr := uu.getLoginUser(ctx, params.Identifier)
+
+	// throttle by the resolved username so switching between username and phone number shares one counter
+	account := strings.ToLower(params.Identifier)
+	if lookupErr == nil {
+		account = user.Username
+	}
+	limits := uu.loginLimits(account, params.ClientIP)
+
+	err := uu.checkLoginThrottle(ctx, limits)
 	if err != nil {
-		return nil, customerror.NewValidationErrorWithMessage("authentication", "wrong username/password")
+		return nil, err
+	}
+
+	if lookupErr != nil {
+		// spend the same time as a wrong password so the response time does not tell whether the account exists
+		uu.compareDummyPassword(params.Password)
+		return nil, uu.failLogin(ctx, limits)
 	}
 
 	err = uu.encryptor.CompareEncryptedAndData([]byte(user.Password), []byte(params.Password))
 	if err != nil {
-		return nil, customerror.NewValidationErrorWithMessage("authentication", "wrong username/password")
+		return nil, uu.failLogin(ctx, limits)
+	}
+
+	if uu.userPolicy.RequireVerifiedPhone && !user.IsPhoneVerified() {
+		return nil, customerror.NewForbiddenError("phone number is not verified")
+	}
+
+	uu.rehashPassword(ctx, user, params.Password)
+
+	if user.IsTOTPEnabled() {
+		return uu.createMFAChallenge(ctx, user)
+	}
+
+	return uu.completeLogin(ctx, user, limits, &entity.Session{
+		DeviceName: params.DeviceName,
+		UserAgent:  params.UserAgent,
+		IPAddress:  params.ClientIP,
+	})
+}
+
+// completeLogin starts a new session once every factor has been checked and clears the failure counters.
+// Logging into a deleted account takes it back, so a password alone never restores an account protected by a second factor.
+func (uu UserWriterUsecase) completeLogin(ctx context.Context, user *entity.User, limits loginLimits, session *entity.Session) (*response.Token, error) {
+	err := uu.restoreAccount(ctx, user)
+	if err != nil {
+		return nil, err
 	}
 
-	token, err := uu.tokenProvider.Generate(user)
+	token, err := uu.issueToken(ctx, user, session)
 	if err != nil {
 		return nil, err
 	}
@@ -27,5 +71,149 @@ func (uu UserWriterUsecase) GenerateUserToken(ctx context.Context, params *reque
 	if err != nil {
 		return nil, err
 	}
+
+	err = uu.loginAttemptStore.Reset(ctx, limits.keys()...)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (uu UserWriterUsecase) getUserByIdentifier(ctx context.Context, identifier string) (*entity.User, error) {
+	if entity.IsPhoneNumber(identifier) {
+		return uu.userGetter.GetByPhoneNumber(ctx, identifier)
+	}
+	return uu.userGetter.GetByUsername(ctx, identifier)
+}
+
+// checkLoginThrottle rejects the login while the account or the client ip is still backing off.
+func (uu UserWriterUsecase) checkLoginThrottle(ctx context.Context, limits loginLimits) error {
+	now := time.Now()
+	var retryAfter time.Duration
+	for _, limit := range limits {
+		attempt, err := uu.loginAttemptStore.Get(ctx, limit.key)
+		if err != nil {
+			return err
+		}
+		if wait := limit.policy.RetryAfter(attempt, now); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	if retryAfter > 0 {
+		return customerror.NewAccountLockedError(retryAfter)
+	}
+	return nil
+}
+
+// failLogin counts the failed attempt and returns the same error whether the identifier or the password was wrong.
+func (uu UserWriterUsecase) failLogin(ctx context.Context, limits loginLimits) error {
+	err := uu.countLoginFailure(ctx, limits)
+	if err != nil {
+		return err
+	}
+	return customerror.NewValidationErrorWithMessage("authentication", "wrong username/phone number or password")
+}
+
+// dummyPasswordHash is hashed once with the configured encryptor, comparing against it costs as much as
+// checking the password of an existing user.
+type dummyPasswordHash struct {
+	once sync.Once
+	hash []byte
+}
+
+func (uu UserWriterUsecase) compareDummyPassword(password string) {
+	uu.dummyPassword.once.Do(func() {
+		uu.dummyPassword.hash, _ = uu.encryptor.Encrypt([]byte("dummy-password-for-unknown-users"))
+	})
+	_ = uu.encryptor.CompareEncryptedAndData(uu.dummyPassword.hash, []byte(password))
+}
+
+func (uu UserWriterUsecase) countLoginFailure(ctx context.Context, limits loginLimits) error {
+	for _, limit := range limits {
+		_, err := uu.loginAttemptStore.IncrementFailure(ctx, limit.key, limit.policy.Window)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type loginLimit struct {
+	key    string
+	policy entity.LoginThrottlePolicy
+}
+
+type loginLimits []loginLimit
+
+func (uu UserWriterUsecase) loginLimits(username, clientIP string) loginLimits {
+	limits := loginLimits{
+		{key: "username:" + username, policy: uu.loginThrottle.Username},
+	}
+	if clientIP != "" {
+		limits = append(limits, loginLimit{key: "ip:" + clientIP, policy: uu.loginThrottle.ClientIP})
+	}
+	return limits
+}
+
+func (limits loginLimits) keys() []string {
+	keys := make([]string, 0, len(limits))
+	for _, limit := range limits {
+		keys = append(keys, limit.key)
+	}
+	return keys
+}
+
+// rehashPassword upgrades a hash produced with an outdated algorithm or cost,
+// it is best effort since the plain password is only known during login and the old hash keeps working.
+func (uu UserWriterUsecase) rehashPassword(ctx context.Context, user *entity.User, password string) {
+	if !uu.encryptor.NeedsRehash([]byte(user.Password)) {
+		return
+	}
+
+	encryptedPassword, err := uu.encryptor.Encrypt([]byte(password))
+	if err != nil {
+		return
+	}
+
+	rehashed := *user
+	rehashed.Password = string(encryptedPassword)
+	if err := uu.userWriter.UpdatePassword(ctx, &rehashed); err == nil {
+		user.Password = rehashed.Password
+	}
+}
+
+// issueToken generates an access token together with a refresh token in the family of session.
+// A session without ID is a new login, it starts a new token family and is recorded, otherwise it is marked as seen.
+func (uu UserWriterUsecase) issueToken(ctx context.Context, user *entity.User, session *entity.Session) (*response.Token, error) {
+	refreshToken, err := uu.refreshTokenProvider.Generate(user.ID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.refreshTokenStore.Create(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	session.UserID = user.ID
+	session.ExpiresAt = refreshToken.ExpiresAt
+	if session.ID == "" {
+		session.ID = refreshToken.FamilyID
+		err = uu.sessionStore.Create(ctx, session)
+	} else {
+		err = uu.sessionStore.Touch(ctx, session)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := uu.tokenProvider.Generate(&entity.AccessTokenSubject{User: user, SessionID: session.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	token.RefreshToken = refreshToken.Token
+	token.RefreshExpiresIn = int(time.Until(refreshToken.ExpiresAt).Round(time.Second).Seconds())
 	return token, nil
 }
@@ -1,14 +1,19 @@
 package usecase_test
 
 import (
+	"app/configs"
 	"app/infra/encryption"
+	"app/infra/memory"
 	"app/internal/adapter/fake"
+	customerror "app/internal/custom_error"
 	"app/internal/user/entity"
 	"app/internal/user/param/request"
 	"app/internal/user/param/response"
 	"app/internal/user/usecase"
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 	"github.com/stretchr/testify/assert"
@@ -20,13 +25,14 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 	validPassword := faker.Password()
 	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.DefaultCost)
 	user := &entity.User{
-		Username: faker.Username(),
-		Name:     faker.Name(),
-		Password: string(encryptedPassword),
+		Username:    faker.Username(),
+		Name:        faker.Name(),
+		PhoneNumber: "+6281234567890",
+		Password:    string(encryptedPassword),
 	}
 
 	assert := assert.New(t)
-	_, err := fakeUserDriven.Create(context.Background(), user)
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
 	assert.NoError(err)
 
 	invalidUser := &entity.User{
@@ -34,7 +40,7 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 		Name:     faker.Name(),
 		Password: string(encryptedPassword),
 	}
-	_, err = fakeUserDriven.Create(context.Background(), invalidUser)
+	_, err = fakeUserDriven.Create(context.Background(), invalidUser, nil)
 	assert.NoError(err)
 
 	type args struct {
@@ -52,8 +58,8 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 			args: args{
 				context.Background(),
 				&request.GenerateUserToken{
-					Username: faker.Username(),
-					Password: faker.Password(),
+					Identifier: faker.Username(),
+					Password:   faker.Password(),
 				},
 			},
 			want:    nil,
@@ -64,8 +70,8 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 			args: args{
 				context.Background(),
 				&request.GenerateUserToken{
-					Username: user.Username,
-					Password: faker.Password(),
+					Identifier: user.Username,
+					Password:   faker.Password(),
 				},
 			},
 			want:    nil,
@@ -76,8 +82,8 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 			args: args{
 				context.Background(),
 				&request.GenerateUserToken{
-					Username: invalidUser.Username,
-					Password: validPassword,
+					Identifier: invalidUser.Username,
+					Password:   validPassword,
 				},
 			},
 			want:    nil,
@@ -88,33 +94,98 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 			args: args{
 				context.WithValue(context.Background(), fake.ContextType("token_error"), true),
 				&request.GenerateUserToken{
-					Username: user.Username,
-					Password: validPassword,
+					Identifier: user.Username,
+					Password:   validPassword,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "when error store refresh token, it should return error",
+			args: args{
+				context.WithValue(context.Background(), fake.ContextType("refresh_token_error"), true),
+				&request.GenerateUserToken{
+					Identifier: user.Username,
+					Password:   validPassword,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "when phone number not registered, it should return error",
+			args: args{
+				context.Background(),
+				&request.GenerateUserToken{
+					Identifier: "+6289999999999",
+					Password:   validPassword,
 				},
 			},
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "when login with phone number, it should return token",
+			args: args{
+				context.Background(),
+				&request.GenerateUserToken{
+					Identifier: user.PhoneNumber,
+					Password:   validPassword,
+				},
+			},
+			want: &response.Token{
+				Token:            "1231313213213131",
+				ExpiresIn:        3600,
+				Type:             "Bearer",
+				RefreshToken:     "refresh-token-1",
+				RefreshExpiresIn: 3600,
+			},
+			wantErr: false,
+		},
 		{
 			name: "success, it should return token",
 			args: args{
 				context.Background(),
 				&request.GenerateUserToken{
-					Username: user.Username,
-					Password: validPassword,
+					Identifier: user.Username,
+					Password:   validPassword,
 				},
 			},
 			want: &response.Token{
-				Token:     "1231313213213131",
-				ExpiresIn: 3600,
-				Type:      "Bearer",
+				Token:            "1231313213213131",
+				ExpiresIn:        3600,
+				Type:             "Bearer",
+				RefreshToken:     "refresh-token-1",
+				RefreshExpiresIn: 3600,
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uu := usecase.NewUserWriterUsecase(fakeUserDriven, new(encryption.BcryptEncryption), fakeUserDriven, new(fake.FakeTokenProvider))
+			uu := usecase.NewUserWriterUsecase(
+				fakeUserDriven,
+				new(encryption.BcryptEncryption),
+				fakeUserDriven,
+				new(fake.FakeTokenProvider),
+				new(fake.FakeRefreshTokenProvider),
+				fake.NewFakeRefreshTokenStore(),
+				memory.NewTokenRevocationStore(),
+				fake.NewFakeLoginAttemptStore(),
+				new(entity.LoginThrottle),
+				new(fake.FakeOTPProvider),
+				fake.NewFakeOTPStore(),
+				new(fake.FakeSMSSender),
+				new(fake.FakePasswordResetTicketProvider),
+				fake.NewFakePasswordResetTicketStore(),
+				new(fake.FakeTwoFactorProvider),
+				fake.NewFakeRecoveryCodeStore(),
+				fake.NewFakeMFAChallengeStore(),
+				fake.NewFakeSessionStore(),
+				fake.NewFakePasswordHistoryStore(),
+				new(entity.UserPolicy),
+			)
 			result, err := uu.GenerateUserToken(tt.args.ctx, tt.args.params)
 
 			assert.Equal(tt.wantErr, err != nil)
@@ -122,3 +193,173 @@ func TestUserWriterUsecase_GenerateUsertoken(t *testing.T) {
 		})
 	}
 }
+
+func TestUserWriterUsecase_GenerateUserToken_rehashOutdatedPassword(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	argon2id := encryption.NewEncryption(&configs.ApplicationConfig{Password: configs.Password{
+		Algorithm: encryption.AlgorithmArgon2id,
+		Argon2id:  configs.Argon2id{Memory: 1024, Iterations: 1, Parallelism: 1},
+	}})
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		argon2id,
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	params := &request.GenerateUserToken{Identifier: user.Username, Password: validPassword}
+
+	_, err = uu.GenerateUserToken(context.WithValue(context.Background(), fake.ContextType("update_password_error"), true), params)
+	assert.NoError(err, "failing to rehash must not block the login")
+	assert.Equal(string(encryptedPassword), user.Password)
+
+	_, err = uu.GenerateUserToken(context.Background(), params)
+	assert.NoError(err)
+
+	stored, err := fakeUserDriven.GetByID(context.Background(), user.ID)
+	assert.NoError(err)
+	assert.True(strings.HasPrefix(stored.Password, "$argon2id$"))
+	assert.False(argon2id.NeedsRehash([]byte(stored.Password)))
+
+	_, err = uu.GenerateUserToken(context.Background(), params)
+	assert.NoError(err, "it should still login with the rehashed password")
+}
+
+func TestUserWriterUsecase_GenerateUserToken_loginThrottle(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	loginAttemptStore := fake.NewFakeLoginAttemptStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		loginAttemptStore,
+		&entity.LoginThrottle{
+			Username: entity.LoginThrottlePolicy{LockoutThreshold: 2, Lockout: time.Minute, Window: time.Hour},
+			ClientIP: entity.LoginThrottlePolicy{LockoutThreshold: 3, Lockout: time.Minute, Window: time.Hour},
+		},
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	wrongPassword := &request.GenerateUserToken{Identifier: user.Username, Password: "wrong", ClientIP: "10.0.0.1"}
+	for i := 0; i < 2; i++ {
+		_, err = uu.GenerateUserToken(context.Background(), wrongPassword)
+		assert.IsType(new(customerror.ValidationError), err)
+	}
+
+	_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: user.Username, Password: validPassword, ClientIP: "10.0.0.2"})
+	var lockedErr *customerror.AccountLockedError
+	assert.ErrorAs(err, &lockedErr, "it should lock the username regardless of the client ip")
+	assert.Greater(lockedErr.RetryAfterSeconds(), 0)
+
+	_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: faker.Username(), Password: "wrong", ClientIP: "10.0.0.1"})
+	assert.IsType(new(customerror.ValidationError), err)
+	_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: faker.Username(), Password: "wrong", ClientIP: "10.0.0.1"})
+	assert.ErrorAs(err, &lockedErr, "it should lock the client ip trying many usernames")
+
+	loginAttemptStore.Set(&entity.LoginAttempt{Key: "username:" + user.Username, FailedCount: 2, LastFailedAt: time.Now().Add(-2 * time.Minute)})
+	_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: user.Username, Password: validPassword, ClientIP: "10.0.0.3"})
+	assert.NoError(err, "it should allow login once the lockout is over")
+
+	attempt, err := loginAttemptStore.Get(context.Background(), "username:"+user.Username)
+	assert.NoError(err)
+	assert.Equal(0, attempt.FailedCount, "it should reset the counter after a successful login")
+
+	_, err = uu.GenerateUserToken(context.WithValue(context.Background(), fake.ContextType("login_attempt_error"), true), wrongPassword)
+	assert.Error(err)
+}
+
+type countingEncryptor struct {
+	*encryption.BcryptEncryption
+	compares int
+}
+
+func (ce *countingEncryptor) CompareEncryptedAndData(encrypted, data []byte) error {
+	ce.compares++
+	return ce.BcryptEncryption.CompareEncryptedAndData(encrypted, data)
+}
+
+func TestUserWriterUsecase_GenerateUserToken_unknownUserComparesPassword(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	encryptor := &countingEncryptor{BcryptEncryption: new(encryption.BcryptEncryption)}
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		encryptor,
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	for i := 1; i <= 2; i++ {
+		_, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: faker.Username(), Password: faker.Password()})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.Equal(i, encryptor.compares, "it should compare the password even when the user does not exist")
+	}
+}
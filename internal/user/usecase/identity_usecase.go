@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+)
+
+// UpdateMyIdentity changes the gender, its visibility and the orientation of the authenticated user.
+// The preferences were only derived from them at signup so they are kept as they are.
+func (uu UserWriterUsecase) UpdateMyIdentity(ctx context.Context, params *request.UpdateIdentity) (*response.User, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.UpdateIdentity(params, uu.userPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.userWriter.UpdateIdentity(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(user), nil
+}
@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserWriterUsecase_UpdateMyIdentity(t *testing.T) {
+	strPtr := func(value string) *string {
+		return &value
+	}
+	hidden := false
+	tests := []struct {
+		name            string
+		ctx             func(context.Context) context.Context
+		params          *request.UpdateIdentity
+		wantErr         bool
+		wantErrType     error
+		wantErrMsg      string
+		wantGender      string
+		wantShowGender  bool
+		wantOrientation string
+	}{
+		{
+			name:        "when no authenticated user, it should return unauthorized error",
+			ctx:         func(context.Context) context.Context { return context.Background() },
+			params:      &request.UpdateIdentity{Gender: strPtr("woman")},
+			wantErr:     true,
+			wantErrType: new(customerror.UnauthorizedError),
+		},
+		{
+			name:        "when fields are not valid, it should return every validation error",
+			params:      &request.UpdateIdentity{Gender: strPtr("agender"), Orientation: strPtr("robot")},
+			wantErr:     true,
+			wantErrType: new(customerror.ValidationError),
+			wantErrMsg:  "gender: can only be woman or man or non_binary;orientation: can only be straight or gay or lesbian or bisexual or pansexual or asexual or queer or questioning",
+		},
+		{
+			name: "when storing fails, it should return error",
+			ctx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, fake.ContextType("update_identity_error"), true)
+			},
+			params:  &request.UpdateIdentity{ShowGender: &hidden},
+			wantErr: true,
+		},
+		{
+			name:            "when only some fields are sent, it should keep the others",
+			params:          &request.UpdateIdentity{ShowGender: &hidden, Orientation: strPtr("Queer")},
+			wantGender:      "man",
+			wantShowGender:  false,
+			wantOrientation: "queer",
+		},
+		{
+			name:           "when gender changes and orientation is removed, it should store both",
+			params:         &request.UpdateIdentity{Gender: strPtr("non_binary"), Orientation: strPtr("")},
+			wantGender:     "non_binary",
+			wantShowGender: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserDriven := fake.NewFakeUserDriven()
+			user := &entity.User{Username: "identity", Gender: entity.GenderMan, ShowGender: true, Orientation: entity.OrientationStraight}
+			_, _ = fakeUserDriven.Create(context.Background(), user, nil)
+			ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+			policy := &entity.UserPolicy{Genders: []entity.Gender{entity.GenderWoman, entity.GenderMan, entity.GenderNonBinary}}
+			uu := usecase.NewUserWriterUsecase(fakeUserDriven, nil, fakeUserDriven, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, policy)
+
+			got, err := uu.UpdateMyIdentity(ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErr {
+				assert.Nil(got)
+				assert.Error(err)
+				if tt.wantErrType != nil {
+					assert.IsType(tt.wantErrType, err)
+				}
+				if tt.wantErrMsg != "" {
+					assert.ElementsMatch(strings.Split(tt.wantErrMsg, ";"), strings.Split(err.Error(), ";"))
+					assert.Equal(entity.GenderMan, user.Gender, "it should leave the user unchanged")
+				}
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.wantGender, got.Gender)
+			assert.Equal(tt.wantShowGender, got.ShowGender)
+			assert.Equal(tt.wantOrientation, got.Orientation)
+
+			stored, err := fakeUserDriven.GetByID(context.Background(), user.ID)
+			assert.NoError(err)
+			assert.Equal(entity.Gender(tt.wantGender), stored.Gender)
+			assert.Equal(entity.Orientation(tt.wantOrientation), stored.Orientation)
+		})
+	}
+}
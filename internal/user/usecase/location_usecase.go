@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driven"
+	"context"
+)
+
+type LocationUsecase struct {
+	locationStore driven.LocationStore
+}
+
+func NewLocationUsecase(locationStore driven.LocationStore) *LocationUsecase {
+	return &LocationUsecase{
+		locationStore: locationStore,
+	}
+}
+
+// GetMyLocation returns the last location shared by the authenticated user, 404 until one is shared.
+func (lu LocationUsecase) GetMyLocation(ctx context.Context) (*response.Location, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	location, err := lu.locationStore.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toLocationResponse(location), nil
+}
+
+// UpdateMyLocation replaces the location of the authenticated user.
+func (lu LocationUsecase) UpdateMyLocation(ctx context.Context, params *request.UpdateLocation) (*response.Location, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	location, err := entity.NewLocation(userID, params.Latitude, params.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lu.locationStore.Save(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	return toLocationResponse(location), nil
+}
+
+func toLocationResponse(location *entity.Location) *response.Location {
+	return &response.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Geohash:   location.Geohash,
+		UpdatedAt: location.UpdatedAt,
+	}
+}
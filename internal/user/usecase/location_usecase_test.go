@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationUsecase_UpdateMyLocation(t *testing.T) {
+	userCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: 1})
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		params      *request.UpdateLocation
+		wantErr     error
+		wantErrMsg  string
+		wantGeohash string
+	}{
+		{
+			name:    "when no authenticated user, it should return unauthorized error",
+			ctx:     context.Background(),
+			params:  &request.UpdateLocation{Latitude: -6.2, Longitude: 106.8},
+			wantErr: new(customerror.UnauthorizedError),
+		},
+		{
+			name:       "when coordinates are out of range, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateLocation{Latitude: 91, Longitude: -181},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "latitude: must be between -90 and 90;longitude: must be between -180 and 180",
+		},
+		{
+			name:       "when latitude is not a number, it should return validation error",
+			ctx:        userCtx,
+			params:     &request.UpdateLocation{Latitude: math.NaN(), Longitude: 10},
+			wantErr:    new(customerror.ValidationError),
+			wantErrMsg: "latitude: must be between -90 and 90",
+		},
+		{
+			name:        "when coordinates are valid, it should store them with their geohash",
+			ctx:         userCtx,
+			params:      &request.UpdateLocation{Latitude: 57.64911, Longitude: 10.40744},
+			wantGeohash: "u4pruydqq",
+		},
+		{
+			name:        "when coordinates are on the edge of the map, it should store them",
+			ctx:         userCtx,
+			params:      &request.UpdateLocation{Latitude: -90, Longitude: 180},
+			wantGeohash: "pbpbpbpbp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lu := usecase.NewLocationUsecase(fake.NewFakeLocationStore())
+			got, err := lu.UpdateMyLocation(tt.ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErr != nil {
+				assert.Nil(got)
+				assert.IsType(tt.wantErr, err)
+				if tt.wantErrMsg != "" {
+					assert.ElementsMatch(strings.Split(tt.wantErrMsg, ";"), strings.Split(err.Error(), ";"))
+				}
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.wantGeohash, got.Geohash)
+			assert.False(got.UpdatedAt.IsZero())
+		})
+	}
+}
+
+func TestLocationUsecase_GetMyLocation(t *testing.T) {
+	assert := assert.New(t)
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: 1})
+	lu := usecase.NewLocationUsecase(fake.NewFakeLocationStore())
+
+	_, err := lu.GetMyLocation(ctx)
+	assert.ErrorIs(err, sql.ErrNoRows, "it should not find a location that was never shared")
+
+	_, err = lu.UpdateMyLocation(ctx, &request.UpdateLocation{Latitude: -6.2, Longitude: 106.8})
+	assert.NoError(err)
+	_, err = lu.UpdateMyLocation(context.WithValue(ctx, fake.ContextType("save_location_error"), true), &request.UpdateLocation{Latitude: 0, Longitude: 0})
+	assert.Error(err)
+
+	got, err := lu.GetMyLocation(ctx)
+	assert.NoError(err)
+	assert.Equal(-6.2, got.Latitude)
+	assert.Equal(106.8, got.Longitude)
+}
+
+func TestHaversineDistanceKM(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  [2]float64
+		wantKM    float64
+		tolerance float64
+	}{
+		{name: "when both points are the same, it should be zero", from: [2]float64{-6.2, 106.8}, to: [2]float64{-6.2, 106.8}, wantKM: 0, tolerance: 1e-9},
+		{name: "when points are Jakarta and Bandung, it should be about 116 km", from: [2]float64{-6.2088, 106.8456}, to: [2]float64{-6.9175, 107.6191}, wantKM: 116.3, tolerance: 1},
+		{name: "when points are across the antimeridian, it should take the short way", from: [2]float64{0, 179.5}, to: [2]float64{0, -179.5}, wantKM: 111.2, tolerance: 0.5},
+		{name: "when points are antipodal, it should be half the circumference", from: [2]float64{0, 0}, to: [2]float64{0, 180}, wantKM: 20015.1, tolerance: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entity.HaversineDistanceKM(tt.from[0], tt.from[1], tt.to[0], tt.to[1])
+			assert.InDelta(t, tt.wantKM, got, tt.tolerance)
+		})
+	}
+}
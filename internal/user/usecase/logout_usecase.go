@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/param/request"
+	"context"
+	"time"
+)
+
+// Logout revokes the access token used for the request and ends the session it was issued in.
+// The refresh token is only needed to end the session of tokens issued before sessions were recorded.
+func (uu UserWriterUsecase) Logout(ctx context.Context, params *request.Logout) error {
+	claims, ok := authcontext.ClaimsFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	if params.RefreshToken != "" {
+		refreshToken, err := uu.refreshTokenStore.GetByHash(ctx, uu.refreshTokenProvider.Hash(params.RefreshToken))
+		if err == nil && refreshToken.UserID == claims.UserID {
+			if err := uu.endSession(ctx, refreshToken.FamilyID, refreshToken.ExpiresAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if claims.SessionID != "" {
+		session, err := uu.sessionStore.GetByID(ctx, claims.SessionID)
+		if err == nil && session.UserID == claims.UserID {
+			if err := uu.endSession(ctx, session.ID, session.ExpiresAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	return uu.tokenRevocationStore.Revoke(ctx, claims.TokenID, claims.ExpiresAt)
+}
+
+// LogoutAll revokes every access and refresh token of the authenticated user.
+func (uu UserWriterUsecase) LogoutAll(ctx context.Context) error {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	return uu.revokeAllSessions(ctx, userID)
+}
+
+// revokeAllSessions revokes every access and refresh token of userID. RevokeUser misses the access tokens
+// issued within the second of the revocation, so the sessions they were issued in are revoked as well.
+func (uu UserWriterUsecase) revokeAllSessions(ctx context.Context, userID int64) error {
+	if err := uu.refreshTokenStore.RevokeByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	sessions, err := uu.sessionStore.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := uu.tokenRevocationStore.Revoke(ctx, session.ID, session.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	if err := uu.sessionStore.RevokeByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	return uu.tokenRevocationStore.RevokeUser(ctx, userID, time.Now())
+}
@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_Logout(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	revocationStore := memory.NewTokenRevocationStore()
+	sessionStore := fake.NewFakeSessionStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		revocationStore,
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		sessionStore,
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	login := func() string {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   validPassword,
+		})
+		assert.NoError(err)
+		return token.RefreshToken
+	}
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		err := uu.Logout(context.Background(), &request.Logout{})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		err = uu.LogoutAll(context.Background())
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when logout, it should revoke the access token and the refresh token family", func(t *testing.T) {
+		refreshToken := login()
+		claims := &entity.UserClaims{
+			UserID:    user.ID,
+			TokenID:   faker.UUIDHyphenated(),
+			IssuedAt:  time.Now(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		ctx := authcontext.WithClaims(context.Background(), claims)
+
+		err := uu.Logout(ctx, &request.Logout{RefreshToken: refreshToken})
+		assert.NoError(err)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), claims)
+		assert.NoError(err)
+		assert.True(revoked)
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when logout everywhere, it should revoke every token of the user", func(t *testing.T) {
+		firstRefreshToken := login()
+		secondRefreshToken := login()
+		claims := &entity.UserClaims{
+			UserID:    user.ID,
+			TokenID:   faker.UUIDHyphenated(),
+			IssuedAt:  time.Now().Add(-time.Minute),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		otherDeviceClaims := &entity.UserClaims{
+			UserID:    user.ID,
+			TokenID:   faker.UUIDHyphenated(),
+			IssuedAt:  time.Now().Add(-time.Minute),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		err := uu.LogoutAll(authcontext.WithClaims(context.Background(), claims))
+		assert.NoError(err)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), otherDeviceClaims)
+		assert.NoError(err)
+		assert.True(revoked)
+
+		for _, refreshToken := range []string{firstRefreshToken, secondRefreshToken} {
+			_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+			assert.IsType(new(customerror.UnauthorizedError), err)
+		}
+	})
+	t.Run("when logout everywhere within the second a token was issued, it should reject that token", func(t *testing.T) {
+		login()
+		sessions, err := sessionStore.ListActiveByUserID(context.Background(), user.ID)
+		assert.NoError(err)
+		assert.NotEmpty(sessions)
+		claims := &entity.UserClaims{
+			UserID:    user.ID,
+			TokenID:   faker.UUIDHyphenated(),
+			SessionID: sessions[0].ID,
+			IssuedAt:  time.Now().Truncate(time.Second),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		err = uu.LogoutAll(authcontext.WithClaims(context.Background(), claims))
+		assert.NoError(err)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), claims)
+		assert.NoError(err)
+		assert.True(revoked)
+	})
+}
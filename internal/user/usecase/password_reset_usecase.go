@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// RequestPasswordReset texts a reset code to the phone number of the account.
+// Unknown identifiers are silently ignored so the endpoint cannot be used to find registered accounts.
+func (uu UserWriterUsecase) RequestPasswordReset(ctx context.Context, params *request.RequestPasswordReset) error {
+	user, err := uu.getUserByIdentifier(ctx, params.Identifier)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return uu.sendOTP(ctx, user, entity.OTPPurposePasswordReset, "password reset")
+}
+
+// VerifyPasswordReset exchanges a valid reset code for a ticket used by ResetPassword.
+func (uu UserWriterUsecase) VerifyPasswordReset(ctx context.Context, params *request.VerifyPasswordReset) (*response.PasswordResetTicket, error) {
+	user, err := uu.getUserByIdentifier(ctx, params.Identifier)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, invalidOTPError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.verifyOTP(ctx, user.ID, entity.OTPPurposePasswordReset, params.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := uu.passwordResetTicketProvider.Generate(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.passwordResetTicketStore.Create(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.PasswordResetTicket{
+		Ticket:    ticket.Ticket,
+		ExpiresIn: int(time.Until(ticket.ExpiresAt).Round(time.Second).Seconds()),
+	}, nil
+}
+
+// ResetPassword sets a new password with a ticket from VerifyPasswordReset and signs the user out everywhere.
+func (uu UserWriterUsecase) ResetPassword(ctx context.Context, params *request.ResetPassword) error {
+	ticket, err := uu.passwordResetTicketStore.GetByHash(ctx, uu.passwordResetTicketProvider.Hash(params.Ticket))
+	if errors.Is(err, sql.ErrNoRows) {
+		return invalidPasswordResetTicketError()
+	}
+	if err != nil {
+		return err
+	}
+
+	if ticket.IsUsed() || ticket.IsExpired(time.Now()) {
+		return invalidPasswordResetTicketError()
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, ticket.UserID)
+	if err != nil {
+		return err
+	}
+
+	// validate before spending the ticket so a weak password can be corrected with the same ticket
+	err = uu.validateNewPassword(ctx, user, params.Password)
+	if err != nil {
+		return err
+	}
+
+	used, err := uu.passwordResetTicketStore.MarkUsed(ctx, ticket.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return invalidPasswordResetTicketError()
+	}
+
+	err = uu.storeNewPassword(ctx, user, params.Password)
+	if err != nil {
+		return err
+	}
+
+	err = uu.revokeAllSessions(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	// the account owner proved control of the phone, lift any lockout left by the attacker
+	return uu.loginAttemptStore.Reset(ctx, "username:"+user.Username)
+}
+
+func invalidPasswordResetTicketError() error {
+	return customerror.NewValidationErrorWithMessage("ticket", "invalid or expired ticket")
+}
@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_PasswordReset(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	smsSender := new(fake.FakeSMSSender)
+	revocationStore := memory.NewTokenRevocationStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		revocationStore,
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		smsSender,
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	oldPassword := "Old-Passw0rd"
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(oldPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username:    faker.Username(),
+		Name:        faker.Name(),
+		PhoneNumber: "+6281234567890",
+		Password:    string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	t.Run("when identifier is not registered, it should not send anything", func(t *testing.T) {
+		err := uu.RequestPasswordReset(context.Background(), &request.RequestPasswordReset{Identifier: faker.Username()})
+		assert.NoError(err)
+		assert.Empty(smsSender.Sent)
+
+		_, err = uu.VerifyPasswordReset(context.Background(), &request.VerifyPasswordReset{Identifier: faker.Username(), Code: fake.FakeOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when code is wrong, it should not return ticket", func(t *testing.T) {
+		err := uu.RequestPasswordReset(context.Background(), &request.RequestPasswordReset{Identifier: user.Username})
+		assert.NoError(err)
+		assert.Len(smsSender.Sent, 1)
+		assert.Equal(user.PhoneNumber, smsSender.Sent[0].PhoneNumber)
+
+		ticket, err := uu.VerifyPasswordReset(context.Background(), &request.VerifyPasswordReset{Identifier: user.Username, Code: "000000"})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.Nil(ticket)
+	})
+
+	t.Run("when ticket is unknown, it should return validation error", func(t *testing.T) {
+		err := uu.ResetPassword(context.Background(), &request.ResetPassword{Ticket: "unknown", Password: "New-Passw0rd"})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when reset completes, it should change the password and revoke every session", func(t *testing.T) {
+		issuedBefore := &entity.UserClaims{UserID: user.ID, TokenID: faker.UUIDHyphenated(), IssuedAt: time.Now().Add(-time.Minute)}
+
+		err := uu.RequestPasswordReset(context.Background(), &request.RequestPasswordReset{Identifier: user.PhoneNumber})
+		assert.NoError(err)
+
+		ticket, err := uu.VerifyPasswordReset(context.Background(), &request.VerifyPasswordReset{Identifier: user.PhoneNumber, Code: fake.FakeOTPCode})
+		assert.NoError(err)
+		assert.NotEmpty(ticket.Ticket)
+		assert.Greater(ticket.ExpiresIn, 0)
+
+		err = uu.ResetPassword(context.Background(), &request.ResetPassword{Ticket: ticket.Ticket, Password: "weak"})
+		assert.IsType(new(customerror.ValidationError), err, "it should apply the password rules")
+
+		err = uu.ResetPassword(context.Background(), &request.ResetPassword{Ticket: ticket.Ticket, Password: "New-Passw0rd"})
+		assert.NoError(err, "it should keep the ticket usable after a rejected password")
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), issuedBefore)
+		assert.NoError(err)
+		assert.True(revoked)
+
+		_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: user.Username, Password: oldPassword})
+		assert.Error(err)
+		_, err = uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{Identifier: user.Username, Password: "New-Passw0rd"})
+		assert.NoError(err)
+
+		err = uu.ResetPassword(context.Background(), &request.ResetPassword{Ticket: ticket.Ticket, Password: "Other-Passw0rd"})
+		assert.IsType(new(customerror.ValidationError), err, "it should only allow the ticket once")
+	})
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SendPhoneVerification texts a new code to the phone number, superseding any code sent before.
+// Unknown and already verified numbers are silently ignored so the endpoint cannot be used
+// to find out which numbers are registered.
+func (uu UserWriterUsecase) SendPhoneVerification(ctx context.Context, params *request.SendPhoneVerification) error {
+	user, err := uu.userGetter.GetByPhoneNumber(ctx, params.PhoneNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.IsPhoneVerified() {
+		return nil
+	}
+
+	return uu.sendOTP(ctx, user, entity.OTPPurposePhoneVerification, "verification")
+}
+
+func (uu UserWriterUsecase) VerifyPhoneNumber(ctx context.Context, params *request.VerifyPhoneNumber) error {
+	user, err := uu.userGetter.GetByPhoneNumber(ctx, params.PhoneNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return invalidOTPError()
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.IsPhoneVerified() {
+		return nil
+	}
+
+	err = uu.verifyOTP(ctx, user.ID, entity.OTPPurposePhoneVerification, params.Code)
+	if err != nil {
+		return err
+	}
+	return uu.userWriter.MarkPhoneVerified(ctx, user)
+}
+
+func (uu UserWriterUsecase) sendOTP(ctx context.Context, user *entity.User, purpose entity.OTPPurpose, codeName string) error {
+	otp, err := uu.otpProvider.Generate(user.ID, purpose)
+	if err != nil {
+		return err
+	}
+
+	err = uu.otpStore.Create(ctx, otp)
+	if err != nil {
+		return err
+	}
+
+	expiresInMinute := int(math.Ceil(time.Until(otp.ExpiresAt).Minutes()))
+	message := fmt.Sprintf("%s is your %s code, it expires in %d minutes. Never share it with anyone.", otp.Code, codeName, expiresInMinute)
+	return uu.smsSender.Send(ctx, user.PhoneNumber, message)
+}
+
+// verifyOTP checks code against the latest code sent for purpose and consumes it on success.
+// Every wrong guess counts toward the attempt limit of that code.
+func (uu UserWriterUsecase) verifyOTP(ctx context.Context, userID int64, purpose entity.OTPPurpose, code string) error {
+	otp, err := uu.otpStore.GetLatest(ctx, userID, purpose)
+	if errors.Is(err, sql.ErrNoRows) {
+		return invalidOTPError()
+	}
+	if err != nil {
+		return err
+	}
+
+	if !otp.IsUsable(time.Now()) {
+		return invalidOTPError()
+	}
+
+	if subtle.ConstantTimeCompare([]byte(uu.otpProvider.Hash(code)), []byte(otp.CodeHash)) != 1 {
+		err = uu.otpStore.IncrementAttempts(ctx, otp.ID)
+		if err != nil {
+			return err
+		}
+		return invalidOTPError()
+	}
+
+	consumed, err := uu.otpStore.Consume(ctx, otp.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return invalidOTPError()
+	}
+	return nil
+}
+
+func invalidOTPError() error {
+	return customerror.NewValidationErrorWithMessage("code", "invalid or expired code")
+}
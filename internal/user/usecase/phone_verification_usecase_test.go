@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_PhoneVerification(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	smsSender := new(fake.FakeSMSSender)
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		smsSender,
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		&entity.UserPolicy{RequireVerifiedPhone: true},
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username:    faker.Username(),
+		Name:        faker.Name(),
+		PhoneNumber: "+6281234567890",
+		Password:    string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	login := &request.GenerateUserToken{Identifier: user.Username, Password: validPassword}
+
+	t.Run("when phone number is not verified, it should refuse to issue token", func(t *testing.T) {
+		_, err := uu.GenerateUserToken(context.Background(), login)
+		assert.IsType(new(customerror.ForbiddenError), err)
+	})
+
+	t.Run("when phone number is not registered, it should not send anything", func(t *testing.T) {
+		err := uu.SendPhoneVerification(context.Background(), &request.SendPhoneVerification{PhoneNumber: "+6289999999999"})
+		assert.NoError(err)
+		assert.Empty(smsSender.Sent)
+
+		err = uu.VerifyPhoneNumber(context.Background(), &request.VerifyPhoneNumber{PhoneNumber: "+6289999999999", Code: fake.FakeOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when no code was sent, it should return validation error", func(t *testing.T) {
+		err := uu.VerifyPhoneNumber(context.Background(), &request.VerifyPhoneNumber{PhoneNumber: user.PhoneNumber, Code: fake.FakeOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when store or sms error, it should return error", func(t *testing.T) {
+		err := uu.SendPhoneVerification(context.WithValue(context.Background(), fake.ContextType("otp_error"), true), &request.SendPhoneVerification{PhoneNumber: user.PhoneNumber})
+		assert.Error(err)
+
+		err = uu.SendPhoneVerification(context.WithValue(context.Background(), fake.ContextType("sms_error"), true), &request.SendPhoneVerification{PhoneNumber: user.PhoneNumber})
+		assert.Error(err)
+	})
+
+	t.Run("when code is guessed too many times, it should reject the right code", func(t *testing.T) {
+		err := uu.SendPhoneVerification(context.Background(), &request.SendPhoneVerification{PhoneNumber: user.PhoneNumber})
+		assert.NoError(err)
+
+		for i := 0; i < 3; i++ {
+			err = uu.VerifyPhoneNumber(context.Background(), &request.VerifyPhoneNumber{PhoneNumber: user.PhoneNumber, Code: "000000"})
+			assert.IsType(new(customerror.ValidationError), err)
+		}
+
+		err = uu.VerifyPhoneNumber(context.Background(), &request.VerifyPhoneNumber{PhoneNumber: user.PhoneNumber, Code: fake.FakeOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.False(user.IsPhoneVerified())
+	})
+
+	t.Run("when code is correct, it should verify the phone number and allow login", func(t *testing.T) {
+		err := uu.SendPhoneVerification(context.Background(), &request.SendPhoneVerification{PhoneNumber: user.PhoneNumber})
+		assert.NoError(err)
+
+		sent := smsSender.Sent[len(smsSender.Sent)-1]
+		assert.Equal(user.PhoneNumber, sent.PhoneNumber)
+		assert.True(strings.Contains(sent.Message, fake.FakeOTPCode))
+
+		err = uu.VerifyPhoneNumber(context.Background(), &request.VerifyPhoneNumber{PhoneNumber: user.PhoneNumber, Code: fake.FakeOTPCode})
+		assert.NoError(err)
+		assert.True(user.IsPhoneVerified())
+
+		_, err = uu.GenerateUserToken(context.Background(), login)
+		assert.NoError(err)
+	})
+
+	t.Run("when phone number is already verified, it should not send another code", func(t *testing.T) {
+		sentCount := len(smsSender.Sent)
+		err := uu.SendPhoneVerification(context.Background(), &request.SendPhoneVerification{PhoneNumber: user.PhoneNumber})
+		assert.NoError(err)
+		assert.Len(smsSender.Sent, sentCount)
+	})
+}
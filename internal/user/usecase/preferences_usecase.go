@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driven"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+type PreferencesUsecase struct {
+	userGetter       driven.UserGetter
+	preferencesStore driven.PreferencesStore
+	userPolicy       *entity.UserPolicy
+}
+
+func NewPreferencesUsecase(userGetter driven.UserGetter, preferencesStore driven.PreferencesStore, userPolicy *entity.UserPolicy) *PreferencesUsecase {
+	return &PreferencesUsecase{
+		userGetter:       userGetter,
+		preferencesStore: preferencesStore,
+		userPolicy:       userPolicy,
+	}
+}
+
+// GetMyPreferences returns the preferences of the authenticated user.
+func (pu PreferencesUsecase) GetMyPreferences(ctx context.Context) (*response.Preferences, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	preferences, err := pu.getPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toPreferencesResponse(preferences), nil
+}
+
+// UpdateMyPreferences only changes the fields that are set in params.
+func (pu PreferencesUsecase) UpdateMyPreferences(ctx context.Context, params *request.UpdatePreferences) (*response.Preferences, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	preferences, err := pu.getPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = preferences.Update(params, pu.userPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.preferencesStore.Save(ctx, preferences)
+	if err != nil {
+		return nil, err
+	}
+	return toPreferencesResponse(preferences), nil
+}
+
+// getPreferences falls back to the defaults of the user when none are stored, which only happens
+// when storing them failed after the user was created.
+func (pu PreferencesUsecase) getPreferences(ctx context.Context, userID int64) (*entity.Preferences, error) {
+	preferences, err := pu.preferencesStore.GetByUserID(ctx, userID)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return preferences, err
+	}
+
+	user, err := pu.userGetter.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewDefaultPreferences(user, pu.userPolicy), nil
+}
+
+func toPreferencesResponse(preferences *entity.Preferences) *response.Preferences {
+	interestedIn := make([]string, 0, len(preferences.InterestedIn))
+	for _, gender := range preferences.InterestedIn {
+		interestedIn = append(interestedIn, gender.String())
+	}
+	return &response.Preferences{
+		InterestedIn:  interestedIn,
+		MinAge:        preferences.MinAge,
+		MaxAge:        preferences.MaxAge,
+		MaxDistanceKM: preferences.MaxDistanceKM,
+		UpdatedAt:     preferences.UpdatedAt,
+	}
+}
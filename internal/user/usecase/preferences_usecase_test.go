@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferencesUsecase_UpdateMyPreferences(t *testing.T) {
+	intPtr := func(value int) *int {
+		return &value
+	}
+	tests := []struct {
+		name       string
+		params     *request.UpdatePreferences
+		policy     *entity.UserPolicy
+		wantErrMsg string
+	}{
+		{
+			name:       "when interested in is emptied, it should return validation error",
+			params:     &request.UpdatePreferences{InterestedIn: []string{}},
+			wantErrMsg: "interested_in: must have at least one gender",
+		},
+		{
+			name:       "when interested in has an unknown gender, it should return validation error",
+			params:     &request.UpdatePreferences{InterestedIn: []string{"man", "robot"}},
+			wantErrMsg: "interested_in: can only be woman or man or non_binary or genderqueer or genderfluid or agender or two_spirit",
+		},
+		{
+			name:       "when interested in has a gender that is not offered, it should return validation error",
+			params:     &request.UpdatePreferences{InterestedIn: []string{"two_spirit"}},
+			policy:     &entity.UserPolicy{Genders: []entity.Gender{entity.GenderWoman, entity.GenderMan}},
+			wantErrMsg: "interested_in: can only be woman or man",
+		},
+		{
+			name:       "when min age is below 18, it should return validation error",
+			params:     &request.UpdatePreferences{MinAge: intPtr(17)},
+			wantErrMsg: "min_age: must be between 18 and 120",
+		},
+		{
+			name:       "when min age is greater than max age, it should return validation error",
+			params:     &request.UpdatePreferences{MinAge: intPtr(40), MaxAge: intPtr(30)},
+			wantErrMsg: "min_age: must not be greater than max_age",
+		},
+		{
+			name:       "when only min age is raised past the stored max age, it should return validation error",
+			params:     &request.UpdatePreferences{MinAge: intPtr(29)},
+			wantErrMsg: "min_age: must not be greater than max_age",
+		},
+		{
+			name:       "when max distance is out of range, it should return validation error",
+			params:     &request.UpdatePreferences{MaxDistanceKM: intPtr(0)},
+			wantErrMsg: "max_distance_km: must be between 1 and 500",
+		},
+		{
+			name:   "when preferences are valid, it should save them",
+			params: &request.UpdatePreferences{InterestedIn: []string{"Man", "non_binary", "man"}, MinAge: intPtr(25), MaxAge: intPtr(25), MaxDistanceKM: intPtr(10)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserDriven := fake.NewFakeUserDriven()
+			userID, _ := fakeUserDriven.Create(context.Background(), &entity.User{Username: "preferences", Gender: entity.GenderMan}, nil)
+			ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: userID})
+			policy := tt.policy
+			if policy == nil {
+				policy = new(entity.UserPolicy)
+			}
+			pu := usecase.NewPreferencesUsecase(fakeUserDriven, fake.NewFakePreferencesStore(), policy)
+
+			got, err := pu.UpdateMyPreferences(ctx, tt.params)
+
+			assert := assert.New(t)
+			if tt.wantErrMsg != "" {
+				assert.Nil(got)
+				assert.IsType(new(customerror.ValidationError), err)
+				assert.EqualError(err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal([]string{"man", "non_binary"}, got.InterestedIn, "it should only keep a gender once")
+			assert.Equal(25, got.MinAge)
+			assert.Equal(25, got.MaxAge)
+			assert.Equal(10, got.MaxDistanceKM)
+		})
+	}
+}
+
+func TestPreferencesUsecase_GetMyPreferences(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	userID, _ := fakeUserDriven.Create(context.Background(), &entity.User{Username: "preferences", Gender: entity.GenderWoman}, nil)
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: userID})
+	pu := usecase.NewPreferencesUsecase(fakeUserDriven, fake.NewFakePreferencesStore(), new(entity.UserPolicy))
+
+	_, err := pu.GetMyPreferences(context.Background())
+	assert.IsType(new(customerror.UnauthorizedError), err)
+
+	got, err := pu.GetMyPreferences(ctx)
+	assert.NoError(err, "it should fall back to the defaults when none are stored")
+	assert.Equal([]string{"man"}, got.InterestedIn)
+	assert.Equal(18, got.MinAge)
+	assert.Equal(28, got.MaxAge)
+	assert.Equal(50, got.MaxDistanceKM)
+
+	maxAge := 35
+	_, err = pu.UpdateMyPreferences(ctx, &request.UpdatePreferences{MaxAge: &maxAge})
+	assert.NoError(err)
+
+	got, err = pu.GetMyPreferences(ctx)
+	assert.NoError(err)
+	assert.Equal(35, got.MaxAge)
+	assert.Equal([]string{"man"}, got.InterestedIn, "it should keep the fields that are not sent")
+
+	_, err = pu.GetMyPreferences(context.WithValue(ctx, fake.ContextType("preferences_error"), true))
+	assert.Error(err)
+	_, err = pu.UpdateMyPreferences(context.WithValue(ctx, fake.ContextType("save_preferences_error"), true), &request.UpdatePreferences{MaxAge: &maxAge})
+	assert.Error(err)
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+	"time"
+)
+
+func (uu UserWriterUsecase) RefreshUserToken(ctx context.Context, params *request.RefreshUserToken) (*response.Token, error) {
+	refreshToken, err := uu.refreshTokenStore.GetByHash(ctx, uu.refreshTokenProvider.Hash(params.RefreshToken))
+	if err != nil {
+		return nil, customerror.NewUnauthorizedError("invalid refresh token")
+	}
+
+	if refreshToken.IsRevoked() || refreshToken.IsExpired(time.Now()) {
+		return nil, customerror.NewUnauthorizedError("invalid refresh token")
+	}
+
+	// a refresh token can only be used once, seeing it again means it leaked
+	// so every token issued from the same login is revoked.
+	if refreshToken.IsUsed() {
+		return nil, uu.revokeReusedFamily(ctx, refreshToken)
+	}
+
+	marked, err := uu.refreshTokenStore.MarkUsed(ctx, refreshToken.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, uu.revokeReusedFamily(ctx, refreshToken)
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, refreshToken.UserID)
+	if err != nil {
+		return nil, customerror.NewUnauthorizedError("invalid refresh token")
+	}
+
+	return uu.issueToken(ctx, user, &entity.Session{
+		ID:        refreshToken.FamilyID,
+		UserAgent: params.UserAgent,
+		IPAddress: params.ClientIP,
+	})
+}
+
+func (uu UserWriterUsecase) revokeReusedFamily(ctx context.Context, refreshToken *entity.RefreshToken) error {
+	if err := uu.endSession(ctx, refreshToken.FamilyID, refreshToken.ExpiresAt); err != nil {
+		return err
+	}
+	return customerror.NewUnauthorizedError("refresh token already used")
+}
@@ -0,0 +1,116 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_RefreshUserToken(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	refreshTokenProvider := new(fake.FakeRefreshTokenProvider)
+	refreshTokenStore := fake.NewFakeRefreshTokenStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		refreshTokenProvider,
+		refreshTokenStore,
+		memory.NewTokenRevocationStore(),
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	validPassword := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(validPassword), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	login := func() string {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   validPassword,
+		})
+		assert.NoError(err)
+		return token.RefreshToken
+	}
+
+	t.Run("when refresh token unknown, it should return unauthorized error", func(t *testing.T) {
+		got, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: "unknown"})
+		assert.Nil(got)
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when refresh token expired, it should return unauthorized error", func(t *testing.T) {
+		refreshToken := login()
+		stored, _ := refreshTokenStore.GetByHash(context.Background(), refreshTokenProvider.Hash(refreshToken))
+		stored.ExpiresAt = time.Now().Add(-time.Minute)
+
+		got, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.Nil(got)
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when refresh token valid, it should rotate the refresh token in the same family", func(t *testing.T) {
+		refreshToken := login()
+		got, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.NoError(err)
+		assert.NotEmpty(got.Token)
+		assert.NotEqual(refreshToken, got.RefreshToken)
+
+		previous, _ := refreshTokenStore.GetByHash(context.Background(), refreshTokenProvider.Hash(refreshToken))
+		rotated, _ := refreshTokenStore.GetByHash(context.Background(), refreshTokenProvider.Hash(got.RefreshToken))
+		assert.True(previous.IsUsed())
+		assert.Equal(previous.FamilyID, rotated.FamilyID)
+	})
+
+	t.Run("when used refresh token replayed, it should revoke the whole family", func(t *testing.T) {
+		refreshToken := login()
+		rotated, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.NoError(err)
+
+		got, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.Nil(got)
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		got, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: rotated.RefreshToken})
+		assert.Nil(got)
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when other family revoked, it should not affect current family", func(t *testing.T) {
+		refreshToken := login()
+		got, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: refreshToken})
+		assert.NoError(err)
+		assert.NotEmpty(got.RefreshToken)
+	})
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/param/request"
+	"context"
+)
+
+// ChangeUserRole grants a role to another user. The access tokens they hold still carry the old role
+// so every session of theirs is revoked, the next login issues a token with the new one.
+func (uu UserWriterUsecase) ChangeUserRole(ctx context.Context, params *request.ChangeUserRole) error {
+	callerID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+	if params.UserID == callerID {
+		return customerror.NewValidationErrorWithMessage("id", "cannot change your own role")
+	}
+
+	user, err := uu.userGetter.GetByID(ctx, params.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = user.ChangeRole(params.Role)
+	if err != nil {
+		return err
+	}
+
+	err = uu.userWriter.UpdateRole(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return uu.revokeAllSessions(ctx, user.ID)
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserWriterUsecase_ChangeUserRole(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	revocationStore := memory.NewTokenRevocationStore()
+	sessionStore := fake.NewFakeSessionStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		nil,
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		revocationStore,
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		sessionStore,
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	user := &entity.User{Username: faker.Username(), Role: entity.RoleUser}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+	adminCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID + 1, Roles: []entity.Role{entity.RoleAdmin}})
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		err := uu.ChangeUserRole(context.Background(), &request.ChangeUserRole{UserID: user.ID, Role: "moderator"})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when caller changes their own role, it should return validation error", func(t *testing.T) {
+		ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+		err := uu.ChangeUserRole(ctx, &request.ChangeUserRole{UserID: user.ID, Role: "admin"})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when user does not exist, it should return not found", func(t *testing.T) {
+		err := uu.ChangeUserRole(adminCtx, &request.ChangeUserRole{UserID: user.ID + 2, Role: "moderator"})
+		assert.ErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("when role is unknown, it should return validation error", func(t *testing.T) {
+		err := uu.ChangeUserRole(adminCtx, &request.ChangeUserRole{UserID: user.ID, Role: "superuser"})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.EqualError(err, "role: can only be user or moderator or admin")
+		assert.Equal(entity.RoleUser, user.Role)
+	})
+
+	t.Run("when role changed, it should store it and revoke the tokens carrying the old role", func(t *testing.T) {
+		oldClaims := &entity.UserClaims{UserID: user.ID, TokenID: "token-id", IssuedAt: time.Now().Add(-time.Minute)}
+
+		err := uu.ChangeUserRole(adminCtx, &request.ChangeUserRole{UserID: user.ID, Role: "moderator"})
+		assert.NoError(err)
+
+		stored, err := fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.NoError(err)
+		assert.Equal(entity.RoleModerator, stored.Role)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), oldClaims)
+		assert.NoError(err)
+		assert.True(revoked)
+	})
+	t.Run("when role changed within the second a token was issued, it should revoke that token", func(t *testing.T) {
+		session := &entity.Session{ID: "role-session-id", UserID: user.ID, ExpiresAt: time.Now().Add(time.Hour)}
+		assert.NoError(sessionStore.Create(context.Background(), session))
+		claims := &entity.UserClaims{UserID: user.ID, TokenID: "same-second-token-id", SessionID: session.ID, IssuedAt: time.Now().Truncate(time.Second)}
+
+		err := uu.ChangeUserRole(adminCtx, &request.ChangeUserRole{UserID: user.ID, Role: "user"})
+		assert.NoError(err)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), claims)
+		assert.NoError(err)
+		assert.True(revoked)
+	})
+}
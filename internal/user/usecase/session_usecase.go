@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// ListSessions returns the devices the authenticated user is signed in on, most recently seen first.
+func (uu UserWriterUsecase) ListSessions(ctx context.Context) ([]*response.Session, error) {
+	claims, ok := authcontext.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	sessions, err := uu.sessionStore.ListActiveByUserID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*response.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &response.Session{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			LastSeenAt: session.LastSeenAt,
+			CreatedAt:  session.CreatedAt,
+			Current:    session.ID == claims.SessionID,
+		})
+	}
+	return result, nil
+}
+
+// RevokeSession signs the authenticated user out of one of their sessions,
+// sessions of other users are reported as not found.
+func (uu UserWriterUsecase) RevokeSession(ctx context.Context, params *request.RevokeSession) error {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	session, err := uu.sessionStore.GetByID(ctx, params.SessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != userID {
+		return sql.ErrNoRows
+	}
+
+	return uu.endSession(ctx, session.ID, session.ExpiresAt)
+}
+
+// endSession revokes the refresh token family of the session and rejects every access token
+// issued in it until expiresAt, by then the last refresh token of the family has expired anyway.
+func (uu UserWriterUsecase) endSession(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	if err := uu.refreshTokenStore.RevokeFamily(ctx, sessionID); err != nil {
+		return err
+	}
+
+	if err := uu.sessionStore.Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return uu.tokenRevocationStore.Revoke(ctx, sessionID, expiresAt)
+}
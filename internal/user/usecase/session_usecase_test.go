@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_Sessions(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	revocationStore := memory.NewTokenRevocationStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		revocationStore,
+		fake.NewFakeLoginAttemptStore(),
+		new(entity.LoginThrottle),
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		new(entity.UserPolicy),
+	)
+
+	password := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+
+	login := func(deviceName, userAgent string) string {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   password,
+			ClientIP:   "10.0.0.1",
+			DeviceName: deviceName,
+			UserAgent:  userAgent,
+		})
+		assert.NoError(err)
+		return token.RefreshToken
+	}
+	phoneRefreshToken := login("Pixel 8", "okhttp/4.12.0")
+	laptopRefreshToken := login("Laptop", "Mozilla/5.0")
+
+	sessionsByDevice := func(ctx context.Context) map[string]*response.Session {
+		sessions, err := uu.ListSessions(ctx)
+		assert.NoError(err)
+		result := make(map[string]*response.Session, len(sessions))
+		for _, session := range sessions {
+			result[session.DeviceName] = session
+		}
+		return result
+	}
+	userCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+	phoneSession := sessionsByDevice(userCtx)["Pixel 8"]
+	laptopSession := sessionsByDevice(userCtx)["Laptop"]
+	phoneCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID, SessionID: phoneSession.ID})
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		_, err := uu.ListSessions(context.Background())
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		err = uu.RevokeSession(context.Background(), &request.RevokeSession{SessionID: phoneSession.ID})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when listing sessions, it should return every device and flag the current one", func(t *testing.T) {
+		sessions := sessionsByDevice(phoneCtx)
+		assert.Len(sessions, 2)
+		assert.True(sessions["Pixel 8"].Current)
+		assert.Equal("okhttp/4.12.0", sessions["Pixel 8"].UserAgent)
+		assert.Equal("10.0.0.1", sessions["Pixel 8"].IPAddress)
+		assert.False(sessions["Laptop"].Current)
+	})
+
+	t.Run("when token refreshed, it should keep the session and record where it was seen", func(t *testing.T) {
+		token, err := uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{
+			RefreshToken: phoneRefreshToken,
+			ClientIP:     "10.0.0.2",
+			UserAgent:    "okhttp/4.12.1",
+		})
+		assert.NoError(err)
+		phoneRefreshToken = token.RefreshToken
+
+		sessions := sessionsByDevice(phoneCtx)
+		assert.Len(sessions, 2)
+		assert.Equal("10.0.0.2", sessions["Pixel 8"].IPAddress)
+		assert.Equal("okhttp/4.12.1", sessions["Pixel 8"].UserAgent)
+	})
+
+	t.Run("when session belongs to another user, it should return not found", func(t *testing.T) {
+		otherCtx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID + 1})
+		err := uu.RevokeSession(otherCtx, &request.RevokeSession{SessionID: laptopSession.ID})
+		assert.ErrorIs(err, sql.ErrNoRows)
+
+		err = uu.RevokeSession(phoneCtx, &request.RevokeSession{SessionID: "unknown"})
+		assert.ErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("when session revoked, it should reject its tokens on subsequent requests", func(t *testing.T) {
+		err := uu.RevokeSession(phoneCtx, &request.RevokeSession{SessionID: laptopSession.ID})
+		assert.NoError(err)
+
+		revoked, err := revocationStore.IsRevoked(context.Background(), &entity.UserClaims{
+			UserID:    user.ID,
+			TokenID:   faker.UUIDHyphenated(),
+			SessionID: laptopSession.ID,
+			IssuedAt:  time.Now(),
+		})
+		assert.NoError(err)
+		assert.True(revoked)
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: laptopRefreshToken})
+		assert.IsType(new(customerror.UnauthorizedError), err)
+
+		sessions := sessionsByDevice(phoneCtx)
+		assert.Len(sessions, 1)
+		assert.Contains(sessions, "Pixel 8")
+
+		_, err = uu.RefreshUserToken(context.Background(), &request.RefreshUserToken{RefreshToken: phoneRefreshToken})
+		assert.NoError(err)
+	})
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// EnrollTOTP generates a new secret for the authenticated user. The secret stays pending,
+// and login keeps working with the password only, until it is confirmed with ConfirmTOTP.
+func (uu UserWriterUsecase) EnrollTOTP(ctx context.Context) (*response.TOTPEnrollment, error) {
+	user, err := uu.authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsTOTPEnabled() {
+		return nil, customerror.NewValidationErrorWithMessage("totp", "two-factor authentication is already enabled")
+	}
+
+	secret, err := uu.twoFactorProvider.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret = secret
+	user.TOTPEnabledAt = nil
+	err = uu.userWriter.UpdateTOTP(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TOTPEnrollment{
+		Secret: secret,
+		URI:    uu.twoFactorProvider.URI(secret, user.Username),
+	}, nil
+}
+
+// ConfirmTOTP enables two-factor authentication once the user proves the authenticator app
+// is set up, and returns the recovery codes. They are only shown this once since only their hash is kept.
+func (uu UserWriterUsecase) ConfirmTOTP(ctx context.Context, params *request.ConfirmTOTP) (*response.TOTPRecoveryCodes, error) {
+	user, err := uu.authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsTOTPEnabled() {
+		return nil, customerror.NewValidationErrorWithMessage("totp", "two-factor authentication is already enabled")
+	}
+	if user.TOTPSecret == "" {
+		return nil, customerror.NewValidationErrorWithMessage("totp", "two-factor authentication enrollment was not started")
+	}
+
+	now := time.Now()
+	if !uu.twoFactorProvider.Validate(user.TOTPSecret, params.Code, now) {
+		return nil, invalidOTPError()
+	}
+
+	codes, err := uu.twoFactorProvider.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	codeHashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		codeHashes = append(codeHashes, uu.twoFactorProvider.HashRecoveryCode(code))
+	}
+	err = uu.recoveryCodeStore.Replace(ctx, user.ID, codeHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPEnabledAt = &now
+	err = uu.userWriter.UpdateTOTP(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TOTPRecoveryCodes{Codes: codes}, nil
+}
+
+// CompleteMFALogin exchanges the challenge returned by GenerateUserToken and a TOTP or recovery code for a token.
+// Wrong codes count toward both the challenge attempt limit and the login throttle of the account.
+func (uu UserWriterUsecase) CompleteMFALogin(ctx context.Context, params *request.CompleteMFALogin) (*response.Token, error) {
+	challenge, err := uu.mfaChallengeStore.GetByID(ctx, params.ChallengeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, invalidOTPError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !challenge.IsUsable(time.Now(), uu.userPolicy.MFAMaxAttempts) {
+		return nil, invalidOTPError()
+	}
+
+	user, err := uu.getMFAUser(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := uu.loginLimits(user.Username, params.ClientIP)
+	err = uu.checkLoginThrottle(ctx, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := uu.verifySecondFactor(ctx, user, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		err = uu.mfaChallengeStore.IncrementAttempts(ctx, challenge.ID)
+		if err != nil {
+			return nil, err
+		}
+		err = uu.countLoginFailure(ctx, limits)
+		if err != nil {
+			return nil, err
+		}
+		return nil, invalidOTPError()
+	}
+
+	consumed, err := uu.mfaChallengeStore.Consume(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, invalidOTPError()
+	}
+
+	return uu.completeLogin(ctx, user, limits, &entity.Session{
+		DeviceName: params.DeviceName,
+		UserAgent:  params.UserAgent,
+		IPAddress:  params.ClientIP,
+	})
+}
+
+func (uu UserWriterUsecase) createMFAChallenge(ctx context.Context, user *entity.User) (*response.Token, error) {
+	challenge := &entity.MFAChallenge{
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(uu.userPolicy.MFAChallengeTTL),
+	}
+	err := uu.mfaChallengeStore.Create(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Token{
+		MFAChallengeID:        challenge.ID,
+		MFAChallengeExpiresIn: int(uu.userPolicy.MFAChallengeTTL.Seconds()),
+	}, nil
+}
+
+// verifySecondFactor accepts the current TOTP code, or else spends one of the recovery codes.
+func (uu UserWriterUsecase) verifySecondFactor(ctx context.Context, user *entity.User, code string) (bool, error) {
+	if !user.IsTOTPEnabled() {
+		return false, nil
+	}
+	if uu.twoFactorProvider.Validate(user.TOTPSecret, code, time.Now()) {
+		return true, nil
+	}
+	return uu.recoveryCodeStore.Consume(ctx, user.ID, uu.twoFactorProvider.HashRecoveryCode(code))
+}
+
+func (uu UserWriterUsecase) authenticatedUser(ctx context.Context) (*entity.User, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+	return uu.userGetter.GetByID(ctx, userID)
+}
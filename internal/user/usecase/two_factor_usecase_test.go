@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"app/infra/encryption"
+	"app/infra/memory"
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/request"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserWriterUsecase_TwoFactor(t *testing.T) {
+	assert := assert.New(t)
+	fakeUserDriven := fake.NewFakeUserDriven()
+	loginAttemptStore := fake.NewFakeLoginAttemptStore()
+	uu := usecase.NewUserWriterUsecase(
+		fakeUserDriven,
+		new(encryption.BcryptEncryption),
+		fakeUserDriven,
+		new(fake.FakeTokenProvider),
+		new(fake.FakeRefreshTokenProvider),
+		fake.NewFakeRefreshTokenStore(),
+		memory.NewTokenRevocationStore(),
+		loginAttemptStore,
+		&entity.LoginThrottle{
+			Username: entity.LoginThrottlePolicy{FreeAttempts: 10, Window: time.Hour},
+		},
+		new(fake.FakeOTPProvider),
+		fake.NewFakeOTPStore(),
+		new(fake.FakeSMSSender),
+		new(fake.FakePasswordResetTicketProvider),
+		fake.NewFakePasswordResetTicketStore(),
+		new(fake.FakeTwoFactorProvider),
+		fake.NewFakeRecoveryCodeStore(),
+		fake.NewFakeMFAChallengeStore(),
+		fake.NewFakeSessionStore(),
+		fake.NewFakePasswordHistoryStore(),
+		&entity.UserPolicy{MFAChallengeTTL: 5 * time.Minute, MFAMaxAttempts: 3, DeletionGracePeriod: time.Hour},
+	)
+
+	password := faker.Password()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	user := &entity.User{
+		Username: faker.Username(),
+		Name:     faker.Name(),
+		Password: string(encryptedPassword),
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(err)
+	ctx := authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID})
+
+	login := func() string {
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   password,
+		})
+		assert.NoError(err)
+		return token.MFAChallengeID
+	}
+
+	t.Run("when no authenticated user, it should return unauthorized error", func(t *testing.T) {
+		_, err := uu.EnrollTOTP(context.Background())
+		assert.IsType(new(customerror.UnauthorizedError), err)
+	})
+
+	t.Run("when confirming before enrolling, it should return validation error", func(t *testing.T) {
+		_, err := uu.ConfirmTOTP(ctx, &request.ConfirmTOTP{Code: fake.FakeTOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when enrollment is not confirmed, it should still login with the password only", func(t *testing.T) {
+		enrollment, err := uu.EnrollTOTP(ctx)
+		assert.NoError(err)
+		assert.NotEmpty(enrollment.Secret)
+		assert.Contains(enrollment.URI, "otpauth://totp/")
+
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   password,
+		})
+		assert.NoError(err)
+		assert.NotEmpty(token.Token)
+		assert.Empty(token.MFAChallengeID)
+	})
+
+	t.Run("when confirm code is wrong, it should not enable two-factor authentication", func(t *testing.T) {
+		codes, err := uu.ConfirmTOTP(ctx, &request.ConfirmTOTP{Code: "000000"})
+		assert.IsType(new(customerror.ValidationError), err)
+		assert.Nil(codes)
+	})
+
+	t.Run("when confirm code is valid, it should return recovery codes and require the second factor", func(t *testing.T) {
+		codes, err := uu.ConfirmTOTP(ctx, &request.ConfirmTOTP{Code: fake.FakeTOTPCode})
+		assert.NoError(err)
+		assert.NotEmpty(codes.Codes)
+
+		_, err = uu.EnrollTOTP(ctx)
+		assert.IsType(new(customerror.ValidationError), err)
+
+		token, err := uu.GenerateUserToken(context.Background(), &request.GenerateUserToken{
+			Identifier: user.Username,
+			Password:   password,
+		})
+		assert.NoError(err)
+		assert.Empty(token.Token)
+		assert.Empty(token.RefreshToken)
+		assert.NotEmpty(token.MFAChallengeID)
+		assert.Equal(300, token.MFAChallengeExpiresIn)
+	})
+
+	t.Run("when totp code is valid, it should issue token once per challenge", func(t *testing.T) {
+		challengeID := login()
+
+		token, err := uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: challengeID, Code: fake.FakeTOTPCode})
+		assert.NoError(err)
+		assert.NotEmpty(token.Token)
+		assert.NotEmpty(token.RefreshToken)
+
+		_, err = uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: challengeID, Code: fake.FakeTOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when recovery code is used, it should only be accepted once", func(t *testing.T) {
+		token, err := uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: login(), Code: "recovery-1"})
+		assert.NoError(err)
+		assert.NotEmpty(token.Token)
+
+		_, err = uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: login(), Code: "recovery-1"})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when code is wrong too many times, it should expire the challenge and count login failures", func(t *testing.T) {
+		challengeID := login()
+		before, err := loginAttemptStore.Get(context.Background(), "username:"+user.Username)
+		assert.NoError(err)
+
+		for i := 0; i < 3; i++ {
+			_, err := uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: challengeID, Code: "000000"})
+			assert.IsType(new(customerror.ValidationError), err)
+		}
+
+		_, err = uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: challengeID, Code: fake.FakeTOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+
+		attempt, err := loginAttemptStore.Get(context.Background(), "username:"+user.Username)
+		assert.NoError(err)
+		assert.Equal(before.FailedCount+3, attempt.FailedCount)
+	})
+
+	t.Run("when challenge is unknown, it should return validation error", func(t *testing.T) {
+		_, err := uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: "unknown", Code: fake.FakeTOTPCode})
+		assert.IsType(new(customerror.ValidationError), err)
+	})
+
+	t.Run("when deleted account logs in with the password only, it should stay deleted until the second factor passes", func(t *testing.T) {
+		_, err := uu.DeleteAccount(ctx)
+		assert.NoError(err)
+
+		challengeID := login()
+		_, err = fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.ErrorIs(err, sql.ErrNoRows)
+
+		token, err := uu.CompleteMFALogin(context.Background(), &request.CompleteMFALogin{ChallengeID: challengeID, Code: fake.FakeTOTPCode})
+		assert.NoError(err)
+		assert.NotEmpty(token.Token)
+
+		restored, err := fakeUserDriven.GetByID(context.Background(), user.ID)
+		assert.NoError(err)
+		assert.False(restored.IsDeleted())
+	})
+}
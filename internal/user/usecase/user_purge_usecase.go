@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	profileentity "app/internal/profile/entity"
+	profiledriven "app/internal/profile/port/driven"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"time"
+)
+
+// purgeBatchSize bounds how many users are removed per statement so a large backlog does not hold one long transaction.
+const purgeBatchSize = 100
+
+type UserPurgeUsecase struct {
+	userPurger      driven.UserPurger
+	dataExportStore driven.DataExportStore
+	fileStorage     driven.FileStorage
+	blobStorage     profiledriven.BlobStorage
+	userPolicy      *entity.UserPolicy
+}
+
+func NewUserPurgeUsecase(
+	userPurger driven.UserPurger,
+	dataExportStore driven.DataExportStore,
+	fileStorage driven.FileStorage,
+	blobStorage profiledriven.BlobStorage,
+	userPolicy *entity.UserPolicy,
+) *UserPurgeUsecase {
+	return &UserPurgeUsecase{
+		userPurger:      userPurger,
+		dataExportStore: dataExportStore,
+		fileStorage:     fileStorage,
+		blobStorage:     blobStorage,
+		userPolicy:      userPolicy,
+	}
+}
+
+// PurgeDeletedUsers permanently removes the accounts whose deletion grace period has ended.
+// Stored files are removed before the rows, a storage failure leaves the account to the next run
+// instead of orphaning files nothing points to anymore.
+func (up UserPurgeUsecase) PurgeDeletedUsers(ctx context.Context) (int64, error) {
+	deletedBefore := time.Now().Add(-up.userPolicy.DeletionGracePeriod)
+
+	var total int64
+	for {
+		userIDs, err := up.userPurger.ListPurgeable(ctx, deletedBefore, purgeBatchSize)
+		if err != nil || len(userIDs) == 0 {
+			return total, err
+		}
+
+		for _, userID := range userIDs {
+			err = up.deleteUserFiles(ctx, userID)
+			if err != nil {
+				return total, err
+			}
+		}
+
+		purged, err := up.userPurger.Purge(ctx, userIDs, deletedBefore)
+		total += purged
+		if err != nil || len(userIDs) < purgeBatchSize {
+			return total, err
+		}
+	}
+}
+
+// PurgeExpiredDataExports removes the archives that can no longer be downloaded together with their records.
+func (up UserPurgeUsecase) PurgeExpiredDataExports(ctx context.Context) (int64, error) {
+	now := time.Now()
+
+	var total int64
+	for {
+		exports, err := up.dataExportStore.ListExpired(ctx, now, purgeBatchSize)
+		if err != nil || len(exports) == 0 {
+			return total, err
+		}
+
+		ids := make([]string, 0, len(exports))
+		for _, export := range exports {
+			err = up.fileStorage.Delete(ctx, export.FileKey())
+			if err != nil {
+				return total, err
+			}
+			ids = append(ids, export.ID)
+		}
+
+		deleted, err := up.dataExportStore.Delete(ctx, ids)
+		total += deleted
+		if err != nil || len(exports) < purgeBatchSize {
+			return total, err
+		}
+	}
+}
+
+func (up UserPurgeUsecase) deleteUserFiles(ctx context.Context, userID int64) error {
+	err := up.blobStorage.DeletePrefix(ctx, profileentity.PhotoKeyPrefix(userID))
+	if err != nil {
+		return err
+	}
+	return up.fileStorage.DeletePrefix(ctx, entity.DataExportKeyPrefix(userID))
+}
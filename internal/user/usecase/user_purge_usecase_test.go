@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	"app/internal/user/entity"
+	"app/internal/user/usecase"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserPurgeUsecase_PurgeDeletedUsers(t *testing.T) {
+	gracePeriod := 30 * 24 * time.Hour
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		deletedAt  *time.Time
+		want       int64
+		wantErr    bool
+		wantExists bool
+		wantFiles  bool
+	}{
+		{
+			name:       "when listing purgeable users fails, it should return error",
+			ctx:        context.WithValue(context.Background(), fake.ContextType("list_purgeable_error"), true),
+			deletedAt:  timePtr(time.Now().Add(-gracePeriod - time.Hour)),
+			want:       0,
+			wantErr:    true,
+			wantExists: true,
+			wantFiles:  true,
+		},
+		{
+			name:       "when deleting photos fails, it should keep the user to retry later",
+			ctx:        context.WithValue(context.Background(), fake.ContextType("delete_prefix_error"), true),
+			deletedAt:  timePtr(time.Now().Add(-gracePeriod - time.Hour)),
+			want:       0,
+			wantErr:    true,
+			wantExists: true,
+			wantFiles:  true,
+		},
+		{
+			name:       "when purge fails, it should return error",
+			ctx:        context.WithValue(context.Background(), fake.ContextType("purge_error"), true),
+			deletedAt:  timePtr(time.Now().Add(-gracePeriod - time.Hour)),
+			want:       0,
+			wantErr:    true,
+			wantExists: true,
+			wantFiles:  false,
+		},
+		{
+			name:       "when user is not deleted, it should keep it",
+			ctx:        context.Background(),
+			deletedAt:  nil,
+			want:       0,
+			wantErr:    false,
+			wantExists: true,
+			wantFiles:  true,
+		},
+		{
+			name:       "when deleted user is still in the grace period, it should keep it",
+			ctx:        context.Background(),
+			deletedAt:  timePtr(time.Now().Add(-time.Hour)),
+			want:       0,
+			wantErr:    false,
+			wantExists: true,
+			wantFiles:  true,
+		},
+		{
+			name:       "when grace period of deleted user has ended, it should remove it",
+			ctx:        context.Background(),
+			deletedAt:  timePtr(time.Now().Add(-gracePeriod - time.Hour)),
+			want:       1,
+			wantErr:    false,
+			wantExists: false,
+			wantFiles:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserDriven := fake.NewFakeUserDriven()
+			user := &entity.User{Username: faker.Username(), DeletedAt: tt.deletedAt}
+			_, err := fakeUserDriven.Create(context.Background(), user, nil)
+			assert := assert.New(t)
+			assert.NoError(err)
+
+			fileStorage := fake.NewFakeFileStorage()
+			photoKey := fmt.Sprintf("photos/%d/photo-id/thumbnail.jpg", user.ID)
+			exportKey := (&entity.DataExport{ID: "export-id", UserID: user.ID}).FileKey()
+			for _, key := range []string{photoKey, exportKey} {
+				assert.NoError(fileStorage.Put(context.Background(), key, strings.NewReader("content")))
+			}
+
+			up := usecase.NewUserPurgeUsecase(fakeUserDriven, fake.NewFakeDataExportStore(), fileStorage, fileStorage, &entity.UserPolicy{DeletionGracePeriod: gracePeriod})
+			got, err := up.PurgeDeletedUsers(tt.ctx)
+
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+
+			_, getErr := fakeUserDriven.GetByUsername(context.Background(), user.Username)
+			_, getDeletedErr := fakeUserDriven.GetDeletedByUsername(context.Background(), user.Username)
+			assert.Equal(tt.wantExists, getErr == nil || getDeletedErr == nil)
+			assert.Equal(tt.wantFiles, slices.Contains(fileStorage.Keys(), photoKey))
+			assert.Equal(tt.wantFiles, slices.Contains(fileStorage.Keys(), exportKey))
+		})
+	}
+}
+
+func TestUserPurgeUsecase_PurgeExpiredDataExports(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		expiresAt time.Time
+		want      int64
+		wantErr   bool
+		wantKept  bool
+	}{
+		{
+			name:      "when listing expired exports fails, it should return error",
+			ctx:       context.WithValue(context.Background(), fake.ContextType("list_expired_error"), true),
+			expiresAt: time.Now().Add(-time.Hour),
+			want:      0,
+			wantErr:   true,
+			wantKept:  true,
+		},
+		{
+			name:      "when export has not expired, it should keep it",
+			ctx:       context.Background(),
+			expiresAt: time.Now().Add(time.Hour),
+			want:      0,
+			wantErr:   false,
+			wantKept:  true,
+		},
+		{
+			name:      "when export expired, it should remove the archive and the record",
+			ctx:       context.Background(),
+			expiresAt: time.Now().Add(-time.Hour),
+			want:      1,
+			wantErr:   false,
+			wantKept:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			dataExportStore := fake.NewFakeDataExportStore()
+			fileStorage := fake.NewFakeFileStorage()
+			export := &entity.DataExport{UserID: 1, ExpiresAt: tt.expiresAt}
+			assert.NoError(dataExportStore.Create(context.Background(), export))
+			assert.NoError(fileStorage.Put(context.Background(), export.FileKey(), strings.NewReader("content")))
+
+			up := usecase.NewUserPurgeUsecase(fake.NewFakeUserDriven(), dataExportStore, fileStorage, fileStorage, new(entity.UserPolicy))
+			got, err := up.PurgeExpiredDataExports(tt.ctx)
+
+			assert.Equal(tt.wantErr, err != nil)
+			assert.Equal(tt.want, got)
+
+			_, getErr := dataExportStore.GetByID(context.Background(), export.ID)
+			assert.Equal(tt.wantKept, getErr == nil)
+			assert.Equal(tt.wantKept, slices.Contains(fileStorage.Keys(), export.FileKey()))
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
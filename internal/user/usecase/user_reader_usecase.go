@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driven"
+	"context"
+)
+
+type UserReaderUsecase struct {
+	userGetter driven.UserGetter
+}
+
+func NewUserReaderUsecase(userGetter driven.UserGetter) *UserReaderUsecase {
+	return &UserReaderUsecase{
+		userGetter: userGetter,
+	}
+}
+
+// GetMe returns the authenticated user.
+func (ur UserReaderUsecase) GetMe(ctx context.Context) (*response.User, error) {
+	userID, ok := authcontext.UserIDFromContext(ctx)
+	if !ok {
+		return nil, customerror.NewUnauthorizedError("missing authenticated user")
+	}
+
+	user, err := ur.userGetter.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(user), nil
+}
+
+func toUserResponse(user *entity.User) *response.User {
+	return &response.User{
+		ID:               user.ID,
+		Name:             user.Name,
+		Username:         user.Username,
+		PhoneNumber:      user.PhoneNumber,
+		Gender:           user.Gender.String(),
+		ShowGender:       user.ShowGender,
+		Orientation:      user.Orientation.String(),
+		Role:             user.Role.String(),
+		PhoneVerified:    user.IsPhoneVerified(),
+		TwoFactorEnabled: user.IsTOTPEnabled(),
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
+	}
+}
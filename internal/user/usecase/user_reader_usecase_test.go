@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"app/internal/adapter/fake"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/usecase"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserReaderUsecase_GetMe(t *testing.T) {
+	fakeUserDriven := fake.NewFakeUserDriven()
+	verifiedAt := time.Now()
+	user := &entity.User{
+		Username:        faker.Username(),
+		Name:            faker.Name(),
+		PhoneNumber:     "+6281234567890",
+		Password:        "$2a$10$hash",
+		PhoneVerifiedAt: &verifiedAt,
+	}
+	_, err := fakeUserDriven.Create(context.Background(), user, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name:    "when no authenticated user, it should return unauthorized error",
+			ctx:     context.Background(),
+			wantErr: customerror.NewUnauthorizedError("missing authenticated user"),
+		},
+		{
+			name:    "when user no longer exists, it should return not found",
+			ctx:     authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID + 1}),
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "when user exists, it should return the user",
+			ctx:  authcontext.WithClaims(context.Background(), &entity.UserClaims{UserID: user.ID}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usecase.NewUserReaderUsecase(fakeUserDriven)
+			got, err := ur.GetMe(tt.ctx)
+
+			assert := assert.New(t)
+			if tt.wantErr != nil {
+				assert.Nil(got)
+				assert.IsType(tt.wantErr, err)
+				assert.EqualError(err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(user.ID, got.ID)
+			assert.Equal(user.Username, got.Username)
+			assert.Equal(user.PhoneNumber, got.PhoneNumber)
+			assert.True(got.PhoneVerified)
+			assert.False(got.TwoFactorEnabled)
+		})
+	}
+}
@@ -6,22 +6,72 @@ import (
 )
 
 type UserWriterUsecase struct {
-	userWriter    driven.UserWriter
-	encryptor     driven.Encyptor
-	userGetter    driven.UserGetter
-	tokenProvider driven.TokenProvider[*entity.User]
+	userWriter                  driven.UserWriter
+	encryptor                   driven.Encyptor
+	userGetter                  driven.UserGetter
+	tokenProvider               driven.TokenProvider[*entity.AccessTokenSubject]
+	refreshTokenProvider        driven.RefreshTokenProvider
+	refreshTokenStore           driven.RefreshTokenStore
+	tokenRevocationStore        driven.TokenRevocationStore
+	loginAttemptStore           driven.LoginAttemptStore
+	loginThrottle               *entity.LoginThrottle
+	otpProvider                 driven.OTPProvider
+	otpStore                    driven.OTPStore
+	smsSender                   driven.SMSSender
+	passwordResetTicketProvider driven.PasswordResetTicketProvider
+	passwordResetTicketStore    driven.PasswordResetTicketStore
+	twoFactorProvider           driven.TwoFactorProvider
+	recoveryCodeStore           driven.RecoveryCodeStore
+	mfaChallengeStore           driven.MFAChallengeStore
+	sessionStore                driven.SessionStore
+	passwordHistoryStore        driven.PasswordHistoryStore
+	userPolicy                  *entity.UserPolicy
+	dummyPassword               *dummyPasswordHash
 }
 
 func NewUserWriterUsecase(
 	userWriter driven.UserWriter,
 	encryptor driven.Encyptor,
 	userGetter driven.UserGetter,
-	tokenProvider driven.TokenProvider[*entity.User],
+	tokenProvider driven.TokenProvider[*entity.AccessTokenSubject],
+	refreshTokenProvider driven.RefreshTokenProvider,
+	refreshTokenStore driven.RefreshTokenStore,
+	tokenRevocationStore driven.TokenRevocationStore,
+	loginAttemptStore driven.LoginAttemptStore,
+	loginThrottle *entity.LoginThrottle,
+	otpProvider driven.OTPProvider,
+	otpStore driven.OTPStore,
+	smsSender driven.SMSSender,
+	passwordResetTicketProvider driven.PasswordResetTicketProvider,
+	passwordResetTicketStore driven.PasswordResetTicketStore,
+	twoFactorProvider driven.TwoFactorProvider,
+	recoveryCodeStore driven.RecoveryCodeStore,
+	mfaChallengeStore driven.MFAChallengeStore,
+	sessionStore driven.SessionStore,
+	passwordHistoryStore driven.PasswordHistoryStore,
+	userPolicy *entity.UserPolicy,
 ) *UserWriterUsecase {
 	return &UserWriterUsecase{
-		userWriter:    userWriter,
-		encryptor:     encryptor,
-		userGetter:    userGetter,
-		tokenProvider: tokenProvider,
+		userWriter:                  userWriter,
+		encryptor:                   encryptor,
+		userGetter:                  userGetter,
+		tokenProvider:               tokenProvider,
+		refreshTokenProvider:        refreshTokenProvider,
+		refreshTokenStore:           refreshTokenStore,
+		tokenRevocationStore:        tokenRevocationStore,
+		loginAttemptStore:           loginAttemptStore,
+		loginThrottle:               loginThrottle,
+		otpProvider:                 otpProvider,
+		otpStore:                    otpStore,
+		smsSender:                   smsSender,
+		passwordResetTicketProvider: passwordResetTicketProvider,
+		passwordResetTicketStore:    passwordResetTicketStore,
+		twoFactorProvider:           twoFactorProvider,
+		recoveryCodeStore:           recoveryCodeStore,
+		mfaChallengeStore:           mfaChallengeStore,
+		sessionStore:                sessionStore,
+		passwordHistoryStore:        passwordHistoryStore,
+		userPolicy:                  userPolicy,
+		dummyPassword:               new(dummyPasswordHash),
 	}
 }
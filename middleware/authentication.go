@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Authentication verifies the bearer token of every operation except publicOperations,
+// rejects revoked tokens and stores the authenticated caller in the context, see authcontext.ClaimsFromContext.
+// It only relies on the transport headers so it can be used by both HTTP and gRPC servers.
+// Routes registered outside kratos (e.g. srv.HandlePrefix) never go through it.
+func Authentication(
+	validator driven.TokenValidator[*entity.UserClaims],
+	revocationStore driven.TokenRevocationStore,
+	publicOperations ...string,
+) middleware.Middleware {
+	public := make(map[string]struct{}, len(publicOperations))
+	for _, operation := range publicOperations {
+		public[operation] = struct{}{}
+	}
+
+	return selector.Server(authenticate(validator, revocationStore)).
+		Match(func(_ context.Context, operation string) bool {
+			_, ok := public[operation]
+			return !ok
+		}).
+		Build()
+}
+
+func authenticate(validator driven.TokenValidator[*entity.UserClaims], revocationStore driven.TokenRevocationStore) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, customerror.NewUnauthorizedError("missing transport")
+			}
+
+			authorization := tr.RequestHeader().Get(authorizationHeader)
+			if !strings.HasPrefix(authorization, bearerPrefix) {
+				return nil, customerror.NewUnauthorizedError("missing bearer token")
+			}
+
+			claims, err := validator.Validate(strings.TrimPrefix(authorization, bearerPrefix))
+			if err != nil {
+				return nil, customerror.NewUnauthorizedError("invalid or expired token")
+			}
+
+			revoked, err := revocationStore.IsRevoked(ctx, claims)
+			if err != nil {
+				return nil, err
+			}
+			if revoked {
+				return nil, customerror.NewUnauthorizedError("token has been revoked")
+			}
+
+			return handler(authcontext.WithClaims(ctx, claims), req)
+		}
+	}
+}
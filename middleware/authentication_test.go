@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"app/infra/memory"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTokenValidator map[string]*entity.UserClaims
+
+func (v testTokenValidator) Validate(token string) (*entity.UserClaims, error) {
+	claims, ok := v[token]
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+func TestAuthentication(t *testing.T) {
+	now := time.Now()
+	validator := testTokenValidator{
+		"valid":   {UserID: 1, TokenID: "valid", IssuedAt: now},
+		"revoked": {UserID: 2, TokenID: "revoked", IssuedAt: now},
+	}
+	revocationStore := memory.NewTokenRevocationStore()
+	assert.NoError(t, revocationStore.Revoke(context.Background(), "revoked", now.Add(time.Hour)))
+
+	tests := []struct {
+		name          string
+		operation     string
+		authorization string
+		wantErr       error
+		wantClaims    *entity.UserClaims
+	}{
+		{
+			name:      "when operation is public, it should call the handler without a token",
+			operation: "/public",
+			wantErr:   nil,
+		},
+		{
+			name:      "when authorization header is missing, it should return unauthorized error",
+			operation: "/private",
+			wantErr:   new(customerror.UnauthorizedError),
+		},
+		{
+			name:          "when authorization header is not a bearer token, it should return unauthorized error",
+			operation:     "/private",
+			authorization: "Basic dXNlcjpwYXNz",
+			wantErr:       new(customerror.UnauthorizedError),
+		},
+		{
+			name:          "when token is invalid, it should return unauthorized error",
+			operation:     "/private",
+			authorization: "Bearer unknown",
+			wantErr:       new(customerror.UnauthorizedError),
+		},
+		{
+			name:          "when token is revoked, it should return unauthorized error",
+			operation:     "/private",
+			authorization: "Bearer revoked",
+			wantErr:       new(customerror.UnauthorizedError),
+		},
+		{
+			name:          "when token is valid, it should store the claims in the context",
+			operation:     "/private",
+			authorization: "Bearer valid",
+			wantClaims:    validator["valid"],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := testTransport{operation: tt.operation, header: testHeader{}, reply: testHeader{}}
+			if tt.authorization != "" {
+				tr.header.Set(authorizationHeader, tt.authorization)
+			}
+			ctx := transport.NewServerContext(context.Background(), tr)
+
+			called := false
+			var gotClaims *entity.UserClaims
+			handler := Authentication(validator, revocationStore, "/public")(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				gotClaims, _ = authcontext.ClaimsFromContext(ctx)
+				return nil, nil
+			})
+			_, err := handler(ctx, nil)
+
+			assert := assert.New(t)
+			if tt.wantErr == nil {
+				assert.NoError(err)
+				assert.True(called)
+				assert.Equal(tt.wantClaims, gotClaims)
+			} else {
+				assert.IsType(tt.wantErr, err)
+				assert.False(called)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// OperationPermissions maps an operation to the permission its caller needs.
+type OperationPermissions map[string]entity.Permission
+
+// Authorization rejects calls to the operations of permissions unless a role of the caller grants
+// the mapped permission, other operations pass through. It reads the caller stored by Authentication
+// so it has to be placed after it.
+func Authorization(permissions OperationPermissions) middleware.Middleware {
+	return selector.Server(authorize(permissions)).
+		Match(func(_ context.Context, operation string) bool {
+			_, ok := permissions[operation]
+			return ok
+		}).
+		Build()
+}
+
+func authorize(permissions OperationPermissions) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, customerror.NewUnauthorizedError("missing transport")
+			}
+
+			claims, ok := authcontext.ClaimsFromContext(ctx)
+			if !ok {
+				return nil, customerror.NewUnauthorizedError("missing authenticated user")
+			}
+
+			permission := permissions[tr.Operation()]
+			if !claims.HasPermission(permission) {
+				return nil, customerror.NewForbiddenError(fmt.Sprintf("missing permission %s", permission))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTransport struct {
+	operation string
+	header    testHeader
+	reply     testHeader
+}
+
+func (tr testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr testTransport) Endpoint() string                { return "" }
+func (tr testTransport) Operation() string               { return tr.operation }
+func (tr testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr testTransport) ReplyHeader() transport.Header   { return tr.reply }
+
+type testHeader nethttp.Header
+
+func (h testHeader) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h testHeader) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h testHeader) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h testHeader) Values(key string) []string { return nethttp.Header(h).Values(key) }
+func (h testHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestAuthorization(t *testing.T) {
+	permissions := OperationPermissions{"/admin": entity.PermissionManageRoles}
+	tests := []struct {
+		name      string
+		operation string
+		claims    *entity.UserClaims
+		wantErr   error
+	}{
+		{
+			name:      "when operation has no permission, it should let every caller through",
+			operation: "/public",
+			claims:    nil,
+			wantErr:   nil,
+		},
+		{
+			name:      "when caller is not authenticated, it should return unauthorized error",
+			operation: "/admin",
+			claims:    nil,
+			wantErr:   new(customerror.UnauthorizedError),
+		},
+		{
+			name:      "when no role of the caller grants the permission, it should return forbidden error",
+			operation: "/admin",
+			claims:    &entity.UserClaims{UserID: 1, Roles: []entity.Role{entity.RoleUser, entity.RoleModerator}},
+			wantErr:   new(customerror.ForbiddenError),
+		},
+		{
+			name:      "when a role of the caller grants the permission, it should call the handler",
+			operation: "/admin",
+			claims:    &entity.UserClaims{UserID: 1, Roles: []entity.Role{entity.RoleAdmin}},
+			wantErr:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := transport.NewServerContext(context.Background(), testTransport{operation: tt.operation})
+			if tt.claims != nil {
+				ctx = authcontext.WithClaims(ctx, tt.claims)
+			}
+
+			called := false
+			handler := Authorization(permissions)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+			_, err := handler(ctx, nil)
+
+			assert := assert.New(t)
+			if tt.wantErr == nil {
+				assert.NoError(err)
+				assert.True(called)
+			} else {
+				assert.IsType(tt.wantErr, err)
+				assert.False(called)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type clientIPKey struct{}
+
+// ClientAddress resolves the address of the caller once and stores it in the context, see ClientIP.
+// X-Forwarded-For and X-Real-IP are only honoured when the request comes from one of trustedProxies,
+// otherwise any client could pick the address it is throttled and rate limited by.
+// It has to be placed before every middleware reading ClientIP.
+func ClientAddress(trustedProxies entity.TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			return handler(withClientIP(ctx, resolveClientIP(tr, trustedProxies)), req)
+		}
+	}
+}
+
+// ClientIP returns the address of the caller resolved by ClientAddress. It returns an empty string when unknown.
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
+
+func withClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// resolveClientIP walks X-Forwarded-For from the closest hop and returns the first address
+// that is not a trusted proxy, the entries left of it may have been written by the client.
+// A hop that is not an IP address breaks the chain, the remote address is returned instead.
+func resolveClientIP(tr transport.Transporter, trustedProxies entity.TrustedProxies) string {
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return ""
+	}
+
+	remoteIP, _, err := net.SplitHostPort(ht.Request().RemoteAddr)
+	if err != nil {
+		remoteIP = ht.Request().RemoteAddr
+	}
+	if !trustedProxies.Contains(net.ParseIP(remoteIP)) {
+		return remoteIP
+	}
+
+	forwardedFor := strings.Split(strings.Join(tr.RequestHeader().Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwardedFor[i])
+		if hop == "" {
+			continue
+		}
+		hopIP := net.ParseIP(hop)
+		if hopIP == nil {
+			return remoteIP
+		}
+		if !trustedProxies.Contains(hopIP) {
+			return hopIP.String()
+		}
+	}
+	if realIP := net.ParseIP(strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP"))); realIP != nil {
+		return realIP.String()
+	}
+	return remoteIP
+}
+
+// UserAgent returns the User-Agent header of the caller, or an empty string when unknown.
+func UserAgent(ctx context.Context) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	return tr.RequestHeader().Get("User-Agent")
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"app/internal/user/entity"
+	"context"
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHTTPTransport struct {
+	testTransport
+	request *nethttp.Request
+}
+
+func (tr testHTTPTransport) Request() *nethttp.Request { return tr.request }
+func (tr testHTTPTransport) PathTemplate() string      { return "" }
+
+func TestClientAddress(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	trustedProxies := entity.TrustedProxies{proxies}
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIP       string
+		wantClientIP string
+	}{
+		{
+			name:         "when caller is not a trusted proxy, it should ignore forwarding headers",
+			remoteAddr:   "203.0.113.7:4321",
+			forwardedFor: "198.51.100.1",
+			realIP:       "198.51.100.2",
+			wantClientIP: "203.0.113.7",
+		},
+		{
+			name:         "when trusted proxy forwards the client, it should return the forwarded address",
+			remoteAddr:   "10.0.0.1:4321",
+			forwardedFor: "198.51.100.1",
+			wantClientIP: "198.51.100.1",
+		},
+		{
+			name:         "when client prepends a spoofed address, it should return the address added by the trusted proxies",
+			remoteAddr:   "10.0.0.1:4321",
+			forwardedFor: "192.0.2.99, 198.51.100.1, 10.0.0.2",
+			wantClientIP: "198.51.100.1",
+		},
+		{
+			name:         "when trusted proxy only sets X-Real-IP, it should return it",
+			remoteAddr:   "10.0.0.1:4321",
+			realIP:       "198.51.100.2",
+			wantClientIP: "198.51.100.2",
+		},
+		{
+			name:         "when trusted proxy sets no forwarding header, it should return the remote address",
+			remoteAddr:   "10.0.0.1:4321",
+			wantClientIP: "10.0.0.1",
+		},
+		{
+			name:         "when a forwarded hop is not an IP address, it should return the remote address",
+			remoteAddr:   "10.0.0.1:4321",
+			forwardedFor: "198.51.100.1, not-an-ip",
+			wantClientIP: "10.0.0.1",
+		},
+		{
+			name:         "when X-Real-IP is not an IP address, it should return the remote address",
+			remoteAddr:   "10.0.0.1:4321",
+			realIP:       "not-an-ip",
+			wantClientIP: "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(nethttp.MethodPost, "/", nil)
+			request.RemoteAddr = tt.remoteAddr
+			tr := testHTTPTransport{testTransport: testTransport{header: testHeader{}}, request: request}
+			if tt.forwardedFor != "" {
+				tr.header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			if tt.realIP != "" {
+				tr.header.Set("X-Real-IP", tt.realIP)
+			}
+
+			var gotClientIP string
+			handler := ClientAddress(trustedProxies)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotClientIP = ClientIP(ctx)
+				return nil, nil
+			})
+			_, err := handler(transport.NewServerContext(context.Background(), tr), nil)
+
+			assert := assert.New(t)
+			assert.NoError(err)
+			assert.Equal(tt.wantClientIP, gotClientIP)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -21,11 +22,19 @@ type ErrorResponseItem struct {
 	Reason string `json:"reason"`
 }
 
+// retryableError is implemented by errors that tell the client when to try again.
+type retryableError interface {
+	RetryAfterSeconds() int
+}
+
 func ErrorFormatter(w http.ResponseWriter, r *http.Request, err error) {
 	// Extract the corresponding encoder from the Accept of Request Header
 	code, body := parseError(err)
 
 	w.Header().Set("Content-Type", "application/json")
+	if retryableErr, ok := err.(retryableError); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(retryableErr.RetryAfterSeconds()))
+	}
 	// Set HTTP Status Code
 	w.WriteHeader(code)
 	_, _ = w.Write(body)
@@ -38,6 +47,66 @@ func parseValidationError(err *customerror.ValidationError) (int, ErrorResponse)
 	}
 }
 
+func parseUnauthorizedError(err *customerror.UnauthorizedError) (int, ErrorResponse) {
+	return http.StatusUnauthorized, ErrorResponse{
+		Type: "Unauthorized",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "authorization",
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
+func parseForbiddenError(err *customerror.ForbiddenError) (int, ErrorResponse) {
+	return http.StatusForbidden, ErrorResponse{
+		Type: "Forbidden",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "authorization",
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
+func parseAccountLockedError(err *customerror.AccountLockedError) (int, ErrorResponse) {
+	return http.StatusTooManyRequests, ErrorResponse{
+		Type: "AccountLocked",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "authentication",
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
+func parseConflictError(err *customerror.ConflictError) (int, ErrorResponse) {
+	return http.StatusConflict, ErrorResponse{
+		Type: "Conflict",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "request",
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
+func parseRateLimitError(err *customerror.RateLimitError) (int, ErrorResponse) {
+	return http.StatusTooManyRequests, ErrorResponse{
+		Type: "RateLimited",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "request",
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
 func parsePQError(err *pq.Error) (int, ErrorResponse) {
 	if err.Code == "23505" {
 		return http.StatusConflict, ErrorResponse{
@@ -54,6 +123,24 @@ func parsePQError(err *pq.Error) (int, ErrorResponse) {
 	return parseDefaultError(err)
 }
 
+// parseBadRequestError answers the errors kratos returns for requests it cannot decode, like a value
+// that is not one of the enum values of a field.
+func parseBadRequestError(err *errors.Error) (int, ErrorResponse) {
+	if err.Code != http.StatusBadRequest {
+		return parseDefaultError(err)
+	}
+
+	return http.StatusBadRequest, ErrorResponse{
+		Type: "BadRequest",
+		Messages: []ErrorResponseItem{
+			{
+				Name:   "request",
+				Reason: err.Message,
+			},
+		},
+	}
+}
+
 func parseNoRowsError(err error) (int, ErrorResponse) {
 	return http.StatusNotFound, ErrorResponse{
 		Type: "RecordNotFound",
@@ -83,8 +170,20 @@ func parseError(err error) (httpCode int, body []byte) {
 	switch parsedError := err.(type) {
 	case *customerror.ValidationError:
 		httpCode, errResponse = parseValidationError(parsedError)
+	case *customerror.UnauthorizedError:
+		httpCode, errResponse = parseUnauthorizedError(parsedError)
+	case *customerror.ForbiddenError:
+		httpCode, errResponse = parseForbiddenError(parsedError)
+	case *customerror.AccountLockedError:
+		httpCode, errResponse = parseAccountLockedError(parsedError)
+	case *customerror.ConflictError:
+		httpCode, errResponse = parseConflictError(parsedError)
+	case *customerror.RateLimitError:
+		httpCode, errResponse = parseRateLimitError(parsedError)
 	case *pq.Error:
 		httpCode, errResponse = parsePQError(parsedError)
+	case *errors.Error:
+		httpCode, errResponse = parseBadRequestError(parsedError)
 	default:
 		if errors.Is(parsedError, sql.ErrNoRows) {
 			httpCode, errResponse = parseNoRowsError(parsedError)
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	customerror "app/internal/custom_error"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody ErrorResponse
+	}{
+		{
+			name:     "when the request body cannot be decoded, it should return bad request",
+			err:      errors.BadRequest("CODEC", `invalid value for enum field genderIdentity: "male"`),
+			wantCode: http.StatusBadRequest,
+			wantBody: ErrorResponse{
+				Type:     "BadRequest",
+				Messages: []ErrorResponseItem{{Name: "request", Reason: `invalid value for enum field genderIdentity: "male"`}},
+			},
+		},
+		{
+			name:     "when kratos returns a server error, it should return internal server error",
+			err:      errors.InternalServer("UNKNOWN", "something went wrong"),
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "when the gender is not offered, it should list the choices in one reason",
+			err:      customerror.NewValidationErrorWithMessage("gender", "can only be woman or man or non_binary"),
+			wantCode: http.StatusBadRequest,
+			wantBody: ErrorResponse{
+				Type:     "ValidationError",
+				Messages: []ErrorResponseItem{{Name: "gender", Reason: "can only be woman or man or non_binary"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCode, body := parseError(tt.err)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantCode, gotCode)
+			if tt.wantBody.Type != "" {
+				var gotBody ErrorResponse
+				assert.NoError(json.Unmarshal(body, &gotBody))
+				assert.Equal(tt.wantBody, gotBody)
+			}
+		})
+	}
+}
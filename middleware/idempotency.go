@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	idempotencyAnonymousScope = "anonymous"
+)
+
+// Idempotency replays the first successful response of a call carrying an Idempotency-Key header
+// instead of processing a retry again, only operations covered by policy are deduplicated so responses
+// carrying credentials are never stored. Keys are scoped to the operation and
+// to the authenticated user, or to the client address of anonymous callers, so it has to be placed
+// after ClientAddress and Authentication. Failed calls release their key
+// so they can be retried, reusing a key with another request body is refused with a conflict.
+func Idempotency(store driven.IdempotencyStore, policy *entity.IdempotencyPolicy) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || tr.RequestHeader().Get(IdempotencyKeyHeader) == "" || !policy.Covers(tr.Operation()) {
+				return handler(ctx, req)
+			}
+			message, ok := req.(proto.Message)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			record, err := newIdempotencyRecord(ctx, tr, message, policy)
+			if err != nil {
+				return nil, err
+			}
+
+			existing, err := store.Reserve(ctx, record)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return replayIdempotentResponse(tr, record, existing)
+			}
+
+			reply, err := handler(ctx, req)
+			if err != nil {
+				_ = store.Release(ctx, record.Key)
+				return nil, err
+			}
+
+			// the call already succeeded, failing to remember it only means a retry is processed again
+			if response, ok := reply.(proto.Message); ok {
+				if stored, err := anypb.New(response); err == nil {
+					if body, err := proto.Marshal(stored); err == nil {
+						_ = store.Complete(ctx, record.Key, body, time.Now().Add(policy.TTL))
+					}
+				}
+			}
+			return reply, nil
+		}
+	}
+}
+
+func newIdempotencyRecord(ctx context.Context, tr transport.Transporter, req proto.Message, policy *entity.IdempotencyPolicy) (*entity.IdempotencyRecord, error) {
+	key := tr.RequestHeader().Get(IdempotencyKeyHeader)
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, customerror.NewValidationErrorWithMessage("idempotency_key",
+			fmt.Sprintf("must be at most %d characters in length", maxIdempotencyKeyLength))
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := sha256.Sum256(body)
+
+	// anonymous clients may send the same key, e.g. a counter of a client library
+	scope := fmt.Sprintf("%s:%s", idempotencyAnonymousScope, ClientIP(ctx))
+	if userID, ok := authcontext.UserIDFromContext(ctx); ok {
+		scope = fmt.Sprintf("user:%d", userID)
+	}
+	return &entity.IdempotencyRecord{
+		Key:         fmt.Sprintf("%s:%s:%s", scope, tr.Operation(), key),
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		ExpiresAt:   time.Now().Add(policy.LockTimeout),
+	}, nil
+}
+
+func replayIdempotentResponse(tr transport.Transporter, record, existing *entity.IdempotencyRecord) (interface{}, error) {
+	if existing.Fingerprint != record.Fingerprint {
+		return nil, customerror.NewConflictError("Idempotency-Key was already used with a different request")
+	}
+	if !existing.IsCompleted() {
+		return nil, customerror.NewConflictError("a request with this Idempotency-Key is still being processed")
+	}
+
+	var stored anypb.Any
+	err := proto.Unmarshal(existing.Response, &stored)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	tr.ReplyHeader().Set(IdempotentReplayedHeader, "true")
+	return reply, nil
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	v1 "app/api/v1"
+	"app/infra/memory"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotency(t *testing.T) {
+	calls := 0
+	handler := Idempotency(memory.NewIdempotencyStore(), &entity.IdempotencyPolicy{TTL: time.Hour, LockTimeout: time.Minute, Operations: map[string]struct{}{"/signup": {}}})(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			if req.(*v1.CreateUserRequest).Username == "failing" {
+				return nil, errors.New("some error")
+			}
+			return &v1.CreateUserResponse{Id: int64(calls)}, nil
+		})
+	call := func(operation, key, clientIP string, userID int64, req *v1.CreateUserRequest) (testTransport, interface{}, error) {
+		tr := testTransport{operation: operation, header: testHeader{}, reply: testHeader{}}
+		if key != "" {
+			tr.header.Set(IdempotencyKeyHeader, key)
+		}
+		ctx := withClientIP(transport.NewServerContext(context.Background(), tr), clientIP)
+		if userID != 0 {
+			ctx = authcontext.WithClaims(ctx, &entity.UserClaims{UserID: userID})
+		}
+		reply, err := handler(ctx, req)
+		return tr, reply, err
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		key       string
+		clientIP  string
+		userID    int64
+		req       *v1.CreateUserRequest
+		wantID    int64
+		wantErr   error
+		wantCalls int
+		replayed  bool
+	}{
+		{
+			name:      "when key is new, it should process the call",
+			key:       "key-1",
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    1,
+			wantCalls: 1,
+		},
+		{
+			name:      "when key is reused with the same body, it should replay the first response",
+			key:       "key-1",
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    1,
+			wantCalls: 1,
+			replayed:  true,
+		},
+		{
+			name:      "when key is reused with another body, it should return conflict error",
+			key:       "key-1",
+			req:       &v1.CreateUserRequest{Username: "jane"},
+			wantErr:   new(customerror.ConflictError),
+			wantCalls: 1,
+		},
+		{
+			name:      "when another user sends the same key, it should process the call",
+			key:       "key-1",
+			userID:    7,
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    2,
+			wantCalls: 2,
+		},
+		{
+			name:      "when no key is sent, it should process every call",
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    3,
+			wantCalls: 3,
+		},
+		{
+			name:      "when call fails, it should return the error",
+			key:       "key-2",
+			req:       &v1.CreateUserRequest{Username: "failing"},
+			wantErr:   errors.New("some error"),
+			wantCalls: 4,
+		},
+		{
+			name:      "when failed call is retried, it should process it again",
+			key:       "key-2",
+			req:       &v1.CreateUserRequest{Username: "failing"},
+			wantErr:   errors.New("some error"),
+			wantCalls: 5,
+		},
+		{
+			name:      "when key is too long, it should return validation error",
+			key:       strings.Repeat("k", 256),
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantErr:   new(customerror.ValidationError),
+			wantCalls: 5,
+		},
+		{
+			name:      "when operation is not covered by the policy, it should ignore the key",
+			operation: "/token",
+			key:       "key-3",
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    6,
+			wantCalls: 6,
+		},
+		{
+			name:      "when key is reused on an operation not covered by the policy, it should process the call again",
+			operation: "/token",
+			key:       "key-3",
+			req:       &v1.CreateUserRequest{Username: "john"},
+			wantID:    7,
+			wantCalls: 7,
+		},
+		{
+			name:      "when another anonymous client sends the same key, it should process the call",
+			key:       "key-1",
+			clientIP:  "198.51.100.2",
+			req:       &v1.CreateUserRequest{Username: "jane"},
+			wantID:    8,
+			wantCalls: 8,
+		},
+		{
+			name:      "when that anonymous client retries, it should replay its own response",
+			key:       "key-1",
+			clientIP:  "198.51.100.2",
+			req:       &v1.CreateUserRequest{Username: "jane"},
+			wantID:    8,
+			wantCalls: 8,
+			replayed:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := tt.operation
+			if operation == "" {
+				operation = "/signup"
+			}
+			tr, reply, err := call(operation, tt.key, tt.clientIP, tt.userID, tt.req)
+
+			assert := assert.New(t)
+			assert.Equal(tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				assert.IsType(tt.wantErr, err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.wantID, reply.(*v1.CreateUserResponse).Id)
+			assert.Equal(tt.replayed, tr.reply.Get(IdempotentReplayedHeader) == "true")
+		})
+	}
+}
+
+func TestIdempotency_concurrentRetry(t *testing.T) {
+	store := memory.NewIdempotencyStore()
+	policy := &entity.IdempotencyPolicy{TTL: time.Hour, LockTimeout: time.Minute, Operations: map[string]struct{}{"/signup": {}}}
+	tr := testTransport{operation: "/signup", header: testHeader{}, reply: testHeader{}}
+	tr.header.Set(IdempotencyKeyHeader, "key-1")
+	ctx := transport.NewServerContext(context.Background(), tr)
+
+	var retryErr error
+	handler := Idempotency(store, policy)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		// the retry arrives while the first call is still processed
+		_, retryErr = Idempotency(store, policy)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &v1.CreateUserResponse{Id: 2}, nil
+		})(ctx, req)
+		return &v1.CreateUserResponse{Id: 1}, nil
+	})
+	_, err := handler(ctx, &v1.CreateUserRequest{Username: "john"})
+
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.IsType(new(customerror.ConflictError), retryErr)
+}
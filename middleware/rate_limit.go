@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// RateLimit refuses calls once the client IP or the authenticated user used up the limits of the operation,
+// every operation has its own buckets. The user is read from the claims stored by Authentication so it has
+// to be placed after it, calls rejected by Authentication are not counted.
+func RateLimit(store driven.RateLimitStore, limits *entity.RateLimits) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			operation := tr.Operation()
+			policy := limits.For(operation)
+			if clientIP := ClientIP(ctx); clientIP != "" {
+				key := fmt.Sprintf("ip:%s:%s", operation, clientIP)
+				if err := takeRateLimit(ctx, store, key, policy.PerIP); err != nil {
+					return nil, err
+				}
+			}
+			if userID, ok := authcontext.UserIDFromContext(ctx); ok {
+				key := fmt.Sprintf("user:%s:%d", operation, userID)
+				if err := takeRateLimit(ctx, store, key, policy.PerUser); err != nil {
+					return nil, err
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func takeRateLimit(ctx context.Context, store driven.RateLimitStore, key string, limit entity.RateLimit) error {
+	if !limit.Enabled() {
+		return nil
+	}
+
+	retryAfter, err := store.Take(ctx, key, limit)
+	if err != nil {
+		return err
+	}
+	if retryAfter > 0 {
+		return customerror.NewRateLimitError(retryAfter)
+	}
+	return nil
+}
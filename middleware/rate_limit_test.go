@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"app/infra/memory"
+	authcontext "app/internal/auth_context"
+	customerror "app/internal/custom_error"
+	"app/internal/user/entity"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	limits := &entity.RateLimits{
+		Default: entity.RateLimitPolicy{
+			PerIP:   entity.RateLimit{Requests: 3, Period: time.Minute},
+			PerUser: entity.RateLimit{Requests: 1, Period: time.Minute},
+		},
+		Operations: map[string]entity.RateLimitPolicy{
+			"/signup":    {PerIP: entity.RateLimit{Requests: 1, Period: time.Hour}},
+			"/unlimited": {},
+		},
+	}
+	handler := RateLimit(memory.NewRateLimitStore(), limits)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	call := func(operation, clientIP string, userID int64) error {
+		ctx := transport.NewServerContext(context.Background(), testTransport{operation: operation, header: testHeader{}})
+		if clientIP != "" {
+			ctx = withClientIP(ctx, clientIP)
+		}
+		if userID != 0 {
+			ctx = authcontext.WithClaims(ctx, &entity.UserClaims{UserID: userID})
+		}
+		_, err := handler(ctx, nil)
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		clientIP  string
+		userID    int64
+		wantErr   bool
+	}{
+		{
+			name:      "when operation has its own limit, it should allow the calls within it",
+			operation: "/signup",
+			clientIP:  "10.0.0.1",
+		},
+		{
+			name:      "when client ip used up the limit of the operation, it should return rate limit error",
+			operation: "/signup",
+			clientIP:  "10.0.0.1",
+			wantErr:   true,
+		},
+		{
+			name:      "when another client ip calls the operation, it should count it on its own",
+			operation: "/signup",
+			clientIP:  "10.0.0.2",
+		},
+		{
+			name:      "when operation has no limit of its own, it should use the default limit",
+			operation: "/me",
+			clientIP:  "10.0.0.1",
+			userID:    1,
+		},
+		{
+			name:      "when user used up the limit, it should return rate limit error even from another client ip",
+			operation: "/me",
+			clientIP:  "10.0.0.3",
+			userID:    1,
+			wantErr:   true,
+		},
+		{
+			name:      "when limits of the operation are disabled, it should let every call through",
+			operation: "/unlimited",
+			clientIP:  "10.0.0.1",
+			userID:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := call(tt.operation, tt.clientIP, tt.userID)
+
+			assert := assert.New(t)
+			if tt.wantErr {
+				var rateLimitErr *customerror.RateLimitError
+				assert.ErrorAs(err, &rateLimitErr)
+				assert.Greater(rateLimitErr.RetryAfterSeconds(), 0)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestErrorFormatter_rateLimitError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ErrorFormatter(recorder, nil, customerror.NewRateLimitError(1500*time.Millisecond))
+
+	assert := assert.New(t)
+	assert.Equal(http.StatusTooManyRequests, recorder.Code)
+	assert.Equal("2", recorder.Header().Get("Retry-After"))
+}
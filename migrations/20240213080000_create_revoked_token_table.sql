@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE revoked_tokens (
+    token_id    VARCHAR(36) PRIMARY KEY,
+    expires_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
+
+CREATE TABLE revoked_user_tokens (
+    user_id         BIGINT      PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    issued_before   TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS revoked_user_tokens;
+DROP TABLE IF EXISTS revoked_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_attempts (
+    key             VARCHAR(255) PRIMARY KEY,
+    failed_count    INT          NOT NULL DEFAULT 0,
+    last_failed_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS login_attempts;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN phone_verified_at TIMESTAMPTZ;
+
+CREATE TABLE one_time_passwords (
+    id            BIGSERIAL   PRIMARY KEY,
+    user_id       BIGINT      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose       VARCHAR(32) NOT NULL,
+    code_hash     VARCHAR(64) NOT NULL,
+    attempts      INT         NOT NULL DEFAULT 0,
+    max_attempts  INT         NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    consumed_at   TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX one_time_passwords_user_id_purpose_idx ON one_time_passwords (user_id, purpose, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS one_time_passwords;
+
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_sessions (
+    id            UUID        PRIMARY KEY,
+    user_id       BIGINT      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_name   VARCHAR(100) NOT NULL DEFAULT '',
+    user_agent    VARCHAR(512) NOT NULL DEFAULT '',
+    ip_address    VARCHAR(45) NOT NULL DEFAULT '',
+    last_seen_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at    TIMESTAMPTZ NOT NULL,
+    revoked_at    TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX user_sessions_user_id_idx ON user_sessions (user_id);
+
+-- refresh token families issued before sessions were recorded
+INSERT INTO user_sessions (id, user_id, last_seen_at, expires_at, created_at)
+SELECT
+    family_id,
+    user_id,
+    MAX(created_at),
+    MAX(expires_at),
+    MIN(created_at)
+FROM
+    user_refresh_tokens
+WHERE
+    revoked_at IS NULL
+GROUP BY
+    family_id, user_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_sessions;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_password_history (
+    id             BIGSERIAL    PRIMARY KEY,
+    user_id        BIGINT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    password_hash  VARCHAR(255) NOT NULL,
+    created_at     TIMESTAMPTZ  DEFAULT NOW()
+);
+
+CREATE INDEX user_password_history_user_id_idx ON user_password_history (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_password_history;
+-- +goose StatementEnd
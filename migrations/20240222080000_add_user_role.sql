@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the first admin is granted by hand, e.g. UPDATE users SET role = 'admin' WHERE username = '...'
+ALTER TABLE users
+    ADD COLUMN role  VARCHAR(20) NOT NULL DEFAULT 'user'
+        CONSTRAINT users_role_check CHECK (role IN ('user', 'moderator', 'admin'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
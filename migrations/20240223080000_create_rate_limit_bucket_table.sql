@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a bucket is full again at full_at, rows past it are pruned as they behave like a missing key
+CREATE TABLE rate_limit_buckets (
+    key      VARCHAR(255) PRIMARY KEY,
+    full_at  TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_limit_buckets;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- response stays NULL while the first request with the key is processed
+CREATE TABLE idempotency_keys (
+    key          VARCHAR(512) PRIMARY KEY,
+    fingerprint  VARCHAR(64)  NOT NULL,
+    response     BYTEA,
+    expires_at   TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE profiles (
+    user_id     BIGINT       PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    birthdate   DATE         NOT NULL,
+    bio         VARCHAR(500) NOT NULL DEFAULT '',
+    job         VARCHAR(100) NOT NULL DEFAULT '',
+    education   VARCHAR(100) NOT NULL DEFAULT '',
+    height_cm   SMALLINT,
+    created_at  TIMESTAMPTZ  DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS profiles;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE profile_photos (
+    id          VARCHAR(36)  PRIMARY KEY,
+    user_id     BIGINT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    position    SMALLINT     NOT NULL,
+    created_at  TIMESTAMPTZ  DEFAULT NOW(),
+    -- deferred so a reorder or a delete can shift positions through each other within a transaction
+    CONSTRAINT profile_photos_user_id_position_key UNIQUE (user_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS profile_photos;
+-- +goose StatementEnd
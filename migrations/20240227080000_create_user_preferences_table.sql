@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_preferences (
+    user_id          BIGINT         PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    interested_in    VARCHAR(10)[]  NOT NULL,
+    min_age          SMALLINT       NOT NULL CHECK (min_age >= 18),
+    max_age          SMALLINT       NOT NULL CHECK (max_age >= min_age),
+    max_distance_km  SMALLINT       NOT NULL,
+    created_at       TIMESTAMPTZ    DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ    DEFAULT NOW()
+);
+
+-- existing users get the same defaults as a signup, see entity.NewDefaultPreferences
+INSERT INTO
+    user_preferences (user_id, interested_in, min_age, max_age, max_distance_km)
+SELECT
+    id,
+    CASE gender WHEN 'female' THEN ARRAY['male'] ELSE ARRAY['female'] END,
+    18,
+    28,
+    50
+FROM
+    users;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_preferences;
+-- +goose StatementEnd
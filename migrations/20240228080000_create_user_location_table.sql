@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_locations (
+    user_id     BIGINT            PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    latitude    DOUBLE PRECISION  NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude   DOUBLE PRECISION  NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    -- byte order so a geohash prefix is a contiguous range of the index
+    geohash     VARCHAR(12)       COLLATE "C" NOT NULL,
+    updated_at  TIMESTAMPTZ       DEFAULT NOW()
+);
+
+CREATE INDEX user_locations_geohash_idx ON user_locations (geohash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_locations;
+-- +goose StatementEnd
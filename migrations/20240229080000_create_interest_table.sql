@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE interests (
+    -- ids are chosen here rather than generated so they stay the same in every environment
+    id        INT           PRIMARY KEY,
+    slug      VARCHAR(50)   NOT NULL UNIQUE,
+    name      VARCHAR(100)  NOT NULL,
+    category  VARCHAR(50)   NOT NULL
+);
+
+CREATE TABLE user_interests (
+    user_id      BIGINT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    interest_id  INT          NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    created_at   TIMESTAMPTZ  DEFAULT NOW(),
+    PRIMARY KEY (user_id, interest_id)
+);
+
+-- finds the other users of an interest when looking for shared interests
+CREATE INDEX user_interests_interest_id_idx ON user_interests (interest_id, user_id);
+
+INSERT INTO
+    interests (id, slug, name, category)
+VALUES
+    (1, 'hiking', 'Hiking', 'Outdoors'),
+    (2, 'camping', 'Camping', 'Outdoors'),
+    (3, 'cycling', 'Cycling', 'Outdoors'),
+    (4, 'surfing', 'Surfing', 'Outdoors'),
+    (5, 'diving', 'Diving', 'Outdoors'),
+    (6, 'running', 'Running', 'Sports'),
+    (7, 'gym', 'Gym', 'Sports'),
+    (8, 'yoga', 'Yoga', 'Sports'),
+    (9, 'football', 'Football', 'Sports'),
+    (10, 'badminton', 'Badminton', 'Sports'),
+    (11, 'basketball', 'Basketball', 'Sports'),
+    (12, 'coffee', 'Coffee', 'Food & Drink'),
+    (13, 'cooking', 'Cooking', 'Food & Drink'),
+    (14, 'baking', 'Baking', 'Food & Drink'),
+    (15, 'street-food', 'Street Food', 'Food & Drink'),
+    (16, 'tea', 'Tea', 'Food & Drink'),
+    (17, 'pop', 'Pop', 'Music'),
+    (18, 'rock', 'Rock', 'Music'),
+    (19, 'jazz', 'Jazz', 'Music'),
+    (20, 'k-pop', 'K-Pop', 'Music'),
+    (21, 'concerts', 'Concerts', 'Music'),
+    (22, 'playing-music', 'Playing Music', 'Music'),
+    (23, 'movies', 'Movies', 'Entertainment'),
+    (24, 'anime', 'Anime', 'Entertainment'),
+    (25, 'series', 'TV Series', 'Entertainment'),
+    (26, 'stand-up-comedy', 'Stand-up Comedy', 'Entertainment'),
+    (27, 'video-games', 'Video Games', 'Games'),
+    (28, 'board-games', 'Board Games', 'Games'),
+    (29, 'chess', 'Chess', 'Games'),
+    (30, 'reading', 'Reading', 'Arts & Culture'),
+    (31, 'writing', 'Writing', 'Arts & Culture'),
+    (32, 'photography', 'Photography', 'Arts & Culture'),
+    (33, 'painting', 'Painting', 'Arts & Culture'),
+    (34, 'museums', 'Museums', 'Arts & Culture'),
+    (35, 'travel', 'Travel', 'Lifestyle'),
+    (36, 'pets', 'Pets', 'Lifestyle'),
+    (37, 'gardening', 'Gardening', 'Lifestyle'),
+    (38, 'fashion', 'Fashion', 'Lifestyle'),
+    (39, 'volunteering', 'Volunteering', 'Lifestyle'),
+    (40, 'technology', 'Technology', 'Lifestyle');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_interests;
+DROP TABLE IF EXISTS interests;
+-- +goose StatementEnd
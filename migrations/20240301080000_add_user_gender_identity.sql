@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ALTER COLUMN gender TYPE VARCHAR(30),
+    ADD COLUMN show_gender BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN orientation VARCHAR(30) NOT NULL DEFAULT '';
+
+-- male and female become the gender identities man and woman, see entity.Genders
+UPDATE
+    users
+SET
+    gender = CASE LOWER(gender) WHEN 'male' THEN 'man' WHEN 'female' THEN 'woman' ELSE gender END;
+
+ALTER TABLE user_preferences
+    ALTER COLUMN interested_in TYPE VARCHAR(30)[];
+
+UPDATE
+    user_preferences
+SET
+    interested_in = ARRAY_REPLACE(ARRAY_REPLACE(interested_in, 'male', 'man'), 'female', 'woman');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the old columns only hold male and female, rolling back would lose every other identity
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT
+            1
+        FROM
+            users
+        WHERE
+            gender NOT IN ('man', 'woman', '')
+    ) OR EXISTS (
+        SELECT
+            1
+        FROM
+            user_preferences
+        WHERE
+            NOT interested_in <@ ARRAY['man', 'woman']::VARCHAR(30)[]
+    ) THEN
+        RAISE EXCEPTION 'gender identities other than man and woman are stored, they cannot be rolled back';
+    END IF;
+END $$;
+
+UPDATE
+    user_preferences
+SET
+    interested_in = ARRAY_REPLACE(ARRAY_REPLACE(interested_in, 'man', 'male'), 'woman', 'female');
+
+ALTER TABLE user_preferences
+    ALTER COLUMN interested_in TYPE VARCHAR(10)[];
+
+UPDATE
+    users
+SET
+    gender = CASE gender WHEN 'man' THEN 'male' WHEN 'woman' THEN 'female' ELSE gender END;
+
+ALTER TABLE users
+    ALTER COLUMN gender TYPE VARCHAR(10),
+    DROP COLUMN IF EXISTS orientation,
+    DROP COLUMN IF EXISTS show_gender;
+-- +goose StatementEnd
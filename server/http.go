@@ -4,7 +4,10 @@ import (
 	v1 "app/api/v1"
 	"app/configs"
 	"app/handler/api"
+	"app/internal/user/entity"
+	"app/internal/user/port/driven"
 	"embed"
+	"encoding/json"
 	"io/fs"
 	nethttp "net/http"
 	"time"
@@ -22,12 +25,48 @@ import (
 var content embed.FS
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *configs.ApplicationConfig, userHandler *api.UserApiHandler, logger log.Logger) *http.Server {
+func NewHTTPServer(
+	c *configs.ApplicationConfig,
+	userHandler *api.UserApiHandler,
+	profileHandler *api.ProfileApiHandler,
+	photoHandler *api.PhotoApiHandler,
+	preferencesHandler *api.PreferencesApiHandler,
+	locationHandler *api.LocationApiHandler,
+	interestHandler *api.InterestApiHandler,
+	tokenValidator driven.TokenValidator[*entity.UserClaims],
+	tokenRevocationStore driven.TokenRevocationStore,
+	tokenKeySet driven.TokenKeySet,
+	rateLimitStore driven.RateLimitStore,
+	rateLimits *entity.RateLimits,
+	idempotencyStore driven.IdempotencyStore,
+	idempotencyPolicy *entity.IdempotencyPolicy,
+	trustedProxies entity.TrustedProxies,
+	logger log.Logger,
+) *http.Server {
 	// func NewHTTPServer(c *configs.ApplicationConfig, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
+			custommiddleware.ClientAddress(trustedProxies),
+			custommiddleware.Authentication(
+				tokenValidator,
+				tokenRevocationStore,
+				v1.OperationUserCreateUser,
+				v1.OperationUserCreateUserToken,
+				v1.OperationUserRefreshUserToken,
+				v1.OperationUserSendPhoneVerification,
+				v1.OperationUserVerifyPhoneNumber,
+				v1.OperationUserRequestPasswordReset,
+				v1.OperationUserVerifyPasswordReset,
+				v1.OperationUserResetPassword,
+				v1.OperationUserCompleteMFALogin,
+			),
+			custommiddleware.RateLimit(rateLimitStore, rateLimits),
+			custommiddleware.Authorization(custommiddleware.OperationPermissions{
+				v1.OperationUserChangeUserRole: entity.PermissionManageRoles,
+			}),
+			custommiddleware.Idempotency(idempotencyStore, idempotencyPolicy),
 		),
 		http.ErrorEncoder(custommiddleware.ErrorFormatter),
 	}
@@ -39,8 +78,17 @@ func NewHTTPServer(c *configs.ApplicationConfig, userHandler *api.UserApiHandler
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterUserHTTPServer(srv, userHandler)
+	v1.RegisterProfileHTTPServer(srv, profileHandler)
+	v1.RegisterPhotoHTTPServer(srv, photoHandler)
+	v1.RegisterPreferencesHTTPServer(srv, preferencesHandler)
+	v1.RegisterLocationHTTPServer(srv, locationHandler)
+	v1.RegisterInterestHTTPServer(srv, interestHandler)
+	srv.Route("/").GET(api.DataExportDownloadPath, userHandler.DownloadDataExport)
+	srv.Route("/").POST(api.PhotoUploadPath, photoHandler.UploadPhoto)
+	srv.Route("/").GET(api.PhotoFilePath, photoHandler.OpenPhoto)
 	openAPIhandler := handleSwaggerUI(configs.OpenAPI)
 	srv.HandlePrefix("/q/", openAPIhandler)
+	srv.HandleFunc("/.well-known/jwks.json", jwksHandler(tokenKeySet))
 	return srv
 }
 
@@ -53,6 +101,15 @@ func handleSwaggerUI(file []byte) nethttp.Handler {
 	return router
 }
 
+func jwksHandler(keySet driven.TokenKeySet) nethttp.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		body, _ := json.Marshal(keySet.KeySet())
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	}
+}
+
 func byteHandler(b []byte) nethttp.HandlerFunc {
 	return func(w http.ResponseWriter, _ *http.Request) {
 		_, _ = w.Write(b)
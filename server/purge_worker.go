@@ -0,0 +1,81 @@
+package server
+
+import (
+	"app/configs"
+	"app/internal/user/port/driver"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = new(AccountPurgeWorker)
+
+// AccountPurgeWorker periodically removes the accounts whose deletion grace period has ended
+// and the data exports that expired.
+// It runs alongside the HTTP server so it starts and stops with the application.
+type AccountPurgeWorker struct {
+	interval   time.Duration
+	userPurger driver.UserPurgeUsecase
+	log        *log.Helper
+	stop       chan struct{}
+	stopOnce   sync.Once
+}
+
+func NewAccountPurgeWorker(c *configs.ApplicationConfig, userPurger driver.UserPurgeUsecase, logger log.Logger) *AccountPurgeWorker {
+	interval := time.Duration(c.AccountDeletion.PurgeIntervalSecond) * time.Second
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &AccountPurgeWorker{
+		interval:   interval,
+		userPurger: userPurger,
+		log:        log.NewHelper(logger),
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start implements transport.Server, it blocks until Stop is called or ctx is done.
+func (w *AccountPurgeWorker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop implements transport.Server.
+func (w *AccountPurgeWorker) Stop(_ context.Context) error {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+	return nil
+}
+
+func (w *AccountPurgeWorker) purge(ctx context.Context) {
+	purged, err := w.userPurger.PurgeDeletedUsers(ctx)
+	if err != nil {
+		w.log.Errorf("purge deleted users: %v", err)
+	}
+	if purged > 0 {
+		w.log.Infof("purged %d deleted users", purged)
+	}
+
+	purged, err = w.userPurger.PurgeExpiredDataExports(ctx)
+	if err != nil {
+		w.log.Errorf("purge expired data exports: %v", err)
+	}
+	if purged > 0 {
+		w.log.Infof("purged %d expired data exports", purged)
+	}
+}
@@ -15,20 +15,175 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ApiV1CreateUserRequestGenderIdentity.
+const (
+	ApiV1CreateUserRequestGenderIdentityGENDERAGENDER     ApiV1CreateUserRequestGenderIdentity = "GENDER_AGENDER"
+	ApiV1CreateUserRequestGenderIdentityGENDERGENDERFLUID ApiV1CreateUserRequestGenderIdentity = "GENDER_GENDERFLUID"
+	ApiV1CreateUserRequestGenderIdentityGENDERGENDERQUEER ApiV1CreateUserRequestGenderIdentity = "GENDER_GENDERQUEER"
+	ApiV1CreateUserRequestGenderIdentityGENDERMAN         ApiV1CreateUserRequestGenderIdentity = "GENDER_MAN"
+	ApiV1CreateUserRequestGenderIdentityGENDERNONBINARY   ApiV1CreateUserRequestGenderIdentity = "GENDER_NON_BINARY"
+	ApiV1CreateUserRequestGenderIdentityGENDERTWOSPIRIT   ApiV1CreateUserRequestGenderIdentity = "GENDER_TWO_SPIRIT"
+	ApiV1CreateUserRequestGenderIdentityGENDERUNSPECIFIED ApiV1CreateUserRequestGenderIdentity = "GENDER_UNSPECIFIED"
+	ApiV1CreateUserRequestGenderIdentityGENDERWOMAN       ApiV1CreateUserRequestGenderIdentity = "GENDER_WOMAN"
+)
+
+// Defines values for ApiV1CreateUserRequestOrientation.
+const (
+	ApiV1CreateUserRequestOrientationORIENTATIONASEXUAL     ApiV1CreateUserRequestOrientation = "ORIENTATION_ASEXUAL"
+	ApiV1CreateUserRequestOrientationORIENTATIONBISEXUAL    ApiV1CreateUserRequestOrientation = "ORIENTATION_BISEXUAL"
+	ApiV1CreateUserRequestOrientationORIENTATIONGAY         ApiV1CreateUserRequestOrientation = "ORIENTATION_GAY"
+	ApiV1CreateUserRequestOrientationORIENTATIONLESBIAN     ApiV1CreateUserRequestOrientation = "ORIENTATION_LESBIAN"
+	ApiV1CreateUserRequestOrientationORIENTATIONPANSEXUAL   ApiV1CreateUserRequestOrientation = "ORIENTATION_PANSEXUAL"
+	ApiV1CreateUserRequestOrientationORIENTATIONQUEER       ApiV1CreateUserRequestOrientation = "ORIENTATION_QUEER"
+	ApiV1CreateUserRequestOrientationORIENTATIONQUESTIONING ApiV1CreateUserRequestOrientation = "ORIENTATION_QUESTIONING"
+	ApiV1CreateUserRequestOrientationORIENTATIONSTRAIGHT    ApiV1CreateUserRequestOrientation = "ORIENTATION_STRAIGHT"
+	ApiV1CreateUserRequestOrientationORIENTATIONUNSPECIFIED ApiV1CreateUserRequestOrientation = "ORIENTATION_UNSPECIFIED"
+)
+
+// Defines values for ApiV1GetMeResponseGenderIdentity.
+const (
+	ApiV1GetMeResponseGenderIdentityGENDERAGENDER     ApiV1GetMeResponseGenderIdentity = "GENDER_AGENDER"
+	ApiV1GetMeResponseGenderIdentityGENDERGENDERFLUID ApiV1GetMeResponseGenderIdentity = "GENDER_GENDERFLUID"
+	ApiV1GetMeResponseGenderIdentityGENDERGENDERQUEER ApiV1GetMeResponseGenderIdentity = "GENDER_GENDERQUEER"
+	ApiV1GetMeResponseGenderIdentityGENDERMAN         ApiV1GetMeResponseGenderIdentity = "GENDER_MAN"
+	ApiV1GetMeResponseGenderIdentityGENDERNONBINARY   ApiV1GetMeResponseGenderIdentity = "GENDER_NON_BINARY"
+	ApiV1GetMeResponseGenderIdentityGENDERTWOSPIRIT   ApiV1GetMeResponseGenderIdentity = "GENDER_TWO_SPIRIT"
+	ApiV1GetMeResponseGenderIdentityGENDERUNSPECIFIED ApiV1GetMeResponseGenderIdentity = "GENDER_UNSPECIFIED"
+	ApiV1GetMeResponseGenderIdentityGENDERWOMAN       ApiV1GetMeResponseGenderIdentity = "GENDER_WOMAN"
+)
+
+// Defines values for ApiV1GetMeResponseOrientation.
+const (
+	ApiV1GetMeResponseOrientationORIENTATIONASEXUAL     ApiV1GetMeResponseOrientation = "ORIENTATION_ASEXUAL"
+	ApiV1GetMeResponseOrientationORIENTATIONBISEXUAL    ApiV1GetMeResponseOrientation = "ORIENTATION_BISEXUAL"
+	ApiV1GetMeResponseOrientationORIENTATIONGAY         ApiV1GetMeResponseOrientation = "ORIENTATION_GAY"
+	ApiV1GetMeResponseOrientationORIENTATIONLESBIAN     ApiV1GetMeResponseOrientation = "ORIENTATION_LESBIAN"
+	ApiV1GetMeResponseOrientationORIENTATIONPANSEXUAL   ApiV1GetMeResponseOrientation = "ORIENTATION_PANSEXUAL"
+	ApiV1GetMeResponseOrientationORIENTATIONQUEER       ApiV1GetMeResponseOrientation = "ORIENTATION_QUEER"
+	ApiV1GetMeResponseOrientationORIENTATIONQUESTIONING ApiV1GetMeResponseOrientation = "ORIENTATION_QUESTIONING"
+	ApiV1GetMeResponseOrientationORIENTATIONSTRAIGHT    ApiV1GetMeResponseOrientation = "ORIENTATION_STRAIGHT"
+	ApiV1GetMeResponseOrientationORIENTATIONUNSPECIFIED ApiV1GetMeResponseOrientation = "ORIENTATION_UNSPECIFIED"
+)
+
+// Defines values for ApiV1PreferencesResponseInterestedIn.
+const (
+	ApiV1PreferencesResponseInterestedInGENDERAGENDER     ApiV1PreferencesResponseInterestedIn = "GENDER_AGENDER"
+	ApiV1PreferencesResponseInterestedInGENDERGENDERFLUID ApiV1PreferencesResponseInterestedIn = "GENDER_GENDERFLUID"
+	ApiV1PreferencesResponseInterestedInGENDERGENDERQUEER ApiV1PreferencesResponseInterestedIn = "GENDER_GENDERQUEER"
+	ApiV1PreferencesResponseInterestedInGENDERMAN         ApiV1PreferencesResponseInterestedIn = "GENDER_MAN"
+	ApiV1PreferencesResponseInterestedInGENDERNONBINARY   ApiV1PreferencesResponseInterestedIn = "GENDER_NON_BINARY"
+	ApiV1PreferencesResponseInterestedInGENDERTWOSPIRIT   ApiV1PreferencesResponseInterestedIn = "GENDER_TWO_SPIRIT"
+	ApiV1PreferencesResponseInterestedInGENDERUNSPECIFIED ApiV1PreferencesResponseInterestedIn = "GENDER_UNSPECIFIED"
+	ApiV1PreferencesResponseInterestedInGENDERWOMAN       ApiV1PreferencesResponseInterestedIn = "GENDER_WOMAN"
+)
+
+// Defines values for ApiV1UpdateMyIdentityRequestGender.
+const (
+	ApiV1UpdateMyIdentityRequestGenderGENDERAGENDER     ApiV1UpdateMyIdentityRequestGender = "GENDER_AGENDER"
+	ApiV1UpdateMyIdentityRequestGenderGENDERGENDERFLUID ApiV1UpdateMyIdentityRequestGender = "GENDER_GENDERFLUID"
+	ApiV1UpdateMyIdentityRequestGenderGENDERGENDERQUEER ApiV1UpdateMyIdentityRequestGender = "GENDER_GENDERQUEER"
+	ApiV1UpdateMyIdentityRequestGenderGENDERMAN         ApiV1UpdateMyIdentityRequestGender = "GENDER_MAN"
+	ApiV1UpdateMyIdentityRequestGenderGENDERNONBINARY   ApiV1UpdateMyIdentityRequestGender = "GENDER_NON_BINARY"
+	ApiV1UpdateMyIdentityRequestGenderGENDERTWOSPIRIT   ApiV1UpdateMyIdentityRequestGender = "GENDER_TWO_SPIRIT"
+	ApiV1UpdateMyIdentityRequestGenderGENDERUNSPECIFIED ApiV1UpdateMyIdentityRequestGender = "GENDER_UNSPECIFIED"
+	ApiV1UpdateMyIdentityRequestGenderGENDERWOMAN       ApiV1UpdateMyIdentityRequestGender = "GENDER_WOMAN"
+)
+
+// Defines values for ApiV1UpdateMyIdentityRequestOrientation.
+const (
+	ORIENTATIONASEXUAL     ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_ASEXUAL"
+	ORIENTATIONBISEXUAL    ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_BISEXUAL"
+	ORIENTATIONGAY         ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_GAY"
+	ORIENTATIONLESBIAN     ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_LESBIAN"
+	ORIENTATIONPANSEXUAL   ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_PANSEXUAL"
+	ORIENTATIONQUEER       ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_QUEER"
+	ORIENTATIONQUESTIONING ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_QUESTIONING"
+	ORIENTATIONSTRAIGHT    ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_STRAIGHT"
+	ORIENTATIONUNSPECIFIED ApiV1UpdateMyIdentityRequestOrientation = "ORIENTATION_UNSPECIFIED"
+)
+
+// Defines values for ApiV1UpdateMyPreferencesRequestInterestedIn.
+const (
+	GENDERAGENDER     ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_AGENDER"
+	GENDERGENDERFLUID ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_GENDERFLUID"
+	GENDERGENDERQUEER ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_GENDERQUEER"
+	GENDERMAN         ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_MAN"
+	GENDERNONBINARY   ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_NON_BINARY"
+	GENDERTWOSPIRIT   ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_TWO_SPIRIT"
+	GENDERUNSPECIFIED ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_UNSPECIFIED"
+	GENDERWOMAN       ApiV1UpdateMyPreferencesRequestInterestedIn = "GENDER_WOMAN"
+)
+
+// ApiV1ChangePasswordRequest defines model for api.v1.ChangePasswordRequest.
+type ApiV1ChangePasswordRequest struct {
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+	NewPassword     *string `json:"newPassword,omitempty"`
+}
+
+// ApiV1ChangePasswordResponse defines model for api.v1.ChangePasswordResponse.
+type ApiV1ChangePasswordResponse = map[string]interface{}
+
+// ApiV1ChangeUserRoleRequest defines model for api.v1.ChangeUserRoleRequest.
+type ApiV1ChangeUserRoleRequest struct {
+	Id *string `json:"id,omitempty"`
+
+	// Role One of user, moderator or admin.
+	Role *string `json:"role,omitempty"`
+}
+
+// ApiV1ChangeUserRoleResponse defines model for api.v1.ChangeUserRoleResponse.
+type ApiV1ChangeUserRoleResponse = map[string]interface{}
+
+// ApiV1CompleteMFALoginRequest defines model for api.v1.CompleteMFALoginRequest.
+type ApiV1CompleteMFALoginRequest struct {
+	ChallengeId *string `json:"challengeId,omitempty"`
+
+	// Code TOTP code or recovery code.
+	Code       *string `json:"code,omitempty"`
+	DeviceName *string `json:"deviceName,omitempty"`
+}
+
+// ApiV1ConfirmTOTPRequest defines model for api.v1.ConfirmTOTPRequest.
+type ApiV1ConfirmTOTPRequest struct {
+	Code *string `json:"code,omitempty"`
+}
+
+// ApiV1ConfirmTOTPResponse defines model for api.v1.ConfirmTOTPResponse.
+type ApiV1ConfirmTOTPResponse struct {
+	// RecoveryCodes Shown only once, each code can be used a single time instead of a TOTP code.
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+}
+
 // ApiV1CreateUserRequest defines model for api.v1.CreateUserRequest.
 type ApiV1CreateUserRequest struct {
-	Gender      *string `json:"gender,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Password    *string `json:"password,omitempty"`
-	PhoneNumber *string `json:"phoneNumber,omitempty"`
-	Username    *string `json:"username,omitempty"`
+	// Gender Deprecated: use gender_identity, male and female are still accepted as man and woman.
+	Gender *string `json:"gender,omitempty"`
+
+	// GenderIdentity Takes precedence over gender.
+	GenderIdentity *ApiV1CreateUserRequestGenderIdentity `json:"genderIdentity,omitempty"`
+	Name           *string                               `json:"name,omitempty"`
+	Orientation    *ApiV1CreateUserRequestOrientation    `json:"orientation,omitempty"`
+	Password       *string                               `json:"password,omitempty"`
+	PhoneNumber    *string                               `json:"phoneNumber,omitempty"`
+
+	// ShowGender Whether other users can see the gender, true when not sent.
+	ShowGender *bool   `json:"showGender,omitempty"`
+	Username   *string `json:"username,omitempty"`
 }
 
+// ApiV1CreateUserRequestGenderIdentity Takes precedence over gender.
+type ApiV1CreateUserRequestGenderIdentity string
+
+// ApiV1CreateUserRequestOrientation defines model for ApiV1CreateUserRequest.Orientation.
+type ApiV1CreateUserRequestOrientation string
+
 // ApiV1CreateUserResponse defines model for api.v1.CreateUserResponse.
 type ApiV1CreateUserResponse struct {
 	Id *string `json:"id,omitempty"`
@@ -36,23 +191,360 @@ type ApiV1CreateUserResponse struct {
 
 // ApiV1CreateUserTokenRequest defines model for api.v1.CreateUserTokenRequest.
 type ApiV1CreateUserTokenRequest struct {
-	Password *string `json:"password,omitempty"`
+	// DeviceName Shown in the session list, e.g. "Pixel 8".
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Identifier Username or phone number in E.164 format, e.g. +6281234567890.
+	Identifier *string `json:"identifier,omitempty"`
+	Password   *string `json:"password,omitempty"`
+
+	// Username Deprecated: use identifier.
 	Username *string `json:"username,omitempty"`
 }
 
 // ApiV1CreateUserTokenResponse defines model for api.v1.CreateUserTokenResponse.
 type ApiV1CreateUserTokenResponse struct {
+	ExpiresIn             *int32 `json:"expiresIn,omitempty"`
+	MfaChallengeExpiresIn *int32 `json:"mfaChallengeExpiresIn,omitempty"`
+
+	// MfaChallengeId Set instead of the tokens when two-factor authentication is enabled,
+	//  complete the login with CompleteMFALogin.
+	MfaChallengeId   *string `json:"mfaChallengeId,omitempty"`
+	RefreshExpiresIn *int32  `json:"refreshExpiresIn,omitempty"`
+	RefreshToken     *string `json:"refreshToken,omitempty"`
+	Token            *string `json:"token,omitempty"`
+	Type             *string `json:"type,omitempty"`
+}
+
+// ApiV1DeleteMeResponse defines model for api.v1.DeleteMeResponse.
+type ApiV1DeleteMeResponse struct {
+	PurgeAt *time.Time `json:"purgeAt,omitempty"`
+}
+
+// ApiV1DeletePhotoResponse defines model for api.v1.DeletePhotoResponse.
+type ApiV1DeletePhotoResponse = map[string]interface{}
+
+// ApiV1EnrollTOTPRequest defines model for api.v1.EnrollTOTPRequest.
+type ApiV1EnrollTOTPRequest = map[string]interface{}
+
+// ApiV1EnrollTOTPResponse defines model for api.v1.EnrollTOTPResponse.
+type ApiV1EnrollTOTPResponse struct {
+	Secret *string `json:"secret,omitempty"`
+
+	// Uri otpauth:// URI to be rendered as a QR code.
+	Uri *string `json:"uri,omitempty"`
+}
+
+// ApiV1GetMeResponse defines model for api.v1.GetMeResponse.
+type ApiV1GetMeResponse struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Gender Deprecated: use gender_identity, man and woman are still sent as male and female.
+	Gender         *string                           `json:"gender,omitempty"`
+	GenderIdentity *ApiV1GetMeResponseGenderIdentity `json:"genderIdentity,omitempty"`
+	Id             *string                           `json:"id,omitempty"`
+	Name           *string                           `json:"name,omitempty"`
+	Orientation    *ApiV1GetMeResponseOrientation    `json:"orientation,omitempty"`
+	PhoneNumber    *string                           `json:"phoneNumber,omitempty"`
+	PhoneVerified  *bool                             `json:"phoneVerified,omitempty"`
+
+	// Role One of user, moderator or admin.
+	Role             *string    `json:"role,omitempty"`
+	ShowGender       *bool      `json:"showGender,omitempty"`
+	TwoFactorEnabled *bool      `json:"twoFactorEnabled,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+	Username         *string    `json:"username,omitempty"`
+}
+
+// ApiV1GetMeResponseGenderIdentity defines model for ApiV1GetMeResponse.GenderIdentity.
+type ApiV1GetMeResponseGenderIdentity string
+
+// ApiV1GetMeResponseOrientation defines model for ApiV1GetMeResponse.Orientation.
+type ApiV1GetMeResponseOrientation string
+
+// ApiV1InterestResponse defines model for api.v1.InterestResponse.
+type ApiV1InterestResponse struct {
+	Category *string `json:"category,omitempty"`
+	Id       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+}
+
+// ApiV1ListInterestsResponse defines model for api.v1.ListInterestsResponse.
+type ApiV1ListInterestsResponse struct {
+	Interests *[]ApiV1InterestResponse `json:"interests,omitempty"`
+}
+
+// ApiV1ListPhotosResponse defines model for api.v1.ListPhotosResponse.
+type ApiV1ListPhotosResponse struct {
+	Photos *[]ApiV1PhotoResponse `json:"photos,omitempty"`
+}
+
+// ApiV1ListSessionsResponse defines model for api.v1.ListSessionsResponse.
+type ApiV1ListSessionsResponse struct {
+	Sessions *[]ApiV1Session `json:"sessions,omitempty"`
+}
+
+// ApiV1LocationResponse defines model for api.v1.LocationResponse.
+type ApiV1LocationResponse struct {
+	Latitude  *float64   `json:"latitude,omitempty"`
+	Longitude *float64   `json:"longitude,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ApiV1LogoutAllRequest defines model for api.v1.LogoutAllRequest.
+type ApiV1LogoutAllRequest = map[string]interface{}
+
+// ApiV1LogoutRequest defines model for api.v1.LogoutRequest.
+type ApiV1LogoutRequest struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// ApiV1LogoutResponse defines model for api.v1.LogoutResponse.
+type ApiV1LogoutResponse = map[string]interface{}
+
+// ApiV1PhotoResponse defines model for api.v1.PhotoResponse.
+type ApiV1PhotoResponse struct {
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	Id           *string    `json:"id,omitempty"`
+	LargeUrl     *string    `json:"largeUrl,omitempty"`
+	MediumUrl    *string    `json:"mediumUrl,omitempty"`
+	Position     *int32     `json:"position,omitempty"`
+	Primary      *bool      `json:"primary,omitempty"`
+	ThumbnailUrl *string    `json:"thumbnailUrl,omitempty"`
+}
+
+// ApiV1PreferencesResponse defines model for api.v1.PreferencesResponse.
+type ApiV1PreferencesResponse struct {
+	InterestedIn  *[]ApiV1PreferencesResponseInterestedIn `json:"interestedIn,omitempty"`
+	MaxAge        *int32                                  `json:"maxAge,omitempty"`
+	MaxDistanceKm *int32                                  `json:"maxDistanceKm,omitempty"`
+	MinAge        *int32                                  `json:"minAge,omitempty"`
+	UpdatedAt     *time.Time                              `json:"updatedAt,omitempty"`
+}
+
+// ApiV1PreferencesResponseInterestedIn defines model for ApiV1PreferencesResponse.InterestedIn.
+type ApiV1PreferencesResponseInterestedIn string
+
+// ApiV1ProfileResponse defines model for api.v1.ProfileResponse.
+type ApiV1ProfileResponse struct {
+	Age *int32  `json:"age,omitempty"`
+	Bio *string `json:"bio,omitempty"`
+
+	// Birthdate Date in YYYY-MM-DD format.
+	Birthdate *string    `json:"birthdate,omitempty"`
+	Education *string    `json:"education,omitempty"`
+	HeightCm  *int32     `json:"heightCm,omitempty"`
+	Job       *string    `json:"job,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ApiV1RefreshUserTokenRequest defines model for api.v1.RefreshUserTokenRequest.
+type ApiV1RefreshUserTokenRequest struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// ApiV1ReorderPhotosRequest defines model for api.v1.ReorderPhotosRequest.
+type ApiV1ReorderPhotosRequest struct {
+	PhotoIds *[]string `json:"photoIds,omitempty"`
+}
+
+// ApiV1RequestDataExportRequest defines model for api.v1.RequestDataExportRequest.
+type ApiV1RequestDataExportRequest = map[string]interface{}
+
+// ApiV1RequestDataExportResponse defines model for api.v1.RequestDataExportResponse.
+type ApiV1RequestDataExportResponse struct {
+	DownloadUrl *string    `json:"downloadUrl,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Id          *string    `json:"id,omitempty"`
+}
+
+// ApiV1RequestPasswordResetRequest defines model for api.v1.RequestPasswordResetRequest.
+type ApiV1RequestPasswordResetRequest struct {
+	// Identifier Username or phone number in E.164 format, e.g. +6281234567890.
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// ApiV1RequestPasswordResetResponse defines model for api.v1.RequestPasswordResetResponse.
+type ApiV1RequestPasswordResetResponse = map[string]interface{}
+
+// ApiV1ResetPasswordRequest defines model for api.v1.ResetPasswordRequest.
+type ApiV1ResetPasswordRequest struct {
+	Password *string `json:"password,omitempty"`
+	Ticket   *string `json:"ticket,omitempty"`
+}
+
+// ApiV1ResetPasswordResponse defines model for api.v1.ResetPasswordResponse.
+type ApiV1ResetPasswordResponse = map[string]interface{}
+
+// ApiV1RevokeSessionResponse defines model for api.v1.RevokeSessionResponse.
+type ApiV1RevokeSessionResponse = map[string]interface{}
+
+// ApiV1SendPhoneVerificationRequest defines model for api.v1.SendPhoneVerificationRequest.
+type ApiV1SendPhoneVerificationRequest struct {
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+}
+
+// ApiV1SendPhoneVerificationResponse defines model for api.v1.SendPhoneVerificationResponse.
+type ApiV1SendPhoneVerificationResponse = map[string]interface{}
+
+// ApiV1Session defines model for api.v1.Session.
+type ApiV1Session struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Current True for the session of the token used for the request.
+	Current    *bool      `json:"current,omitempty"`
+	DeviceName *string    `json:"deviceName,omitempty"`
+	Id         *string    `json:"id,omitempty"`
+	IpAddress  *string    `json:"ipAddress,omitempty"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+	UserAgent  *string    `json:"userAgent,omitempty"`
+}
+
+// ApiV1SetMyInterestsRequest defines model for api.v1.SetMyInterestsRequest.
+type ApiV1SetMyInterestsRequest struct {
+	// InterestIds Ids of the catalog returned by ListInterests.
+	InterestIds *[]string `json:"interestIds,omitempty"`
+}
+
+// ApiV1UpdateMyIdentityRequest defines model for api.v1.UpdateMyIdentityRequest.
+type ApiV1UpdateMyIdentityRequest struct {
+	Gender *ApiV1UpdateMyIdentityRequestGender `json:"gender,omitempty"`
+
+	// Orientation ORIENTATION_UNSPECIFIED removes the orientation.
+	Orientation *ApiV1UpdateMyIdentityRequestOrientation `json:"orientation,omitempty"`
+	ShowGender  *bool                                    `json:"showGender,omitempty"`
+}
+
+// ApiV1UpdateMyIdentityRequestGender defines model for ApiV1UpdateMyIdentityRequest.Gender.
+type ApiV1UpdateMyIdentityRequestGender string
+
+// ApiV1UpdateMyIdentityRequestOrientation ORIENTATION_UNSPECIFIED removes the orientation.
+type ApiV1UpdateMyIdentityRequestOrientation string
+
+// ApiV1UpdateMyLocationRequest defines model for api.v1.UpdateMyLocationRequest.
+type ApiV1UpdateMyLocationRequest struct {
+	// Latitude Degrees between -90 and 90.
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude Degrees between -180 and 180.
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// ApiV1UpdateMyPreferencesRequest defines model for api.v1.UpdateMyPreferencesRequest.
+type ApiV1UpdateMyPreferencesRequest struct {
+	// InterestedIn Any of the genders enabled in the server configuration.
+	InterestedIn  *[]ApiV1UpdateMyPreferencesRequestInterestedIn `json:"interestedIn,omitempty"`
+	MaxAge        *int32                                         `json:"maxAge,omitempty"`
+	MaxDistanceKm *int32                                         `json:"maxDistanceKm,omitempty"`
+
+	// MinAge At least 18 and at most max_age.
+	MinAge *int32 `json:"minAge,omitempty"`
+}
+
+// ApiV1UpdateMyPreferencesRequestInterestedIn defines model for ApiV1UpdateMyPreferencesRequest.InterestedIn.
+type ApiV1UpdateMyPreferencesRequestInterestedIn string
+
+// ApiV1UpdateMyProfileRequest defines model for api.v1.UpdateMyProfileRequest.
+type ApiV1UpdateMyProfileRequest struct {
+	Bio *string `json:"bio,omitempty"`
+
+	// Birthdate Date in YYYY-MM-DD format, users must be at least 18 years old.
+	Birthdate *string `json:"birthdate,omitempty"`
+	Education *string `json:"education,omitempty"`
+
+	// HeightCm Height in centimeters, 0 clears it.
+	HeightCm *int32  `json:"heightCm,omitempty"`
+	Job      *string `json:"job,omitempty"`
+}
+
+// ApiV1VerifyPasswordResetRequest defines model for api.v1.VerifyPasswordResetRequest.
+type ApiV1VerifyPasswordResetRequest struct {
+	Code       *string `json:"code,omitempty"`
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// ApiV1VerifyPasswordResetResponse defines model for api.v1.VerifyPasswordResetResponse.
+type ApiV1VerifyPasswordResetResponse struct {
 	ExpiresIn *int32  `json:"expiresIn,omitempty"`
-	Token     *string `json:"token,omitempty"`
-	Type      *string `json:"type,omitempty"`
+	Ticket    *string `json:"ticket,omitempty"`
+}
+
+// ApiV1VerifyPhoneNumberRequest defines model for api.v1.VerifyPhoneNumberRequest.
+type ApiV1VerifyPhoneNumberRequest struct {
+	Code        *string `json:"code,omitempty"`
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
 }
 
+// ApiV1VerifyPhoneNumberResponse defines model for api.v1.VerifyPhoneNumberResponse.
+type ApiV1VerifyPhoneNumberResponse = map[string]interface{}
+
+// UserChangeUserRoleJSONRequestBody defines body for UserChangeUserRole for application/json ContentType.
+type UserChangeUserRoleJSONRequestBody = ApiV1ChangeUserRoleRequest
+
 // UserCreateUserJSONRequestBody defines body for UserCreateUser for application/json ContentType.
 type UserCreateUserJSONRequestBody = ApiV1CreateUserRequest
 
+// UserLogoutJSONRequestBody defines body for UserLogout for application/json ContentType.
+type UserLogoutJSONRequestBody = ApiV1LogoutRequest
+
+// UserLogoutAllJSONRequestBody defines body for UserLogoutAll for application/json ContentType.
+type UserLogoutAllJSONRequestBody = ApiV1LogoutAllRequest
+
+// UserEnrollTOTPJSONRequestBody defines body for UserEnrollTOTP for application/json ContentType.
+type UserEnrollTOTPJSONRequestBody = ApiV1EnrollTOTPRequest
+
+// UserConfirmTOTPJSONRequestBody defines body for UserConfirmTOTP for application/json ContentType.
+type UserConfirmTOTPJSONRequestBody = ApiV1ConfirmTOTPRequest
+
+// UserRequestDataExportJSONRequestBody defines body for UserRequestDataExport for application/json ContentType.
+type UserRequestDataExportJSONRequestBody = ApiV1RequestDataExportRequest
+
+// UserUpdateMyIdentityJSONRequestBody defines body for UserUpdateMyIdentity for application/json ContentType.
+type UserUpdateMyIdentityJSONRequestBody = ApiV1UpdateMyIdentityRequest
+
+// InterestSetMyInterestsJSONRequestBody defines body for InterestSetMyInterests for application/json ContentType.
+type InterestSetMyInterestsJSONRequestBody = ApiV1SetMyInterestsRequest
+
+// LocationUpdateMyLocationJSONRequestBody defines body for LocationUpdateMyLocation for application/json ContentType.
+type LocationUpdateMyLocationJSONRequestBody = ApiV1UpdateMyLocationRequest
+
+// UserChangePasswordJSONRequestBody defines body for UserChangePassword for application/json ContentType.
+type UserChangePasswordJSONRequestBody = ApiV1ChangePasswordRequest
+
+// PhotoReorderPhotosJSONRequestBody defines body for PhotoReorderPhotos for application/json ContentType.
+type PhotoReorderPhotosJSONRequestBody = ApiV1ReorderPhotosRequest
+
+// PreferencesUpdateMyPreferencesJSONRequestBody defines body for PreferencesUpdateMyPreferences for application/json ContentType.
+type PreferencesUpdateMyPreferencesJSONRequestBody = ApiV1UpdateMyPreferencesRequest
+
+// ProfileUpdateMyProfileJSONRequestBody defines body for ProfileUpdateMyProfile for application/json ContentType.
+type ProfileUpdateMyProfileJSONRequestBody = ApiV1UpdateMyProfileRequest
+
+// UserRequestPasswordResetJSONRequestBody defines body for UserRequestPasswordReset for application/json ContentType.
+type UserRequestPasswordResetJSONRequestBody = ApiV1RequestPasswordResetRequest
+
+// UserVerifyPasswordResetJSONRequestBody defines body for UserVerifyPasswordReset for application/json ContentType.
+type UserVerifyPasswordResetJSONRequestBody = ApiV1VerifyPasswordResetRequest
+
+// UserResetPasswordJSONRequestBody defines body for UserResetPassword for application/json ContentType.
+type UserResetPasswordJSONRequestBody = ApiV1ResetPasswordRequest
+
+// UserSendPhoneVerificationJSONRequestBody defines body for UserSendPhoneVerification for application/json ContentType.
+type UserSendPhoneVerificationJSONRequestBody = ApiV1SendPhoneVerificationRequest
+
+// UserVerifyPhoneNumberJSONRequestBody defines body for UserVerifyPhoneNumber for application/json ContentType.
+type UserVerifyPhoneNumberJSONRequestBody = ApiV1VerifyPhoneNumberRequest
+
 // UserCreateUserTokenJSONRequestBody defines body for UserCreateUserToken for application/json ContentType.
 type UserCreateUserTokenJSONRequestBody = ApiV1CreateUserTokenRequest
 
+// UserCompleteMFALoginJSONRequestBody defines body for UserCompleteMFALogin for application/json ContentType.
+type UserCompleteMFALoginJSONRequestBody = ApiV1CompleteMFALoginRequest
+
+// UserRefreshUserTokenJSONRequestBody defines body for UserRefreshUserToken for application/json ContentType.
+type UserRefreshUserTokenJSONRequestBody = ApiV1RefreshUserTokenRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -126,19 +618,152 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// UserChangeUserRoleWithBody request with any body
+	UserChangeUserRoleWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserChangeUserRole(ctx context.Context, id string, body UserChangeUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InterestListInterests request
+	InterestListInterests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserCreateUserWithBody request with any body
 	UserCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UserCreateUser(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserLogoutWithBody request with any body
+	UserLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserLogout(ctx context.Context, body UserLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserLogoutAllWithBody request with any body
+	UserLogoutAllWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserLogoutAll(ctx context.Context, body UserLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserDeleteMe request
+	UserDeleteMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetMe request
+	UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserEnrollTOTPWithBody request with any body
+	UserEnrollTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserEnrollTOTP(ctx context.Context, body UserEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserConfirmTOTPWithBody request with any body
+	UserConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserConfirmTOTP(ctx context.Context, body UserConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRequestDataExportWithBody request with any body
+	UserRequestDataExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserRequestDataExport(ctx context.Context, body UserRequestDataExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserUpdateMyIdentityWithBody request with any body
+	UserUpdateMyIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserUpdateMyIdentity(ctx context.Context, body UserUpdateMyIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InterestListMyInterests request
+	InterestListMyInterests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InterestSetMyInterestsWithBody request with any body
+	InterestSetMyInterestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InterestSetMyInterests(ctx context.Context, body InterestSetMyInterestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LocationGetMyLocation request
+	LocationGetMyLocation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LocationUpdateMyLocationWithBody request with any body
+	LocationUpdateMyLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LocationUpdateMyLocation(ctx context.Context, body LocationUpdateMyLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserChangePasswordWithBody request with any body
+	UserChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserChangePassword(ctx context.Context, body UserChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PhotoListMyPhotos request
+	PhotoListMyPhotos(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PhotoReorderPhotosWithBody request with any body
+	PhotoReorderPhotosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PhotoReorderPhotos(ctx context.Context, body PhotoReorderPhotosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PhotoDeletePhoto request
+	PhotoDeletePhoto(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreferencesGetMyPreferences request
+	PreferencesGetMyPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreferencesUpdateMyPreferencesWithBody request with any body
+	PreferencesUpdateMyPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreferencesUpdateMyPreferences(ctx context.Context, body PreferencesUpdateMyPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProfileGetMyProfile request
+	ProfileGetMyProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProfileUpdateMyProfileWithBody request with any body
+	ProfileUpdateMyProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProfileUpdateMyProfile(ctx context.Context, body ProfileUpdateMyProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListSessions request
+	UserListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRevokeSession request
+	UserRevokeSession(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRequestPasswordResetWithBody request with any body
+	UserRequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserRequestPasswordReset(ctx context.Context, body UserRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserVerifyPasswordResetWithBody request with any body
+	UserVerifyPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserVerifyPasswordReset(ctx context.Context, body UserVerifyPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserResetPasswordWithBody request with any body
+	UserResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserResetPassword(ctx context.Context, body UserResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserSendPhoneVerificationWithBody request with any body
+	UserSendPhoneVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserSendPhoneVerification(ctx context.Context, body UserSendPhoneVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserVerifyPhoneNumberWithBody request with any body
+	UserVerifyPhoneNumberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserVerifyPhoneNumber(ctx context.Context, body UserVerifyPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserCreateUserTokenWithBody request with any body
 	UserCreateUserTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UserCreateUserToken(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCompleteMFALoginWithBody request with any body
+	UserCompleteMFALoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserCompleteMFALogin(ctx context.Context, body UserCompleteMFALoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRefreshUserTokenWithBody request with any body
+	UserRefreshUserTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserRefreshUserToken(ctx context.Context, body UserRefreshUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) UserCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) UserChangeUserRoleWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserChangeUserRoleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +774,8 @@ func (c *Client) UserCreateUserWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateUser(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateUserRequest(c.Server, body)
+func (c *Client) UserChangeUserRole(ctx context.Context, id string, body UserChangeUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserChangeUserRoleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +786,8 @@ func (c *Client) UserCreateUser(ctx context.Context, body UserCreateUserJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateUserTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateUserTokenRequestWithBody(c.Server, contentType, body)
+func (c *Client) InterestListInterests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterestListInterestsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -173,8 +798,8 @@ func (c *Client) UserCreateUserTokenWithBody(ctx context.Context, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateUserToken(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateUserTokenRequest(c.Server, body)
+func (c *Client) UserCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -185,234 +810,4024 @@ func (c *Client) UserCreateUserToken(ctx context.Context, body UserCreateUserTok
 	return c.Client.Do(req)
 }
 
-// NewUserCreateUserRequest calls the generic UserCreateUser builder with application/json body
-func NewUserCreateUserRequest(server string, body UserCreateUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserCreateUser(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserCreateUserRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUserCreateUserRequestWithBody generates requests for UserCreateUser with any type of body
-func NewUserCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserLogout(ctx context.Context, body UserLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUserCreateUserTokenRequest calls the generic UserCreateUserToken builder with application/json body
-func NewUserCreateUserTokenRequest(server string, body UserCreateUserTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserLogoutAllWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLogoutAllRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserCreateUserTokenRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUserCreateUserTokenRequestWithBody generates requests for UserCreateUserToken with any type of body
-func NewUserCreateUserTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) UserLogoutAll(ctx context.Context, body UserLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLogoutAllRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserDeleteMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserDeleteMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/users/token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserEnrollTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserEnrollTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) UserEnrollTOTP(ctx context.Context, body UserEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserEnrollTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) UserConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserConfirmTOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) UserConfirmTOTP(ctx context.Context, body UserConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserConfirmTOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) UserRequestDataExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestDataExportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) UserRequestDataExport(ctx context.Context, body UserRequestDataExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestDataExportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) UserUpdateMyIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateMyIdentityRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) UserUpdateMyIdentity(ctx context.Context, body UserUpdateMyIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateMyIdentityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// UserCreateUserWithBodyWithResponse request with any body
-	UserCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error)
+func (c *Client) InterestListMyInterests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterestListMyInterestsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	UserCreateUserWithResponse(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error)
+func (c *Client) InterestSetMyInterestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterestSetMyInterestsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// UserCreateUserTokenWithBodyWithResponse request with any body
-	UserCreateUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error)
+func (c *Client) InterestSetMyInterests(ctx context.Context, body InterestSetMyInterestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInterestSetMyInterestsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	UserCreateUserTokenWithResponse(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error)
+func (c *Client) LocationGetMyLocation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLocationGetMyLocationRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UserCreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApiV1CreateUserResponse
+func (c *Client) LocationUpdateMyLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLocationUpdateMyLocationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserCreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) LocationUpdateMyLocation(ctx context.Context, body LocationUpdateMyLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLocationUpdateMyLocationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) UserChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserChangePasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UserCreateUserTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApiV1CreateUserTokenResponse
+func (c *Client) UserChangePassword(ctx context.Context, body UserChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserChangePasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserCreateUserTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PhotoListMyPhotos(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPhotoListMyPhotosRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateUserTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PhotoReorderPhotosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPhotoReorderPhotosRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UserCreateUserWithBodyWithResponse request with arbitrary body returning *UserCreateUserResponse
-func (c *ClientWithResponses) UserCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error) {
-	rsp, err := c.UserCreateUserWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PhotoReorderPhotos(ctx context.Context, body PhotoReorderPhotosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPhotoReorderPhotosRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateUserResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) UserCreateUserWithResponse(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error) {
-	rsp, err := c.UserCreateUser(ctx, body, reqEditors...)
+func (c *Client) PhotoDeletePhoto(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPhotoDeletePhotoRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateUserResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UserCreateUserTokenWithBodyWithResponse request with arbitrary body returning *UserCreateUserTokenResponse
-func (c *ClientWithResponses) UserCreateUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error) {
-	rsp, err := c.UserCreateUserTokenWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PreferencesGetMyPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreferencesGetMyPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreferencesUpdateMyPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreferencesUpdateMyPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreferencesUpdateMyPreferences(ctx context.Context, body PreferencesUpdateMyPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreferencesUpdateMyPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProfileGetMyProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProfileGetMyProfileRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProfileUpdateMyProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProfileUpdateMyProfileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProfileUpdateMyProfile(ctx context.Context, body ProfileUpdateMyProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProfileUpdateMyProfileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRevokeSession(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRevokeSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRequestPasswordReset(ctx context.Context, body UserRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserVerifyPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserVerifyPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserVerifyPasswordReset(ctx context.Context, body UserVerifyPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserVerifyPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserResetPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserResetPassword(ctx context.Context, body UserResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserResetPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSendPhoneVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSendPhoneVerificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSendPhoneVerification(ctx context.Context, body UserSendPhoneVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSendPhoneVerificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserVerifyPhoneNumberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserVerifyPhoneNumberRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserVerifyPhoneNumber(ctx context.Context, body UserVerifyPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserVerifyPhoneNumberRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateUserTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateUserTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateUserToken(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateUserTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCompleteMFALoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCompleteMFALoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCompleteMFALogin(ctx context.Context, body UserCompleteMFALoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCompleteMFALoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRefreshUserTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRefreshUserTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRefreshUserToken(ctx context.Context, body UserRefreshUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRefreshUserTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewUserChangeUserRoleRequest calls the generic UserChangeUserRole builder with application/json body
+func NewUserChangeUserRoleRequest(server string, id string, body UserChangeUserRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserChangeUserRoleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUserChangeUserRoleRequestWithBody generates requests for UserChangeUserRole with any type of body
+func NewUserChangeUserRoleRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInterestListInterestsRequest generates requests for InterestListInterests
+func NewInterestListInterestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/interests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserCreateUserRequest calls the generic UserCreateUser builder with application/json body
+func NewUserCreateUserRequest(server string, body UserCreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateUserRequestWithBody generates requests for UserCreateUser with any type of body
+func NewUserCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserLogoutRequest calls the generic UserLogout builder with application/json body
+func NewUserLogoutRequest(server string, body UserLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserLogoutRequestWithBody generates requests for UserLogout with any type of body
+func NewUserLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserLogoutAllRequest calls the generic UserLogoutAll builder with application/json body
+func NewUserLogoutAllRequest(server string, body UserLogoutAllJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserLogoutAllRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserLogoutAllRequestWithBody generates requests for UserLogoutAll with any type of body
+func NewUserLogoutAllRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/logout/all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserDeleteMeRequest generates requests for UserDeleteMe
+func NewUserDeleteMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserGetMeRequest generates requests for UserGetMe
+func NewUserGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserEnrollTOTPRequest calls the generic UserEnrollTOTP builder with application/json body
+func NewUserEnrollTOTPRequest(server string, body UserEnrollTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserEnrollTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserEnrollTOTPRequestWithBody generates requests for UserEnrollTOTP with any type of body
+func NewUserEnrollTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/2fa/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserConfirmTOTPRequest calls the generic UserConfirmTOTP builder with application/json body
+func NewUserConfirmTOTPRequest(server string, body UserConfirmTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserConfirmTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserConfirmTOTPRequestWithBody generates requests for UserConfirmTOTP with any type of body
+func NewUserConfirmTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/2fa/totp/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserRequestDataExportRequest calls the generic UserRequestDataExport builder with application/json body
+func NewUserRequestDataExportRequest(server string, body UserRequestDataExportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserRequestDataExportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserRequestDataExportRequestWithBody generates requests for UserRequestDataExport with any type of body
+func NewUserRequestDataExportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateMyIdentityRequest calls the generic UserUpdateMyIdentity builder with application/json body
+func NewUserUpdateMyIdentityRequest(server string, body UserUpdateMyIdentityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdateMyIdentityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdateMyIdentityRequestWithBody generates requests for UserUpdateMyIdentity with any type of body
+func NewUserUpdateMyIdentityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/identity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInterestListMyInterestsRequest generates requests for InterestListMyInterests
+func NewInterestListMyInterestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/interests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInterestSetMyInterestsRequest calls the generic InterestSetMyInterests builder with application/json body
+func NewInterestSetMyInterestsRequest(server string, body InterestSetMyInterestsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInterestSetMyInterestsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInterestSetMyInterestsRequestWithBody generates requests for InterestSetMyInterests with any type of body
+func NewInterestSetMyInterestsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/interests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLocationGetMyLocationRequest generates requests for LocationGetMyLocation
+func NewLocationGetMyLocationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/location")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLocationUpdateMyLocationRequest calls the generic LocationUpdateMyLocation builder with application/json body
+func NewLocationUpdateMyLocationRequest(server string, body LocationUpdateMyLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLocationUpdateMyLocationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLocationUpdateMyLocationRequestWithBody generates requests for LocationUpdateMyLocation with any type of body
+func NewLocationUpdateMyLocationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/location")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserChangePasswordRequest calls the generic UserChangePassword builder with application/json body
+func NewUserChangePasswordRequest(server string, body UserChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserChangePasswordRequestWithBody generates requests for UserChangePassword with any type of body
+func NewUserChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPhotoListMyPhotosRequest generates requests for PhotoListMyPhotos
+func NewPhotoListMyPhotosRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/photos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPhotoReorderPhotosRequest calls the generic PhotoReorderPhotos builder with application/json body
+func NewPhotoReorderPhotosRequest(server string, body PhotoReorderPhotosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPhotoReorderPhotosRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPhotoReorderPhotosRequestWithBody generates requests for PhotoReorderPhotos with any type of body
+func NewPhotoReorderPhotosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/photos/order")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPhotoDeletePhotoRequest generates requests for PhotoDeletePhoto
+func NewPhotoDeletePhotoRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/photos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPreferencesGetMyPreferencesRequest generates requests for PreferencesGetMyPreferences
+func NewPreferencesGetMyPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPreferencesUpdateMyPreferencesRequest calls the generic PreferencesUpdateMyPreferences builder with application/json body
+func NewPreferencesUpdateMyPreferencesRequest(server string, body PreferencesUpdateMyPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreferencesUpdateMyPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPreferencesUpdateMyPreferencesRequestWithBody generates requests for PreferencesUpdateMyPreferences with any type of body
+func NewPreferencesUpdateMyPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewProfileGetMyProfileRequest generates requests for ProfileGetMyProfile
+func NewProfileGetMyProfileRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProfileUpdateMyProfileRequest calls the generic ProfileUpdateMyProfile builder with application/json body
+func NewProfileUpdateMyProfileRequest(server string, body ProfileUpdateMyProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProfileUpdateMyProfileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewProfileUpdateMyProfileRequestWithBody generates requests for ProfileUpdateMyProfile with any type of body
+func NewProfileUpdateMyProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserListSessionsRequest generates requests for UserListSessions
+func NewUserListSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserRevokeSessionRequest generates requests for UserRevokeSession
+func NewUserRevokeSessionRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserRequestPasswordResetRequest calls the generic UserRequestPasswordReset builder with application/json body
+func NewUserRequestPasswordResetRequest(server string, body UserRequestPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserRequestPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserRequestPasswordResetRequestWithBody generates requests for UserRequestPasswordReset with any type of body
+func NewUserRequestPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserVerifyPasswordResetRequest calls the generic UserVerifyPasswordReset builder with application/json body
+func NewUserVerifyPasswordResetRequest(server string, body UserVerifyPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserVerifyPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserVerifyPasswordResetRequestWithBody generates requests for UserVerifyPasswordReset with any type of body
+func NewUserVerifyPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password/forgot/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserResetPasswordRequest calls the generic UserResetPassword builder with application/json body
+func NewUserResetPasswordRequest(server string, body UserResetPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserResetPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserResetPasswordRequestWithBody generates requests for UserResetPassword with any type of body
+func NewUserResetPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserSendPhoneVerificationRequest calls the generic UserSendPhoneVerification builder with application/json body
+func NewUserSendPhoneVerificationRequest(server string, body UserSendPhoneVerificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserSendPhoneVerificationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserSendPhoneVerificationRequestWithBody generates requests for UserSendPhoneVerification with any type of body
+func NewUserSendPhoneVerificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/phone/otp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserVerifyPhoneNumberRequest calls the generic UserVerifyPhoneNumber builder with application/json body
+func NewUserVerifyPhoneNumberRequest(server string, body UserVerifyPhoneNumberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserVerifyPhoneNumberRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserVerifyPhoneNumberRequestWithBody generates requests for UserVerifyPhoneNumber with any type of body
+func NewUserVerifyPhoneNumberRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/phone/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserCreateUserTokenRequest calls the generic UserCreateUserToken builder with application/json body
+func NewUserCreateUserTokenRequest(server string, body UserCreateUserTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateUserTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateUserTokenRequestWithBody generates requests for UserCreateUserToken with any type of body
+func NewUserCreateUserTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserCompleteMFALoginRequest calls the generic UserCompleteMFALogin builder with application/json body
+func NewUserCompleteMFALoginRequest(server string, body UserCompleteMFALoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCompleteMFALoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCompleteMFALoginRequestWithBody generates requests for UserCompleteMFALogin with any type of body
+func NewUserCompleteMFALoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/token/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserRefreshUserTokenRequest calls the generic UserRefreshUserToken builder with application/json body
+func NewUserRefreshUserTokenRequest(server string, body UserRefreshUserTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserRefreshUserTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserRefreshUserTokenRequestWithBody generates requests for UserRefreshUserToken with any type of body
+func NewUserRefreshUserTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/token/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// UserChangeUserRoleWithBodyWithResponse request with any body
+	UserChangeUserRoleWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserChangeUserRoleResponse, error)
+
+	UserChangeUserRoleWithResponse(ctx context.Context, id string, body UserChangeUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UserChangeUserRoleResponse, error)
+
+	// InterestListInterestsWithResponse request
+	InterestListInterestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InterestListInterestsResponse, error)
+
+	// UserCreateUserWithBodyWithResponse request with any body
+	UserCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error)
+
+	UserCreateUserWithResponse(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error)
+
+	// UserLogoutWithBodyWithResponse request with any body
+	UserLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLogoutResponse, error)
+
+	UserLogoutWithResponse(ctx context.Context, body UserLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLogoutResponse, error)
+
+	// UserLogoutAllWithBodyWithResponse request with any body
+	UserLogoutAllWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLogoutAllResponse, error)
+
+	UserLogoutAllWithResponse(ctx context.Context, body UserLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLogoutAllResponse, error)
+
+	// UserDeleteMeWithResponse request
+	UserDeleteMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserDeleteMeResponse, error)
+
+	// UserGetMeWithResponse request
+	UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error)
+
+	// UserEnrollTOTPWithBodyWithResponse request with any body
+	UserEnrollTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserEnrollTOTPResponse, error)
+
+	UserEnrollTOTPWithResponse(ctx context.Context, body UserEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserEnrollTOTPResponse, error)
+
+	// UserConfirmTOTPWithBodyWithResponse request with any body
+	UserConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserConfirmTOTPResponse, error)
+
+	UserConfirmTOTPWithResponse(ctx context.Context, body UserConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserConfirmTOTPResponse, error)
+
+	// UserRequestDataExportWithBodyWithResponse request with any body
+	UserRequestDataExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestDataExportResponse, error)
+
+	UserRequestDataExportWithResponse(ctx context.Context, body UserRequestDataExportJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestDataExportResponse, error)
+
+	// UserUpdateMyIdentityWithBodyWithResponse request with any body
+	UserUpdateMyIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateMyIdentityResponse, error)
+
+	UserUpdateMyIdentityWithResponse(ctx context.Context, body UserUpdateMyIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateMyIdentityResponse, error)
+
+	// InterestListMyInterestsWithResponse request
+	InterestListMyInterestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InterestListMyInterestsResponse, error)
+
+	// InterestSetMyInterestsWithBodyWithResponse request with any body
+	InterestSetMyInterestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InterestSetMyInterestsResponse, error)
+
+	InterestSetMyInterestsWithResponse(ctx context.Context, body InterestSetMyInterestsJSONRequestBody, reqEditors ...RequestEditorFn) (*InterestSetMyInterestsResponse, error)
+
+	// LocationGetMyLocationWithResponse request
+	LocationGetMyLocationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LocationGetMyLocationResponse, error)
+
+	// LocationUpdateMyLocationWithBodyWithResponse request with any body
+	LocationUpdateMyLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LocationUpdateMyLocationResponse, error)
+
+	LocationUpdateMyLocationWithResponse(ctx context.Context, body LocationUpdateMyLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*LocationUpdateMyLocationResponse, error)
+
+	// UserChangePasswordWithBodyWithResponse request with any body
+	UserChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserChangePasswordResponse, error)
+
+	UserChangePasswordWithResponse(ctx context.Context, body UserChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserChangePasswordResponse, error)
+
+	// PhotoListMyPhotosWithResponse request
+	PhotoListMyPhotosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PhotoListMyPhotosResponse, error)
+
+	// PhotoReorderPhotosWithBodyWithResponse request with any body
+	PhotoReorderPhotosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PhotoReorderPhotosResponse, error)
+
+	PhotoReorderPhotosWithResponse(ctx context.Context, body PhotoReorderPhotosJSONRequestBody, reqEditors ...RequestEditorFn) (*PhotoReorderPhotosResponse, error)
+
+	// PhotoDeletePhotoWithResponse request
+	PhotoDeletePhotoWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PhotoDeletePhotoResponse, error)
+
+	// PreferencesGetMyPreferencesWithResponse request
+	PreferencesGetMyPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreferencesGetMyPreferencesResponse, error)
+
+	// PreferencesUpdateMyPreferencesWithBodyWithResponse request with any body
+	PreferencesUpdateMyPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreferencesUpdateMyPreferencesResponse, error)
+
+	PreferencesUpdateMyPreferencesWithResponse(ctx context.Context, body PreferencesUpdateMyPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PreferencesUpdateMyPreferencesResponse, error)
+
+	// ProfileGetMyProfileWithResponse request
+	ProfileGetMyProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProfileGetMyProfileResponse, error)
+
+	// ProfileUpdateMyProfileWithBodyWithResponse request with any body
+	ProfileUpdateMyProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProfileUpdateMyProfileResponse, error)
+
+	ProfileUpdateMyProfileWithResponse(ctx context.Context, body ProfileUpdateMyProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*ProfileUpdateMyProfileResponse, error)
+
+	// UserListSessionsWithResponse request
+	UserListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListSessionsResponse, error)
+
+	// UserRevokeSessionWithResponse request
+	UserRevokeSessionWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserRevokeSessionResponse, error)
+
+	// UserRequestPasswordResetWithBodyWithResponse request with any body
+	UserRequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPasswordResetResponse, error)
+
+	UserRequestPasswordResetWithResponse(ctx context.Context, body UserRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPasswordResetResponse, error)
+
+	// UserVerifyPasswordResetWithBodyWithResponse request with any body
+	UserVerifyPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserVerifyPasswordResetResponse, error)
+
+	UserVerifyPasswordResetWithResponse(ctx context.Context, body UserVerifyPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*UserVerifyPasswordResetResponse, error)
+
+	// UserResetPasswordWithBodyWithResponse request with any body
+	UserResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserResetPasswordResponse, error)
+
+	UserResetPasswordWithResponse(ctx context.Context, body UserResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserResetPasswordResponse, error)
+
+	// UserSendPhoneVerificationWithBodyWithResponse request with any body
+	UserSendPhoneVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserSendPhoneVerificationResponse, error)
+
+	UserSendPhoneVerificationWithResponse(ctx context.Context, body UserSendPhoneVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*UserSendPhoneVerificationResponse, error)
+
+	// UserVerifyPhoneNumberWithBodyWithResponse request with any body
+	UserVerifyPhoneNumberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserVerifyPhoneNumberResponse, error)
+
+	UserVerifyPhoneNumberWithResponse(ctx context.Context, body UserVerifyPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*UserVerifyPhoneNumberResponse, error)
+
+	// UserCreateUserTokenWithBodyWithResponse request with any body
+	UserCreateUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error)
+
+	UserCreateUserTokenWithResponse(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error)
+
+	// UserCompleteMFALoginWithBodyWithResponse request with any body
+	UserCompleteMFALoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCompleteMFALoginResponse, error)
+
+	UserCompleteMFALoginWithResponse(ctx context.Context, body UserCompleteMFALoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCompleteMFALoginResponse, error)
+
+	// UserRefreshUserTokenWithBodyWithResponse request with any body
+	UserRefreshUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRefreshUserTokenResponse, error)
+
+	UserRefreshUserTokenWithResponse(ctx context.Context, body UserRefreshUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRefreshUserTokenResponse, error)
+}
+
+type UserChangeUserRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ChangeUserRoleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserChangeUserRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserChangeUserRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InterestListInterestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListInterestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r InterestListInterestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InterestListInterestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1CreateUserResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1LogoutResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserLogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1LogoutResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserLogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserLogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserDeleteMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1DeleteMeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserDeleteMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserDeleteMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1GetMeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserEnrollTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1EnrollTOTPResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserEnrollTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserEnrollTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserConfirmTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ConfirmTOTPResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserConfirmTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserConfirmTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRequestDataExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1RequestDataExportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRequestDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRequestDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserUpdateMyIdentityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1GetMeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserUpdateMyIdentityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserUpdateMyIdentityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InterestListMyInterestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListInterestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r InterestListMyInterestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InterestListMyInterestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InterestSetMyInterestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListInterestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r InterestSetMyInterestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InterestSetMyInterestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LocationGetMyLocationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1LocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LocationGetMyLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LocationGetMyLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LocationUpdateMyLocationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1LocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LocationUpdateMyLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LocationUpdateMyLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ChangePasswordResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PhotoListMyPhotosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListPhotosResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PhotoListMyPhotosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PhotoListMyPhotosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PhotoReorderPhotosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListPhotosResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PhotoReorderPhotosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PhotoReorderPhotosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PhotoDeletePhotoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1DeletePhotoResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PhotoDeletePhotoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PhotoDeletePhotoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreferencesGetMyPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1PreferencesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PreferencesGetMyPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreferencesGetMyPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreferencesUpdateMyPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1PreferencesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PreferencesUpdateMyPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreferencesUpdateMyPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProfileGetMyProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ProfileResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ProfileGetMyProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProfileGetMyProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProfileUpdateMyProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ProfileResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ProfileUpdateMyProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProfileUpdateMyProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ListSessionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1RevokeSessionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRequestPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1RequestPasswordResetResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRequestPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRequestPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserVerifyPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1VerifyPasswordResetResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserVerifyPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserVerifyPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserResetPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1ResetPasswordResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserResetPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserResetPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserSendPhoneVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1SendPhoneVerificationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserSendPhoneVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserSendPhoneVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserVerifyPhoneNumberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1VerifyPhoneNumberResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserVerifyPhoneNumberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserVerifyPhoneNumberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCreateUserTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1CreateUserTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateUserTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateUserTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCompleteMFALoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1CreateUserTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCompleteMFALoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCompleteMFALoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRefreshUserTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiV1CreateUserTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRefreshUserTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRefreshUserTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// UserChangeUserRoleWithBodyWithResponse request with arbitrary body returning *UserChangeUserRoleResponse
+func (c *ClientWithResponses) UserChangeUserRoleWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserChangeUserRoleResponse, error) {
+	rsp, err := c.UserChangeUserRoleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserChangeUserRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserChangeUserRoleWithResponse(ctx context.Context, id string, body UserChangeUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UserChangeUserRoleResponse, error) {
+	rsp, err := c.UserChangeUserRole(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserChangeUserRoleResponse(rsp)
+}
+
+// InterestListInterestsWithResponse request returning *InterestListInterestsResponse
+func (c *ClientWithResponses) InterestListInterestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InterestListInterestsResponse, error) {
+	rsp, err := c.InterestListInterests(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterestListInterestsResponse(rsp)
+}
+
+// UserCreateUserWithBodyWithResponse request with arbitrary body returning *UserCreateUserResponse
+func (c *ClientWithResponses) UserCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error) {
+	rsp, err := c.UserCreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateUserWithResponse(ctx context.Context, body UserCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserResponse, error) {
+	rsp, err := c.UserCreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateUserResponse(rsp)
+}
+
+// UserLogoutWithBodyWithResponse request with arbitrary body returning *UserLogoutResponse
+func (c *ClientWithResponses) UserLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLogoutResponse, error) {
+	rsp, err := c.UserLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserLogoutWithResponse(ctx context.Context, body UserLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLogoutResponse, error) {
+	rsp, err := c.UserLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLogoutResponse(rsp)
+}
+
+// UserLogoutAllWithBodyWithResponse request with arbitrary body returning *UserLogoutAllResponse
+func (c *ClientWithResponses) UserLogoutAllWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLogoutAllResponse, error) {
+	rsp, err := c.UserLogoutAllWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLogoutAllResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserLogoutAllWithResponse(ctx context.Context, body UserLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLogoutAllResponse, error) {
+	rsp, err := c.UserLogoutAll(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLogoutAllResponse(rsp)
+}
+
+// UserDeleteMeWithResponse request returning *UserDeleteMeResponse
+func (c *ClientWithResponses) UserDeleteMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserDeleteMeResponse, error) {
+	rsp, err := c.UserDeleteMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserDeleteMeResponse(rsp)
+}
+
+// UserGetMeWithResponse request returning *UserGetMeResponse
+func (c *ClientWithResponses) UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error) {
+	rsp, err := c.UserGetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetMeResponse(rsp)
+}
+
+// UserEnrollTOTPWithBodyWithResponse request with arbitrary body returning *UserEnrollTOTPResponse
+func (c *ClientWithResponses) UserEnrollTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserEnrollTOTPResponse, error) {
+	rsp, err := c.UserEnrollTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserEnrollTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserEnrollTOTPWithResponse(ctx context.Context, body UserEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserEnrollTOTPResponse, error) {
+	rsp, err := c.UserEnrollTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserEnrollTOTPResponse(rsp)
+}
+
+// UserConfirmTOTPWithBodyWithResponse request with arbitrary body returning *UserConfirmTOTPResponse
+func (c *ClientWithResponses) UserConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserConfirmTOTPResponse, error) {
+	rsp, err := c.UserConfirmTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserConfirmTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserConfirmTOTPWithResponse(ctx context.Context, body UserConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserConfirmTOTPResponse, error) {
+	rsp, err := c.UserConfirmTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserConfirmTOTPResponse(rsp)
+}
+
+// UserRequestDataExportWithBodyWithResponse request with arbitrary body returning *UserRequestDataExportResponse
+func (c *ClientWithResponses) UserRequestDataExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestDataExportResponse, error) {
+	rsp, err := c.UserRequestDataExportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestDataExportResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserRequestDataExportWithResponse(ctx context.Context, body UserRequestDataExportJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestDataExportResponse, error) {
+	rsp, err := c.UserRequestDataExport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestDataExportResponse(rsp)
+}
+
+// UserUpdateMyIdentityWithBodyWithResponse request with arbitrary body returning *UserUpdateMyIdentityResponse
+func (c *ClientWithResponses) UserUpdateMyIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateMyIdentityResponse, error) {
+	rsp, err := c.UserUpdateMyIdentityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateMyIdentityResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserUpdateMyIdentityWithResponse(ctx context.Context, body UserUpdateMyIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateMyIdentityResponse, error) {
+	rsp, err := c.UserUpdateMyIdentity(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateMyIdentityResponse(rsp)
+}
+
+// InterestListMyInterestsWithResponse request returning *InterestListMyInterestsResponse
+func (c *ClientWithResponses) InterestListMyInterestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InterestListMyInterestsResponse, error) {
+	rsp, err := c.InterestListMyInterests(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterestListMyInterestsResponse(rsp)
+}
+
+// InterestSetMyInterestsWithBodyWithResponse request with arbitrary body returning *InterestSetMyInterestsResponse
+func (c *ClientWithResponses) InterestSetMyInterestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InterestSetMyInterestsResponse, error) {
+	rsp, err := c.InterestSetMyInterestsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterestSetMyInterestsResponse(rsp)
+}
+
+func (c *ClientWithResponses) InterestSetMyInterestsWithResponse(ctx context.Context, body InterestSetMyInterestsJSONRequestBody, reqEditors ...RequestEditorFn) (*InterestSetMyInterestsResponse, error) {
+	rsp, err := c.InterestSetMyInterests(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInterestSetMyInterestsResponse(rsp)
+}
+
+// LocationGetMyLocationWithResponse request returning *LocationGetMyLocationResponse
+func (c *ClientWithResponses) LocationGetMyLocationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LocationGetMyLocationResponse, error) {
+	rsp, err := c.LocationGetMyLocation(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLocationGetMyLocationResponse(rsp)
+}
+
+// LocationUpdateMyLocationWithBodyWithResponse request with arbitrary body returning *LocationUpdateMyLocationResponse
+func (c *ClientWithResponses) LocationUpdateMyLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LocationUpdateMyLocationResponse, error) {
+	rsp, err := c.LocationUpdateMyLocationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLocationUpdateMyLocationResponse(rsp)
+}
+
+func (c *ClientWithResponses) LocationUpdateMyLocationWithResponse(ctx context.Context, body LocationUpdateMyLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*LocationUpdateMyLocationResponse, error) {
+	rsp, err := c.LocationUpdateMyLocation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLocationUpdateMyLocationResponse(rsp)
+}
+
+// UserChangePasswordWithBodyWithResponse request with arbitrary body returning *UserChangePasswordResponse
+func (c *ClientWithResponses) UserChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserChangePasswordResponse, error) {
+	rsp, err := c.UserChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserChangePasswordWithResponse(ctx context.Context, body UserChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserChangePasswordResponse, error) {
+	rsp, err := c.UserChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserChangePasswordResponse(rsp)
+}
+
+// PhotoListMyPhotosWithResponse request returning *PhotoListMyPhotosResponse
+func (c *ClientWithResponses) PhotoListMyPhotosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PhotoListMyPhotosResponse, error) {
+	rsp, err := c.PhotoListMyPhotos(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePhotoListMyPhotosResponse(rsp)
+}
+
+// PhotoReorderPhotosWithBodyWithResponse request with arbitrary body returning *PhotoReorderPhotosResponse
+func (c *ClientWithResponses) PhotoReorderPhotosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PhotoReorderPhotosResponse, error) {
+	rsp, err := c.PhotoReorderPhotosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePhotoReorderPhotosResponse(rsp)
+}
+
+func (c *ClientWithResponses) PhotoReorderPhotosWithResponse(ctx context.Context, body PhotoReorderPhotosJSONRequestBody, reqEditors ...RequestEditorFn) (*PhotoReorderPhotosResponse, error) {
+	rsp, err := c.PhotoReorderPhotos(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePhotoReorderPhotosResponse(rsp)
+}
+
+// PhotoDeletePhotoWithResponse request returning *PhotoDeletePhotoResponse
+func (c *ClientWithResponses) PhotoDeletePhotoWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PhotoDeletePhotoResponse, error) {
+	rsp, err := c.PhotoDeletePhoto(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePhotoDeletePhotoResponse(rsp)
+}
+
+// PreferencesGetMyPreferencesWithResponse request returning *PreferencesGetMyPreferencesResponse
+func (c *ClientWithResponses) PreferencesGetMyPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreferencesGetMyPreferencesResponse, error) {
+	rsp, err := c.PreferencesGetMyPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreferencesGetMyPreferencesResponse(rsp)
+}
+
+// PreferencesUpdateMyPreferencesWithBodyWithResponse request with arbitrary body returning *PreferencesUpdateMyPreferencesResponse
+func (c *ClientWithResponses) PreferencesUpdateMyPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreferencesUpdateMyPreferencesResponse, error) {
+	rsp, err := c.PreferencesUpdateMyPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreferencesUpdateMyPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreferencesUpdateMyPreferencesWithResponse(ctx context.Context, body PreferencesUpdateMyPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PreferencesUpdateMyPreferencesResponse, error) {
+	rsp, err := c.PreferencesUpdateMyPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreferencesUpdateMyPreferencesResponse(rsp)
+}
+
+// ProfileGetMyProfileWithResponse request returning *ProfileGetMyProfileResponse
+func (c *ClientWithResponses) ProfileGetMyProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProfileGetMyProfileResponse, error) {
+	rsp, err := c.ProfileGetMyProfile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProfileGetMyProfileResponse(rsp)
+}
+
+// ProfileUpdateMyProfileWithBodyWithResponse request with arbitrary body returning *ProfileUpdateMyProfileResponse
+func (c *ClientWithResponses) ProfileUpdateMyProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProfileUpdateMyProfileResponse, error) {
+	rsp, err := c.ProfileUpdateMyProfileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProfileUpdateMyProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) ProfileUpdateMyProfileWithResponse(ctx context.Context, body ProfileUpdateMyProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*ProfileUpdateMyProfileResponse, error) {
+	rsp, err := c.ProfileUpdateMyProfile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProfileUpdateMyProfileResponse(rsp)
+}
+
+// UserListSessionsWithResponse request returning *UserListSessionsResponse
+func (c *ClientWithResponses) UserListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListSessionsResponse, error) {
+	rsp, err := c.UserListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListSessionsResponse(rsp)
+}
+
+// UserRevokeSessionWithResponse request returning *UserRevokeSessionResponse
+func (c *ClientWithResponses) UserRevokeSessionWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserRevokeSessionResponse, error) {
+	rsp, err := c.UserRevokeSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRevokeSessionResponse(rsp)
+}
+
+// UserRequestPasswordResetWithBodyWithResponse request with arbitrary body returning *UserRequestPasswordResetResponse
+func (c *ClientWithResponses) UserRequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPasswordResetResponse, error) {
+	rsp, err := c.UserRequestPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserRequestPasswordResetWithResponse(ctx context.Context, body UserRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPasswordResetResponse, error) {
+	rsp, err := c.UserRequestPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestPasswordResetResponse(rsp)
+}
+
+// UserVerifyPasswordResetWithBodyWithResponse request with arbitrary body returning *UserVerifyPasswordResetResponse
+func (c *ClientWithResponses) UserVerifyPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserVerifyPasswordResetResponse, error) {
+	rsp, err := c.UserVerifyPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserVerifyPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserVerifyPasswordResetWithResponse(ctx context.Context, body UserVerifyPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*UserVerifyPasswordResetResponse, error) {
+	rsp, err := c.UserVerifyPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserVerifyPasswordResetResponse(rsp)
+}
+
+// UserResetPasswordWithBodyWithResponse request with arbitrary body returning *UserResetPasswordResponse
+func (c *ClientWithResponses) UserResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserResetPasswordResponse, error) {
+	rsp, err := c.UserResetPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserResetPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserResetPasswordWithResponse(ctx context.Context, body UserResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserResetPasswordResponse, error) {
+	rsp, err := c.UserResetPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserResetPasswordResponse(rsp)
+}
+
+// UserSendPhoneVerificationWithBodyWithResponse request with arbitrary body returning *UserSendPhoneVerificationResponse
+func (c *ClientWithResponses) UserSendPhoneVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserSendPhoneVerificationResponse, error) {
+	rsp, err := c.UserSendPhoneVerificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSendPhoneVerificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserSendPhoneVerificationWithResponse(ctx context.Context, body UserSendPhoneVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*UserSendPhoneVerificationResponse, error) {
+	rsp, err := c.UserSendPhoneVerification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSendPhoneVerificationResponse(rsp)
+}
+
+// UserVerifyPhoneNumberWithBodyWithResponse request with arbitrary body returning *UserVerifyPhoneNumberResponse
+func (c *ClientWithResponses) UserVerifyPhoneNumberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserVerifyPhoneNumberResponse, error) {
+	rsp, err := c.UserVerifyPhoneNumberWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserVerifyPhoneNumberResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserVerifyPhoneNumberWithResponse(ctx context.Context, body UserVerifyPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*UserVerifyPhoneNumberResponse, error) {
+	rsp, err := c.UserVerifyPhoneNumber(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserVerifyPhoneNumberResponse(rsp)
+}
+
+// UserCreateUserTokenWithBodyWithResponse request with arbitrary body returning *UserCreateUserTokenResponse
+func (c *ClientWithResponses) UserCreateUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error) {
+	rsp, err := c.UserCreateUserTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateUserTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateUserTokenWithResponse(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error) {
+	rsp, err := c.UserCreateUserToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateUserTokenResponse(rsp)
+}
+
+// UserCompleteMFALoginWithBodyWithResponse request with arbitrary body returning *UserCompleteMFALoginResponse
+func (c *ClientWithResponses) UserCompleteMFALoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCompleteMFALoginResponse, error) {
+	rsp, err := c.UserCompleteMFALoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCompleteMFALoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCompleteMFALoginWithResponse(ctx context.Context, body UserCompleteMFALoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCompleteMFALoginResponse, error) {
+	rsp, err := c.UserCompleteMFALogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCompleteMFALoginResponse(rsp)
+}
+
+// UserRefreshUserTokenWithBodyWithResponse request with arbitrary body returning *UserRefreshUserTokenResponse
+func (c *ClientWithResponses) UserRefreshUserTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRefreshUserTokenResponse, error) {
+	rsp, err := c.UserRefreshUserTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRefreshUserTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserRefreshUserTokenWithResponse(ctx context.Context, body UserRefreshUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRefreshUserTokenResponse, error) {
+	rsp, err := c.UserRefreshUserToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRefreshUserTokenResponse(rsp)
+}
+
+// ParseUserChangeUserRoleResponse parses an HTTP response from a UserChangeUserRoleWithResponse call
+func ParseUserChangeUserRoleResponse(rsp *http.Response) (*UserChangeUserRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserChangeUserRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ChangeUserRoleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInterestListInterestsResponse parses an HTTP response from a InterestListInterestsWithResponse call
+func ParseInterestListInterestsResponse(rsp *http.Response) (*InterestListInterestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InterestListInterestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListInterestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserCreateUserResponse parses an HTTP response from a UserCreateUserWithResponse call
+func ParseUserCreateUserResponse(rsp *http.Response) (*UserCreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserCreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1CreateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserLogoutResponse parses an HTTP response from a UserLogoutWithResponse call
+func ParseUserLogoutResponse(rsp *http.Response) (*UserLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1LogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserLogoutAllResponse parses an HTTP response from a UserLogoutAllWithResponse call
+func ParseUserLogoutAllResponse(rsp *http.Response) (*UserLogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserLogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1LogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserDeleteMeResponse parses an HTTP response from a UserDeleteMeWithResponse call
+func ParseUserDeleteMeResponse(rsp *http.Response) (*UserDeleteMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserDeleteMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1DeleteMeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserGetMeResponse parses an HTTP response from a UserGetMeWithResponse call
+func ParseUserGetMeResponse(rsp *http.Response) (*UserGetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1GetMeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserEnrollTOTPResponse parses an HTTP response from a UserEnrollTOTPWithResponse call
+func ParseUserEnrollTOTPResponse(rsp *http.Response) (*UserEnrollTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserEnrollTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1EnrollTOTPResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserConfirmTOTPResponse parses an HTTP response from a UserConfirmTOTPWithResponse call
+func ParseUserConfirmTOTPResponse(rsp *http.Response) (*UserConfirmTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserConfirmTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ConfirmTOTPResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserRequestDataExportResponse parses an HTTP response from a UserRequestDataExportWithResponse call
+func ParseUserRequestDataExportResponse(rsp *http.Response) (*UserRequestDataExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserRequestDataExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1RequestDataExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateMyIdentityResponse parses an HTTP response from a UserUpdateMyIdentityWithResponse call
+func ParseUserUpdateMyIdentityResponse(rsp *http.Response) (*UserUpdateMyIdentityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateMyIdentityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1GetMeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInterestListMyInterestsResponse parses an HTTP response from a InterestListMyInterestsWithResponse call
+func ParseInterestListMyInterestsResponse(rsp *http.Response) (*InterestListMyInterestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InterestListMyInterestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListInterestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInterestSetMyInterestsResponse parses an HTTP response from a InterestSetMyInterestsWithResponse call
+func ParseInterestSetMyInterestsResponse(rsp *http.Response) (*InterestSetMyInterestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InterestSetMyInterestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListInterestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLocationGetMyLocationResponse parses an HTTP response from a LocationGetMyLocationWithResponse call
+func ParseLocationGetMyLocationResponse(rsp *http.Response) (*LocationGetMyLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LocationGetMyLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1LocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLocationUpdateMyLocationResponse parses an HTTP response from a LocationUpdateMyLocationWithResponse call
+func ParseLocationUpdateMyLocationResponse(rsp *http.Response) (*LocationUpdateMyLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LocationUpdateMyLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1LocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserChangePasswordResponse parses an HTTP response from a UserChangePasswordWithResponse call
+func ParseUserChangePasswordResponse(rsp *http.Response) (*UserChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ChangePasswordResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePhotoListMyPhotosResponse parses an HTTP response from a PhotoListMyPhotosWithResponse call
+func ParsePhotoListMyPhotosResponse(rsp *http.Response) (*PhotoListMyPhotosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PhotoListMyPhotosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListPhotosResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePhotoReorderPhotosResponse parses an HTTP response from a PhotoReorderPhotosWithResponse call
+func ParsePhotoReorderPhotosResponse(rsp *http.Response) (*PhotoReorderPhotosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PhotoReorderPhotosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListPhotosResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePhotoDeletePhotoResponse parses an HTTP response from a PhotoDeletePhotoWithResponse call
+func ParsePhotoDeletePhotoResponse(rsp *http.Response) (*PhotoDeletePhotoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PhotoDeletePhotoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1DeletePhotoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreferencesGetMyPreferencesResponse parses an HTTP response from a PreferencesGetMyPreferencesWithResponse call
+func ParsePreferencesGetMyPreferencesResponse(rsp *http.Response) (*PreferencesGetMyPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreferencesGetMyPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreferencesUpdateMyPreferencesResponse parses an HTTP response from a PreferencesUpdateMyPreferencesWithResponse call
+func ParsePreferencesUpdateMyPreferencesResponse(rsp *http.Response) (*PreferencesUpdateMyPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreferencesUpdateMyPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProfileGetMyProfileResponse parses an HTTP response from a ProfileGetMyProfileWithResponse call
+func ParseProfileGetMyProfileResponse(rsp *http.Response) (*ProfileGetMyProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProfileGetMyProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProfileUpdateMyProfileResponse parses an HTTP response from a ProfileUpdateMyProfileWithResponse call
+func ParseProfileUpdateMyProfileResponse(rsp *http.Response) (*ProfileUpdateMyProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProfileUpdateMyProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserListSessionsResponse parses an HTTP response from a UserListSessionsWithResponse call
+func ParseUserListSessionsResponse(rsp *http.Response) (*UserListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ListSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserRevokeSessionResponse parses an HTTP response from a UserRevokeSessionWithResponse call
+func ParseUserRevokeSessionResponse(rsp *http.Response) (*UserRevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserRevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1RevokeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserRequestPasswordResetResponse parses an HTTP response from a UserRequestPasswordResetWithResponse call
+func ParseUserRequestPasswordResetResponse(rsp *http.Response) (*UserRequestPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserRequestPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1RequestPasswordResetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserVerifyPasswordResetResponse parses an HTTP response from a UserVerifyPasswordResetWithResponse call
+func ParseUserVerifyPasswordResetResponse(rsp *http.Response) (*UserVerifyPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserVerifyPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1VerifyPasswordResetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserResetPasswordResponse parses an HTTP response from a UserResetPasswordWithResponse call
+func ParseUserResetPasswordResponse(rsp *http.Response) (*UserResetPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserResetPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1ResetPasswordResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserSendPhoneVerificationResponse parses an HTTP response from a UserSendPhoneVerificationWithResponse call
+func ParseUserSendPhoneVerificationResponse(rsp *http.Response) (*UserSendPhoneVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSendPhoneVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1SendPhoneVerificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserVerifyPhoneNumberResponse parses an HTTP response from a UserVerifyPhoneNumberWithResponse call
+func ParseUserVerifyPhoneNumberResponse(rsp *http.Response) (*UserVerifyPhoneNumberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserVerifyPhoneNumberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1VerifyPhoneNumberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserCreateUserTokenResponse parses an HTTP response from a UserCreateUserTokenWithResponse call
+func ParseUserCreateUserTokenResponse(rsp *http.Response) (*UserCreateUserTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateUserTokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserCreateUserTokenWithResponse(ctx context.Context, body UserCreateUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateUserTokenResponse, error) {
-	rsp, err := c.UserCreateUserToken(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UserCreateUserTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserCreateUserTokenResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiV1CreateUserTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseUserCreateUserResponse parses an HTTP response from a UserCreateUserWithResponse call
-func ParseUserCreateUserResponse(rsp *http.Response) (*UserCreateUserResponse, error) {
+// ParseUserCompleteMFALoginResponse parses an HTTP response from a UserCompleteMFALoginWithResponse call
+func ParseUserCompleteMFALoginResponse(rsp *http.Response) (*UserCompleteMFALoginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCreateUserResponse{
+	response := &UserCompleteMFALoginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiV1CreateUserResponse
+		var dest ApiV1CreateUserTokenResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -423,15 +4838,15 @@ func ParseUserCreateUserResponse(rsp *http.Response) (*UserCreateUserResponse, e
 	return response, nil
 }
 
-// ParseUserCreateUserTokenResponse parses an HTTP response from a UserCreateUserTokenWithResponse call
-func ParseUserCreateUserTokenResponse(rsp *http.Response) (*UserCreateUserTokenResponse, error) {
+// ParseUserRefreshUserTokenResponse parses an HTTP response from a UserRefreshUserTokenWithResponse call
+func ParseUserRefreshUserTokenResponse(rsp *http.Response) (*UserRefreshUserTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCreateUserTokenResponse{
+	response := &UserRefreshUserTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -452,11 +4867,104 @@ func ParseUserCreateUserTokenResponse(rsp *http.Response) (*UserCreateUserTokenR
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (PUT /api/v1/admin/users/{id}/role)
+	UserChangeUserRole(ctx echo.Context, id string) error
+
+	// (GET /api/v1/interests)
+	InterestListInterests(ctx echo.Context) error
+
 	// (POST /api/v1/users)
 	UserCreateUser(ctx echo.Context) error
 
+	// (POST /api/v1/users/logout)
+	UserLogout(ctx echo.Context) error
+
+	// (POST /api/v1/users/logout/all)
+	UserLogoutAll(ctx echo.Context) error
+
+	// (DELETE /api/v1/users/me)
+	UserDeleteMe(ctx echo.Context) error
+
+	// (GET /api/v1/users/me)
+	UserGetMe(ctx echo.Context) error
+
+	// (POST /api/v1/users/me/2fa/totp)
+	UserEnrollTOTP(ctx echo.Context) error
+
+	// (POST /api/v1/users/me/2fa/totp/confirm)
+	UserConfirmTOTP(ctx echo.Context) error
+
+	// (POST /api/v1/users/me/exports)
+	UserRequestDataExport(ctx echo.Context) error
+
+	// (PATCH /api/v1/users/me/identity)
+	UserUpdateMyIdentity(ctx echo.Context) error
+
+	// (GET /api/v1/users/me/interests)
+	InterestListMyInterests(ctx echo.Context) error
+
+	// (PUT /api/v1/users/me/interests)
+	InterestSetMyInterests(ctx echo.Context) error
+
+	// (GET /api/v1/users/me/location)
+	LocationGetMyLocation(ctx echo.Context) error
+
+	// (PUT /api/v1/users/me/location)
+	LocationUpdateMyLocation(ctx echo.Context) error
+
+	// (POST /api/v1/users/me/password)
+	UserChangePassword(ctx echo.Context) error
+
+	// (GET /api/v1/users/me/photos)
+	PhotoListMyPhotos(ctx echo.Context) error
+
+	// (PUT /api/v1/users/me/photos/order)
+	PhotoReorderPhotos(ctx echo.Context) error
+
+	// (DELETE /api/v1/users/me/photos/{id})
+	PhotoDeletePhoto(ctx echo.Context, id string) error
+
+	// (GET /api/v1/users/me/preferences)
+	PreferencesGetMyPreferences(ctx echo.Context) error
+
+	// (PATCH /api/v1/users/me/preferences)
+	PreferencesUpdateMyPreferences(ctx echo.Context) error
+
+	// (GET /api/v1/users/me/profile)
+	ProfileGetMyProfile(ctx echo.Context) error
+
+	// (PATCH /api/v1/users/me/profile)
+	ProfileUpdateMyProfile(ctx echo.Context) error
+
+	// (GET /api/v1/users/me/sessions)
+	UserListSessions(ctx echo.Context) error
+
+	// (DELETE /api/v1/users/me/sessions/{id})
+	UserRevokeSession(ctx echo.Context, id string) error
+
+	// (POST /api/v1/users/password/forgot)
+	UserRequestPasswordReset(ctx echo.Context) error
+
+	// (POST /api/v1/users/password/forgot/verify)
+	UserVerifyPasswordReset(ctx echo.Context) error
+
+	// (POST /api/v1/users/password/reset)
+	UserResetPassword(ctx echo.Context) error
+
+	// (POST /api/v1/users/phone/otp)
+	UserSendPhoneVerification(ctx echo.Context) error
+
+	// (POST /api/v1/users/phone/verify)
+	UserVerifyPhoneNumber(ctx echo.Context) error
+
 	// (POST /api/v1/users/token)
 	UserCreateUserToken(ctx echo.Context) error
+
+	// (POST /api/v1/users/token/mfa)
+	UserCompleteMFALogin(ctx echo.Context) error
+
+	// (POST /api/v1/users/token/refresh)
+	UserRefreshUserToken(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -464,6 +4972,31 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// UserChangeUserRole converts echo context to params.
+func (w *ServerInterfaceWrapper) UserChangeUserRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserChangeUserRole(ctx, id)
+	return err
+}
+
+// InterestListInterests converts echo context to params.
+func (w *ServerInterfaceWrapper) InterestListInterests(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InterestListInterests(ctx)
+	return err
+}
+
 // UserCreateUser converts echo context to params.
 func (w *ServerInterfaceWrapper) UserCreateUser(ctx echo.Context) error {
 	var err error
@@ -473,6 +5006,263 @@ func (w *ServerInterfaceWrapper) UserCreateUser(ctx echo.Context) error {
 	return err
 }
 
+// UserLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) UserLogout(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserLogout(ctx)
+	return err
+}
+
+// UserLogoutAll converts echo context to params.
+func (w *ServerInterfaceWrapper) UserLogoutAll(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserLogoutAll(ctx)
+	return err
+}
+
+// UserDeleteMe converts echo context to params.
+func (w *ServerInterfaceWrapper) UserDeleteMe(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserDeleteMe(ctx)
+	return err
+}
+
+// UserGetMe converts echo context to params.
+func (w *ServerInterfaceWrapper) UserGetMe(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserGetMe(ctx)
+	return err
+}
+
+// UserEnrollTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) UserEnrollTOTP(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserEnrollTOTP(ctx)
+	return err
+}
+
+// UserConfirmTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) UserConfirmTOTP(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserConfirmTOTP(ctx)
+	return err
+}
+
+// UserRequestDataExport converts echo context to params.
+func (w *ServerInterfaceWrapper) UserRequestDataExport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserRequestDataExport(ctx)
+	return err
+}
+
+// UserUpdateMyIdentity converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateMyIdentity(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateMyIdentity(ctx)
+	return err
+}
+
+// InterestListMyInterests converts echo context to params.
+func (w *ServerInterfaceWrapper) InterestListMyInterests(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InterestListMyInterests(ctx)
+	return err
+}
+
+// InterestSetMyInterests converts echo context to params.
+func (w *ServerInterfaceWrapper) InterestSetMyInterests(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InterestSetMyInterests(ctx)
+	return err
+}
+
+// LocationGetMyLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) LocationGetMyLocation(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocationGetMyLocation(ctx)
+	return err
+}
+
+// LocationUpdateMyLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) LocationUpdateMyLocation(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocationUpdateMyLocation(ctx)
+	return err
+}
+
+// UserChangePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) UserChangePassword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserChangePassword(ctx)
+	return err
+}
+
+// PhotoListMyPhotos converts echo context to params.
+func (w *ServerInterfaceWrapper) PhotoListMyPhotos(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PhotoListMyPhotos(ctx)
+	return err
+}
+
+// PhotoReorderPhotos converts echo context to params.
+func (w *ServerInterfaceWrapper) PhotoReorderPhotos(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PhotoReorderPhotos(ctx)
+	return err
+}
+
+// PhotoDeletePhoto converts echo context to params.
+func (w *ServerInterfaceWrapper) PhotoDeletePhoto(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PhotoDeletePhoto(ctx, id)
+	return err
+}
+
+// PreferencesGetMyPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PreferencesGetMyPreferences(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreferencesGetMyPreferences(ctx)
+	return err
+}
+
+// PreferencesUpdateMyPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PreferencesUpdateMyPreferences(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreferencesUpdateMyPreferences(ctx)
+	return err
+}
+
+// ProfileGetMyProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) ProfileGetMyProfile(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProfileGetMyProfile(ctx)
+	return err
+}
+
+// ProfileUpdateMyProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) ProfileUpdateMyProfile(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProfileUpdateMyProfile(ctx)
+	return err
+}
+
+// UserListSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) UserListSessions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserListSessions(ctx)
+	return err
+}
+
+// UserRevokeSession converts echo context to params.
+func (w *ServerInterfaceWrapper) UserRevokeSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserRevokeSession(ctx, id)
+	return err
+}
+
+// UserRequestPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) UserRequestPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserRequestPasswordReset(ctx)
+	return err
+}
+
+// UserVerifyPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) UserVerifyPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserVerifyPasswordReset(ctx)
+	return err
+}
+
+// UserResetPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) UserResetPassword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserResetPassword(ctx)
+	return err
+}
+
+// UserSendPhoneVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) UserSendPhoneVerification(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserSendPhoneVerification(ctx)
+	return err
+}
+
+// UserVerifyPhoneNumber converts echo context to params.
+func (w *ServerInterfaceWrapper) UserVerifyPhoneNumber(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserVerifyPhoneNumber(ctx)
+	return err
+}
+
 // UserCreateUserToken converts echo context to params.
 func (w *ServerInterfaceWrapper) UserCreateUserToken(ctx echo.Context) error {
 	var err error
@@ -482,6 +5272,24 @@ func (w *ServerInterfaceWrapper) UserCreateUserToken(ctx echo.Context) error {
 	return err
 }
 
+// UserCompleteMFALogin converts echo context to params.
+func (w *ServerInterfaceWrapper) UserCompleteMFALogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserCompleteMFALogin(ctx)
+	return err
+}
+
+// UserRefreshUserToken converts echo context to params.
+func (w *ServerInterfaceWrapper) UserRefreshUserToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserRefreshUserToken(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -510,21 +5318,106 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.PUT(baseURL+"/api/v1/admin/users/:id/role", wrapper.UserChangeUserRole)
+	router.GET(baseURL+"/api/v1/interests", wrapper.InterestListInterests)
 	router.POST(baseURL+"/api/v1/users", wrapper.UserCreateUser)
+	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserLogout)
+	router.POST(baseURL+"/api/v1/users/logout/all", wrapper.UserLogoutAll)
+	router.DELETE(baseURL+"/api/v1/users/me", wrapper.UserDeleteMe)
+	router.GET(baseURL+"/api/v1/users/me", wrapper.UserGetMe)
+	router.POST(baseURL+"/api/v1/users/me/2fa/totp", wrapper.UserEnrollTOTP)
+	router.POST(baseURL+"/api/v1/users/me/2fa/totp/confirm", wrapper.UserConfirmTOTP)
+	router.POST(baseURL+"/api/v1/users/me/exports", wrapper.UserRequestDataExport)
+	router.PATCH(baseURL+"/api/v1/users/me/identity", wrapper.UserUpdateMyIdentity)
+	router.GET(baseURL+"/api/v1/users/me/interests", wrapper.InterestListMyInterests)
+	router.PUT(baseURL+"/api/v1/users/me/interests", wrapper.InterestSetMyInterests)
+	router.GET(baseURL+"/api/v1/users/me/location", wrapper.LocationGetMyLocation)
+	router.PUT(baseURL+"/api/v1/users/me/location", wrapper.LocationUpdateMyLocation)
+	router.POST(baseURL+"/api/v1/users/me/password", wrapper.UserChangePassword)
+	router.GET(baseURL+"/api/v1/users/me/photos", wrapper.PhotoListMyPhotos)
+	router.PUT(baseURL+"/api/v1/users/me/photos/order", wrapper.PhotoReorderPhotos)
+	router.DELETE(baseURL+"/api/v1/users/me/photos/:id", wrapper.PhotoDeletePhoto)
+	router.GET(baseURL+"/api/v1/users/me/preferences", wrapper.PreferencesGetMyPreferences)
+	router.PATCH(baseURL+"/api/v1/users/me/preferences", wrapper.PreferencesUpdateMyPreferences)
+	router.GET(baseURL+"/api/v1/users/me/profile", wrapper.ProfileGetMyProfile)
+	router.PATCH(baseURL+"/api/v1/users/me/profile", wrapper.ProfileUpdateMyProfile)
+	router.GET(baseURL+"/api/v1/users/me/sessions", wrapper.UserListSessions)
+	router.DELETE(baseURL+"/api/v1/users/me/sessions/:id", wrapper.UserRevokeSession)
+	router.POST(baseURL+"/api/v1/users/password/forgot", wrapper.UserRequestPasswordReset)
+	router.POST(baseURL+"/api/v1/users/password/forgot/verify", wrapper.UserVerifyPasswordReset)
+	router.POST(baseURL+"/api/v1/users/password/reset", wrapper.UserResetPassword)
+	router.POST(baseURL+"/api/v1/users/phone/otp", wrapper.UserSendPhoneVerification)
+	router.POST(baseURL+"/api/v1/users/phone/verify", wrapper.UserVerifyPhoneNumber)
 	router.POST(baseURL+"/api/v1/users/token", wrapper.UserCreateUserToken)
+	router.POST(baseURL+"/api/v1/users/token/mfa", wrapper.UserCompleteMFALogin)
+	router.POST(baseURL+"/api/v1/users/token/refresh", wrapper.UserRefreshUserToken)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8RUwY7TQAz9FWQ4Vkm6veUGnCokQAhOaA+zidt62diDxy2sqv478kxoVdGgCgR7c233",
-	"zXvPdvbQyRCFkS1Bu4fUbXAIOQyRqt28eq0YDD8l1A/4dYvJvBZVIqoR5s41co/qkT1GhBaSKfEaDjPg",
-	"MODFQgwpfRPtLxc3wvh2O9xNoG4T6gTyYfYzI3f32Jm3X1CSonDCX6VQ/6eYH+UL8qRFv5X7t3LGp6c0",
-	"4fdIimnJ/mMlOgSDFohtcQNHeGLDNarjm8NdJFoSV5D0FPFKcjPZg9ec6bOX75cwgx1qImFooamaau7Q",
-	"EpFDJGhhUTXVAnxDbJP51yFSvZvXblMxU4rFLjIYCS/7Ef/kCcxAyzBeSf/o3Z2wIVtZ7fhAXf5rfZ+E",
-	"T5vv0QvFFbTwvD6dRj3eRT15FFmyv0iKPbSmW8yJMpRM+6Zp/iWPcfyZSI+pU4pWTH73psworBO0n7NR",
-	"cOuZM2fr49yv8Tfv3P8y+ey2ntrp82u73u5Taj9+FkvpcHv4EQAA//8vRn06hgUAAA==",
+	"H4sIAAAAAAAC/+xcW3PbOLL+Kyie83YYy05yZjN+U2Ilo51E9viys6lxygWRLRJjEOACoBVVyv99CwAp",
+	"XgRSlGVJ5d15SWQQBBrd6MbXF/CHF/Ak5QyYkt7pD08GMSTY/MQpOXo4OfoQYxbBBZZyzkV4Cf/KQCr9",
+	"PBU8BaEImN5BJgQwVfTTTWqRgnfqSSUIi7xH32Mw73j+6BctfPonBEq/0UKDTDmTUBmj5Y0bCeKSU2il",
+	"mrgJFZya0UOQgSCpIpx5p945A8RnKJMgfJTwEARWXCAuEA4Two48f+MllQT2WBJPUgoKvnwcfuYRYe2i",
+	"iDGlwCIYu1cX8NCxuuvz6wukH+kFCQj4A4iFaXAszPdCeCABTHACG4qSsxkRiZ6tfQE5gU8ctWRlfdhi",
+	"UR94CHKVAVcxnzPEGV0gzgLwEeAgthwJMENT0JIPEUaSsIgCUiQBRJhUgEO9LzBaslBzjChIpJP/eQMW",
+	"Ai+6VyUAK7tH2lgVAQtBrC7mDFIBAVYQnmqyke13R0JgiqiFjxJMAWEWohnYnwKQVIRShIMAUqVXKlGC",
+	"mek05wlmzn1gBx7n4zq2Fb4HiTQxEAILAGkR5OToAYFliXf6h/dpNDkbXd7dTK4uRh/GH8ejM88vGn8/",
+	"/zKclH/W/picT+7ejyfDy69lm/3vt5vR6LLZ+PHzzbgy8tD+XzZc/35+d3Uxvhxfe998b8ZFgpV3aql0",
+	"rJ6597/vcUGAKWyZ8GO5yvPL8WhyPbwen08aS60+ubq+HI4//XLdaP40/Npo+Ty6ej8eThqt78dXo3/e",
+	"DD83mi+GE2f70NlacK/RdqV/jCefenEn7ToM0pgzmGTJFITzuYz5/FPL7v49BhWDQNz8qw2yNDoqAZCK",
+	"i93uIyUyQPMYGGJcIQlMVfbwlHMKmOm59Ahsc1NWUc82m0PCp455ze+h3cbXza/LkBFmWCFBSsIZokQq",
+	"H8FRdIRuvQvyHSh6d+s5VdoaiRlxcf4m55Q+JIwEETMi1NONjk5+eovsrsin+r+fXr87ef3m7f//9Ld3",
+	"Px8fbbxJqoLpNnAl0Ruew02Gt0kSvqdEgBwbfV5ufcLUm9flhIQpiEDo8ZMZ/lAcxKMt3h2Hq4u/AlU9",
+	"ebSclaZe2s2u5vzVDAcanOBMxZoxgTFFiEgEDE8phP4tQ0GOKMwAVGMKNCcqRk2k4ZSbgJkAGW+6tPw1",
+	"w2z36dj+xDRsok1nYJYB7VJNMxHBUNWID7GCV/p09/yN57qIueJ90NyICU5pAwb16dy2EgmBAOXWIkFW",
+	"9xBXqd4cp4MBurkcI8U1whHGcNqzH6PfLtvwXwcfPoHqYnhg9C3sz3J/G4xTQS8VhCOBKYtuahioJ755",
+	"2YilxeP5C8h0Apk1WMU8/wcIff5U+VuBGM/lUjaB0epMas4/GuM/spbe3StLw0318GkoacwUCJCqwyRg",
+	"BREXCydrN92vkmbRZgR+JlIVRMoOJFd0MX8Uzt3/Cph5p97/DMowyiCPoQzaGLCJC6iJM2dKB2Wpeb4p",
+	"WfWTalOariyslF3nke2xKV35yJtRxC2+aaeGYkVUFkJ9t/NsSitb3UJZPS7lLNqk/8ba1LmYiGdqSGkP",
+	"YGD7tnoJa8DWWiL6AJkVxLP1ed+i8hSLCG4EdT5MICRZ0vY05ZIUJ1gPjJoKkmCxcBtOFWfJlGFC3ZN1",
+	"8PRCwAwEsAB6mBkIx6ymOi8bdtRV2fcS/H0YQV93CH8/I1JhFsCvSd93COs/wXOq74XgM0I7EDDuTdaU",
+	"cOd2nhKhYk2dAxNjpYOS6OvXr19fffny6uwsd8idUALCLFhCu5WnMZAoVh/6cvxPPnV7H8/I3Etr0NbH",
+	"R55u+S6BixBEcei2jG/O3HFYP92eHunN5znDCo++p1yoHrbf8U7blgv5nFGOwzb7mMc2tjbS61dYyeGA",
+	"6kjL7C8CtTHN649E03Ftxqwz8KVIcO/05h/9vtP2ofOB30MOuPq8cAUsvCh9nQJ0tetIh9f06G86TR/6",
+	"zFKeBYXkCU1HRkMHlWdc1MKr1SiczRMVPYRljzvw3JlFa0VCJB2GoQApW3CShufANnXthlG+3E0Epb4s",
+	"Kr5TmzrnPXJ7WWfnOJQF9wKsMOUREqAywSBE0wWqeWfPlFe7MSfSl0UR1emRXXvZ6KsRxGnEINyhHCQg",
+	"4Q8gjWQqA1STdv9dUaDuwEuP/Va6qS37reqlNmOdkQCQaApqDsDQq5+PTeTSHmmberRrxj55Zwc/eddv",
+	"9B5Lr/k+a8xE4frUqRyyRWEnrFouUxllqkvo3HKgawGiTCx3618u1LYuVEMSClHAUqGTd2abYIUSLhVK",
+	"8Pc7HEFty7SN3mvL5I5Uy3Z5Tu/Iz5PISSaVzoPgyhIXgIVEnIZbulB1Un4xTzQxgT6EElAgpI+OUUDN",
+	"fET14mOb29XBXwOqFv2AeEslTjNHvOXkz5VyfQpozgkqoermvHgyznXM3Ypx9TiEzeyWJ4rqZ57vPYCw",
+	"iNc7Pjo+OtGD8xQYTol36r05Oj564/leilVslqBjrIOHk4FJLgzMlh/8IOHjoMhOpJkD8NZL1XQO2RRJ",
+	"YUr5HEKdOTTjSd9YYQZzpIdDOE0pAWmKqcwTPR/KHXOLK4iwiFlvdc1qo0jjMPfy6vOadQhsFcU7/UOf",
+	"F96pWZtX5AM8Enq+pxE3ERB6p0pk4Oc1jS7RfLOdQar3PFxYITOVw2BDv9XtwZ/SKng5VI9ItrsG0Uiy",
+	"TqJpsJI3Ynp9fLxrWoqw/+Oj35D2+a92z+JIc9kIwvumW4rNU0uERODYMDXMnqN5iyPnMaclzjeBFgvz",
+	"ixyQOVC0MFe3RDFibXRv96xz54fWc654p849o3RG1bh06dqyHKUoOhSgBIEQSTwDurAFGpihcQhJyhWw",
+	"YPHqV1igGLApecLmhbyfQUU6VnIPlrNTHi5uGYpAWXnMiJAKFRxEUxzct+jiki5vtzqzUvJ4IH1Zre16",
+	"iq5YE0tNMqUq9FUG24TLbplbzxYdhrGNxNK2TB1gSvswdkjpPnhbydq9cPYWNXcUnEA6L6xCMQnzEAEO",
+	"Ap4xhYTBtXiOrcUxBVYazpY4IBI4AJSCIDxEwEKpa9EojyLCIg2IpzDjwnRl2jQpLqDAw6viLSjZw0Gw",
+	"Uk3Wm7t+cU6u0m/KpfZAfL0sa5t9MXg9wwPFVdqtd2XN2m4Vb7WQ7jCa56jRexYuDwJ7t6Gb25ULEDs+",
+	"oFfvbxzohHZc+diK4WCSaB3YbCXhhrAIYqJjpKDvlahYGzBjr0KEpzxTS7fHukZ5d+1BFRk5CG8Zmgme",
+	"LFvuMkEb6G0KWIAokgtMEYpy1/gOt5jFFVp3uyta85eH2RvtqdGtdgipVIGmWAWxIzvZSC1YVzkw3lcB",
+	"uYGG+idWtiQVmLLbIy1jpOYJhZkpU1WxRu8C3JJuzrhbQbelTg4j52c81Pp5tZVsV82vXb6NUh2DMi6t",
+	"bq+U30NoLEG3X1sZ/2V4tr47ZFRPDCIBKcVBYSeX3Cri+atc8rWLC0mqFubiSjUXlejAUzsXr2CFiTtT",
+	"Bnf680DA//mjFFotKC+j206t0Cq4TGzVdELnoVHxPpIxFl164aO3x2/zw40zc0baV1ZFXcxWm3of6tKs",
+	"Ml3P3SV1rZrSzA2WumJ4WLSakgLFkc5BIgZYTBcoBZ5SaOdPc+j9nAzNJOeh/OBtZOXShGq5TgcOr91U",
+	"9/YQX27WGR0yvrxSfLTVkVzWtXecx7ZOr2Z47Ht5VpgIG2b2K/HO3L6YvrbU176zqkpm9OpEezqVGyX/",
+	"69loXujk48CwoTXJUyt7RArfL09r8zoiyzS7TvA4WTqFgCcgC562cLM20a69Ekcp5+GO510IVWfv1gfq",
+	"zECoLOeZcZ27095qritZWiTvvjtlmd8rbSqM8WTNDGGLtCvz7yyBt4+oX+O2zHaiK/28blhVKZpZGrh5",
+	"zFsQFJpjpqQBCQB+rrzmQYxlzbk08QZJIpaliDuSr5V5m3Tswf65rkn0YHj5lgVb3f55lbU9XfSlR1LW",
+	"Kd0Rhu4BUvtaXjFalCd18tVBx37gmaMQ6zAW8TnE7NYuUza0TrNMpzpsyNv4rIeLQpS2fTNCbf2ZS9Zm",
+	"tOpse9Gd+uWTPgy1tPXSmZxB/fRlWYelOVVsL22ebDF2leet7GtMvS8VqRWeHUo9ni5Jl1pUr2O2JqOq",
+	"Nzv3hHRXLpJu5TIUq3TgIleMvHL94YWiE/cVjqfzsPByBzMuIr6mZsF1P2YveQZn8eJBUw3uisZnk8Pg",
+	"wZQKdovDUVu5W2l0VJIeRhhd1aXPIAthWLpGJSp3sHatC45bZodSAtfNsy04HnMGg7UlBs57YrsO+Xfc",
+	"gDsM87tvy20rhA0MT6UMeh9mZ7Vk+6BGx1HF/XTeLz+C1RFprn+4bF9lmbVr14euzax/sm1Ldg+SGV7D",
+	"8sZ30XbM85bvvf5nMT2/D7DuVK1f+9/1wer+yMBLZXzZtHL3tkjEU1AyvwKlCxgq9QwmVoh1bMvEQYrS",
+	"/SIVyYskS8WTz52lYnDv0f9RtC1zbI9+k5Y8Ro0ZLsIK+ZBFjLo1LnOEblJdOaXj2brkVIJ40L91b5Lg",
+	"SAcoKMhbk0VFgmfKxFMjIlVx/SA275G8QtVU25hPhv796nzim8+K6h4UhNnClqCjiJ/eMoTQxfnVNWoJ",
+	"0JeVXJaSPIeSZFSRFAtlYyfo1n5Q4tbzlyEpjGoxZzPTp1E5USUBMPjxgAXBTD3a6fK/0PJTNT6y38fR",
+	"30wwn9EppWQmqYqoGmFzSCmXSZ4+C7EyWYS8VZovjype/TCrn8fKJGj/WoHdUdWKYT5DN3llzJIGM16V",
+	"LN3De/z2+O8BAB827NKxXAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,48 @@
+package fake
+
+import (
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+	"errors"
+)
+
+var (
+	_ driver.InterestUsecase = new(FakeInterestUsecase)
+)
+
+type FakeInterestUsecase struct{}
+
+// ListInterests implements driver.InterestUsecase.
+func (*FakeInterestUsecase) ListInterests(ctx context.Context) ([]*response.Interest, error) {
+	if val := ctx.Value(ContextType("list_interests_error")); val != nil {
+		return nil, errors.New("cannot list interests")
+	}
+	return []*response.Interest{
+		{ID: 12, Slug: "coffee", Name: "Coffee", Category: "Food & Drink"},
+		{ID: 1, Slug: "hiking", Name: "Hiking", Category: "Outdoors"},
+	}, nil
+}
+
+// ListMyInterests implements driver.InterestUsecase.
+func (*FakeInterestUsecase) ListMyInterests(ctx context.Context) ([]*response.Interest, error) {
+	if val := ctx.Value(ContextType("list_my_interests_error")); val != nil {
+		return nil, errors.New("cannot list interests")
+	}
+	return []*response.Interest{
+		{ID: 1, Slug: "hiking", Name: "Hiking", Category: "Outdoors"},
+	}, nil
+}
+
+// SetMyInterests implements driver.InterestUsecase.
+func (*FakeInterestUsecase) SetMyInterests(ctx context.Context, params *request.SetInterests) ([]*response.Interest, error) {
+	interests := make([]*response.Interest, 0, len(params.InterestIDs))
+	for _, id := range params.InterestIDs {
+		if id == 123 {
+			return nil, errors.New("cannot set interests")
+		}
+		interests = append(interests, &response.Interest{ID: id})
+	}
+	return interests, nil
+}
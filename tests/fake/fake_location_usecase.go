@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driver"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	_ driver.LocationUsecase = new(FakeLocationUsecase)
+)
+
+type FakeLocationUsecase struct{}
+
+// GetMyLocation implements driver.LocationUsecase.
+func (*FakeLocationUsecase) GetMyLocation(ctx context.Context) (*response.Location, error) {
+	if val := ctx.Value(ContextType("get_location_error")); val != nil {
+		return nil, errors.New("cannot get location")
+	}
+	return &response.Location{Latitude: -6.2, Longitude: 106.8, Geohash: "qqguxv2jd", UpdatedAt: time.Now()}, nil
+}
+
+// UpdateMyLocation implements driver.LocationUsecase.
+func (*FakeLocationUsecase) UpdateMyLocation(ctx context.Context, params *request.UpdateLocation) (*response.Location, error) {
+	if params.Latitude > 90 {
+		return nil, errors.New("cannot update location")
+	}
+	return &response.Location{Latitude: params.Latitude, Longitude: params.Longitude, UpdatedAt: time.Now()}, nil
+}
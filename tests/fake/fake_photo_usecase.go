@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	_ driver.PhotoUsecase = new(FakePhotoUsecase)
+)
+
+type FakePhotoUsecase struct{}
+
+// UploadPhoto implements driver.PhotoUsecase.
+func (*FakePhotoUsecase) UploadPhoto(ctx context.Context, params *request.UploadPhoto) (*response.Photo, error) {
+	if string(params.Content) == "test123" {
+		return nil, errors.New("cannot upload photo")
+	}
+	return &response.Photo{ID: "photo-1", Position: 0, Primary: true, CreatedAt: time.Now()}, nil
+}
+
+// ListMyPhotos implements driver.PhotoUsecase.
+func (*FakePhotoUsecase) ListMyPhotos(ctx context.Context) ([]*response.Photo, error) {
+	if val := ctx.Value(ContextType("list_photos_error")); val != nil {
+		return nil, errors.New("cannot list photos")
+	}
+	return []*response.Photo{
+		{ID: "photo-1", Position: 0, Primary: true, CreatedAt: time.Now()},
+		{ID: "photo-2", Position: 1, CreatedAt: time.Now()},
+	}, nil
+}
+
+// DeletePhoto implements driver.PhotoUsecase.
+func (*FakePhotoUsecase) DeletePhoto(ctx context.Context, params *request.DeletePhoto) error {
+	if params.PhotoID == "test123" {
+		return errors.New("cannot delete photo")
+	}
+	return nil
+}
+
+// ReorderPhotos implements driver.PhotoUsecase.
+func (*FakePhotoUsecase) ReorderPhotos(ctx context.Context, params *request.ReorderPhotos) ([]*response.Photo, error) {
+	photos := make([]*response.Photo, 0, len(params.PhotoIDs))
+	for position, id := range params.PhotoIDs {
+		if id == "test123" {
+			return nil, errors.New("cannot reorder photos")
+		}
+		photos = append(photos, &response.Photo{ID: id, Position: position, Primary: position == 0, CreatedAt: time.Now()})
+	}
+	return photos, nil
+}
+
+// OpenPhoto implements driver.PhotoUsecase.
+func (*FakePhotoUsecase) OpenPhoto(ctx context.Context, params *request.OpenPhoto) (*response.PhotoFile, error) {
+	if params.PhotoID == "test123" {
+		return nil, errors.New("cannot open photo")
+	}
+	return &response.PhotoFile{
+		ContentType: "image/jpeg",
+		Content:     io.NopCloser(strings.NewReader("image")),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"app/internal/user/param/request"
+	"app/internal/user/param/response"
+	"app/internal/user/port/driver"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	_ driver.PreferencesUsecase = new(FakePreferencesUsecase)
+)
+
+type FakePreferencesUsecase struct{}
+
+// GetMyPreferences implements driver.PreferencesUsecase.
+func (*FakePreferencesUsecase) GetMyPreferences(ctx context.Context) (*response.Preferences, error) {
+	if val := ctx.Value(ContextType("get_preferences_error")); val != nil {
+		return nil, errors.New("cannot get preferences")
+	}
+	return &response.Preferences{
+		InterestedIn:  []string{"woman"},
+		MinAge:        18,
+		MaxAge:        28,
+		MaxDistanceKM: 50,
+		UpdatedAt:     time.Now(),
+	}, nil
+}
+
+// UpdateMyPreferences implements driver.PreferencesUsecase.
+func (f *FakePreferencesUsecase) UpdateMyPreferences(ctx context.Context, params *request.UpdatePreferences) (*response.Preferences, error) {
+	if params.MinAge != nil && *params.MinAge == 17 {
+		return nil, errors.New("cannot update preferences")
+	}
+	preferences, _ := f.GetMyPreferences(ctx)
+	if params.InterestedIn != nil {
+		preferences.InterestedIn = params.InterestedIn
+	}
+	if params.MaxDistanceKM != nil {
+		preferences.MaxDistanceKM = *params.MaxDistanceKM
+	}
+	return preferences, nil
+}
@@ -0,0 +1,43 @@
+package fake
+
+import (
+	"app/internal/profile/param/request"
+	"app/internal/profile/param/response"
+	"app/internal/profile/port/driver"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	_ driver.ProfileUsecase = new(FakeProfileUsecase)
+)
+
+type FakeProfileUsecase struct{}
+
+// GetMyProfile implements driver.ProfileUsecase.
+func (*FakeProfileUsecase) GetMyProfile(ctx context.Context) (*response.Profile, error) {
+	if val := ctx.Value(ContextType("get_profile_error")); val != nil {
+		return nil, errors.New("cannot get profile")
+	}
+	return &response.Profile{
+		UserID:    1,
+		Birthdate: time.Date(1995, time.March, 4, 0, 0, 0, 0, time.UTC),
+		Age:       29,
+		Bio:       "hello",
+		HeightCM:  170,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// UpdateMyProfile implements driver.ProfileUsecase.
+func (f *FakeProfileUsecase) UpdateMyProfile(ctx context.Context, params *request.UpdateProfile) (*response.Profile, error) {
+	if params.Bio != nil && *params.Bio == "test123" {
+		return nil, errors.New("cannot update profile")
+	}
+	profile, _ := f.GetMyProfile(ctx)
+	if params.HeightCM != nil {
+		profile.HeightCM = *params.HeightCM
+	}
+	return profile, nil
+}
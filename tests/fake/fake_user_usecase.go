@@ -6,15 +6,22 @@ import (
 	"app/internal/user/port/driver"
 	"context"
 	"errors"
+	"io"
 	"math/rand"
+	"strings"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 )
 
 var (
 	_ driver.UserWriterUsecase = new(FakeUserUsecase)
+	_ driver.UserReaderUsecase = new(FakeUserReaderUsecase)
+	_ driver.DataExportUsecase = new(FakeDataExportUsecase)
 )
 
+type ContextType string
+
 type FakeUserUsecase struct{}
 
 // CreateUser implements driver.UserWriterUsecase.
@@ -27,12 +34,245 @@ func (*FakeUserUsecase) CreateUser(ctx context.Context, params *request.CreateUs
 
 // GenerateUserToken implements driver.UserWriterUsecase.
 func (*FakeUserUsecase) GenerateUserToken(ctx context.Context, params *request.GenerateUserToken) (*response.Token, error) {
-	if params.Username == "test123" {
+	if params.Identifier == "test123" {
 		return nil, errors.New("cannot create user")
 	}
 	return &response.Token{
-		Token:     faker.Jwt(),
-		ExpiresIn: 3600,
-		Type:      "Bearer",
+		Token:            faker.Jwt(),
+		ExpiresIn:        3600,
+		Type:             "Bearer",
+		RefreshToken:     faker.UUIDDigit(),
+		RefreshExpiresIn: 2592000,
+	}, nil
+}
+
+// RefreshUserToken implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) RefreshUserToken(ctx context.Context, params *request.RefreshUserToken) (*response.Token, error) {
+	if params.RefreshToken == "test123" {
+		return nil, errors.New("invalid refresh token")
+	}
+	return &response.Token{
+		Token:            faker.Jwt(),
+		ExpiresIn:        3600,
+		Type:             "Bearer",
+		RefreshToken:     faker.UUIDDigit(),
+		RefreshExpiresIn: 2592000,
+	}, nil
+}
+
+// Logout implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) Logout(ctx context.Context, params *request.Logout) error {
+	if params.RefreshToken == "test123" {
+		return errors.New("cannot logout")
+	}
+	return nil
+}
+
+// LogoutAll implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) LogoutAll(ctx context.Context) error {
+	if val := ctx.Value(ContextType("logout_error")); val != nil {
+		return errors.New("cannot logout")
+	}
+	return nil
+}
+
+// SendPhoneVerification implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) SendPhoneVerification(ctx context.Context, params *request.SendPhoneVerification) error {
+	if params.PhoneNumber == "test123" {
+		return errors.New("cannot send verification code")
+	}
+	return nil
+}
+
+// VerifyPhoneNumber implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) VerifyPhoneNumber(ctx context.Context, params *request.VerifyPhoneNumber) error {
+	if params.Code == "test123" {
+		return errors.New("invalid code")
+	}
+	return nil
+}
+
+// RequestPasswordReset implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) RequestPasswordReset(ctx context.Context, params *request.RequestPasswordReset) error {
+	if params.Identifier == "test123" {
+		return errors.New("cannot send reset code")
+	}
+	return nil
+}
+
+// VerifyPasswordReset implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) VerifyPasswordReset(ctx context.Context, params *request.VerifyPasswordReset) (*response.PasswordResetTicket, error) {
+	if params.Code == "test123" {
+		return nil, errors.New("invalid code")
+	}
+	return &response.PasswordResetTicket{
+		Ticket:    faker.UUIDDigit(),
+		ExpiresIn: 600,
+	}, nil
+}
+
+// ResetPassword implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) ResetPassword(ctx context.Context, params *request.ResetPassword) error {
+	if params.Ticket == "test123" {
+		return errors.New("invalid ticket")
+	}
+	return nil
+}
+
+// ChangePassword implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) ChangePassword(ctx context.Context, params *request.ChangePassword) error {
+	if params.CurrentPassword == "test123" {
+		return errors.New("wrong password")
+	}
+	return nil
+}
+
+// EnrollTOTP implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) EnrollTOTP(ctx context.Context) (*response.TOTPEnrollment, error) {
+	if val := ctx.Value(ContextType("enroll_totp_error")); val != nil {
+		return nil, errors.New("cannot enroll totp")
+	}
+	return &response.TOTPEnrollment{
+		Secret: "JBSWY3DPEHPK3PXP",
+		URI:    "otpauth://totp/dating-be:user?secret=JBSWY3DPEHPK3PXP",
+	}, nil
+}
+
+// ConfirmTOTP implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) ConfirmTOTP(ctx context.Context, params *request.ConfirmTOTP) (*response.TOTPRecoveryCodes, error) {
+	if params.Code == "test123" {
+		return nil, errors.New("invalid code")
+	}
+	return &response.TOTPRecoveryCodes{
+		Codes: []string{faker.UUIDDigit(), faker.UUIDDigit()},
+	}, nil
+}
+
+// CompleteMFALogin implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) CompleteMFALogin(ctx context.Context, params *request.CompleteMFALogin) (*response.Token, error) {
+	if params.Code == "test123" {
+		return nil, errors.New("invalid code")
+	}
+	return &response.Token{
+		Token:            faker.Jwt(),
+		ExpiresIn:        3600,
+		Type:             "Bearer",
+		RefreshToken:     faker.UUIDDigit(),
+		RefreshExpiresIn: 2592000,
+	}, nil
+}
+
+// ListSessions implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) ListSessions(ctx context.Context) ([]*response.Session, error) {
+	if val := ctx.Value(ContextType("list_sessions_error")); val != nil {
+		return nil, errors.New("cannot list sessions")
+	}
+	return []*response.Session{
+		{
+			ID:         faker.UUIDHyphenated(),
+			DeviceName: "Pixel 8",
+			UserAgent:  "okhttp/4.12.0",
+			IPAddress:  faker.IPv4(),
+			LastSeenAt: time.Now(),
+			CreatedAt:  time.Now().Add(-time.Hour),
+			Current:    true,
+		},
+	}, nil
+}
+
+// RevokeSession implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) RevokeSession(ctx context.Context, params *request.RevokeSession) error {
+	if params.SessionID == "test123" {
+		return errors.New("cannot revoke session")
+	}
+	return nil
+}
+
+// DeleteAccount implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) DeleteAccount(ctx context.Context) (*response.AccountDeletion, error) {
+	if val := ctx.Value(ContextType("delete_account_error")); val != nil {
+		return nil, errors.New("cannot delete account")
+	}
+	return &response.AccountDeletion{
+		PurgeAt: time.Now().Add(30 * 24 * time.Hour),
+	}, nil
+}
+
+// ChangeUserRole implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) ChangeUserRole(ctx context.Context, params *request.ChangeUserRole) error {
+	if params.Role == "test123" {
+		return errors.New("cannot change user role")
+	}
+	return nil
+}
+
+// UpdateMyIdentity implements driver.UserWriterUsecase.
+func (*FakeUserUsecase) UpdateMyIdentity(ctx context.Context, params *request.UpdateIdentity) (*response.User, error) {
+	user := &response.User{
+		ID:          1,
+		Name:        faker.Name(),
+		Username:    faker.Username(),
+		PhoneNumber: "+6281234567890",
+		Gender:      "man",
+		ShowGender:  true,
+		Role:        "user",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if params.Gender != nil {
+		if *params.Gender == "" {
+			return nil, errors.New("cannot update identity")
+		}
+		user.Gender = *params.Gender
+	}
+	if params.ShowGender != nil {
+		user.ShowGender = *params.ShowGender
+	}
+	if params.Orientation != nil {
+		user.Orientation = *params.Orientation
+	}
+	return user, nil
+}
+
+type FakeUserReaderUsecase struct{}
+
+// GetMe implements driver.UserReaderUsecase.
+func (*FakeUserReaderUsecase) GetMe(ctx context.Context) (*response.User, error) {
+	if val := ctx.Value(ContextType("get_me_error")); val != nil {
+		return nil, errors.New("cannot get user")
+	}
+	return &response.User{
+		ID:          faker.NewSafeSource(rand.NewSource(1000)).Int63(),
+		Name:        faker.Name(),
+		Username:    faker.Username(),
+		PhoneNumber: "+6281234567890",
+		Gender:      "man",
+		Role:        "user",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+type FakeDataExportUsecase struct{}
+
+// RequestDataExport implements driver.DataExportUsecase.
+func (*FakeDataExportUsecase) RequestDataExport(ctx context.Context) (*response.DataExport, error) {
+	if val := ctx.Value(ContextType("request_data_export_error")); val != nil {
+		return nil, errors.New("cannot export data")
+	}
+	return &response.DataExport{
+		ID:        "export-1",
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}, nil
+}
+
+// DownloadDataExport implements driver.DataExportUsecase.
+func (*FakeDataExportUsecase) DownloadDataExport(ctx context.Context, params *request.DownloadDataExport) (*response.DataExportFile, error) {
+	if params.ExportID == "test123" {
+		return nil, errors.New("cannot download data export")
+	}
+	return &response.DataExportFile{
+		Name:    "data-export.zip",
+		Content: io.NopCloser(strings.NewReader("archive")),
 	}, nil
 }
@@ -1,6 +1,16 @@
 package integration
 
-import "app/tests/client"
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
 
 var openApiClient *client.Client
 
@@ -18,3 +28,44 @@ func strToPtr(value string) *string {
 	}
 	return &value
 }
+
+func genderPtr(value client.ApiV1CreateUserRequestGenderIdentity) *client.ApiV1CreateUserRequestGenderIdentity {
+	return &value
+}
+
+// registerAndLogin creates a new user and returns the token pair of its first login.
+func registerAndLogin(t *testing.T) client.ApiV1CreateUserTokenResponse {
+	assert := assert.New(t)
+	username := generateUsername(10)
+	password := generatePassword(20)
+	resp, err := openApiClient.UserCreateUser(context.Background(), client.UserCreateUserJSONRequestBody{
+		GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+		Name:           strToPtr(faker.Name()),
+		Password:       strToPtr(password),
+		PhoneNumber:    strToPtr(generatePhoneNumber()),
+		Username:       strToPtr(username),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	loginResponse, err := openApiClient.UserCreateUserToken(context.Background(), client.UserCreateUserTokenJSONRequestBody{
+		Password: strToPtr(password),
+		Username: strToPtr(username),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, loginResponse.StatusCode)
+
+	var token client.ApiV1CreateUserTokenResponse
+	body, _ := io.ReadAll(loginResponse.Body)
+	assert.NoError(json.Unmarshal(body, &token))
+	return token
+}
+
+func withBearer(token *string) client.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if token != nil {
+			req.Header.Set("Authorization", "Bearer "+*token)
+		}
+		return nil
+	}
+}
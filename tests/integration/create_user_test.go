@@ -30,11 +30,26 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("male"),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(password),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr(username),
+				},
+			},
+			wantHttpCode: http.StatusOK,
+			wantMessage:  "id",
+		},
+		{
+			name: "when the deprecated gender is sent, it should still return 200",
+			arg: arg{
+				ctx: context.Background(),
+				body: client.UserCreateUserJSONRequestBody{
+					Gender:      strToPtr("female"),
 					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(password),
+					Password:    strToPtr(generatePassword(20)),
 					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr(username),
+					Username:    strToPtr(generateUsername(10)),
 				},
 			},
 			wantHttpCode: http.StatusOK,
@@ -45,11 +60,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("male"),
-					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(password),
-					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr(username),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(password),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr(username),
 				},
 			},
 			wantHttpCode: http.StatusConflict,
@@ -60,11 +75,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("male"),
-					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(password),
-					PhoneNumber: strToPtr(faker.Phonenumber()),
-					Username:    strToPtr(generateUsername(10)),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(password),
+					PhoneNumber:    strToPtr(faker.Phonenumber()),
+					Username:       strToPtr(generateUsername(10)),
 				},
 			},
 			wantHttpCode: http.StatusBadRequest,
@@ -75,11 +90,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("male"),
-					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(faker.Password()),
-					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr(generateUsername(10)),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(faker.Password()),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr(generateUsername(10)),
 				},
 			},
 			wantHttpCode: http.StatusBadRequest,
@@ -90,11 +105,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("male"),
-					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(generatePassword(15)),
-					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr("asd123@13#"),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(generatePassword(15)),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr("asd123@13#"),
 				},
 			},
 			wantHttpCode: http.StatusBadRequest,
@@ -105,11 +120,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("asdqwe"),
-					Name:        strToPtr(faker.Name()),
-					Password:    strToPtr(generatePassword(15)),
-					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr(generateUsername(12)),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERUNSPECIFIED),
+					Name:           strToPtr(faker.Name()),
+					Password:       strToPtr(generatePassword(15)),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr(generateUsername(12)),
 				},
 			},
 			wantHttpCode: http.StatusBadRequest,
@@ -120,11 +135,11 @@ func TestCreateUser(t *testing.T) {
 			arg: arg{
 				ctx: context.Background(),
 				body: client.UserCreateUserJSONRequestBody{
-					Gender:      strToPtr("asdqwe"),
-					Name:        strToPtr("as"),
-					Password:    strToPtr(generatePassword(15)),
-					PhoneNumber: strToPtr(generatePhoneNumber()),
-					Username:    strToPtr(generateUsername(12)),
+					GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERUNSPECIFIED),
+					Name:           strToPtr("as"),
+					Password:       strToPtr(generatePassword(15)),
+					PhoneNumber:    strToPtr(generatePhoneNumber()),
+					Username:       strToPtr(generateUsername(12)),
 				},
 			},
 			wantHttpCode: http.StatusBadRequest,
@@ -0,0 +1,48 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataExport(t *testing.T) {
+	assert := assert.New(t)
+
+	token := registerAndLogin(t)
+	resp, err := openApiClient.UserRequestDataExport(context.Background(), client.UserRequestDataExportJSONRequestBody{}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var export client.ApiV1RequestDataExportResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &export))
+	assert.NotNil(export.DownloadUrl)
+
+	download := func(editors ...client.RequestEditorFn) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:8000"+*export.DownloadUrl, nil)
+		assert.NoError(err)
+		for _, editor := range editors {
+			assert.NoError(editor(context.Background(), req))
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		return resp
+	}
+
+	resp = download()
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	otherToken := registerAndLogin(t)
+	resp = download(withBearer(otherToken.Token))
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp = download(withBearer(token.Token))
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("application/zip", resp.Header.Get("Content-Type"))
+}
@@ -0,0 +1,26 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteMe(t *testing.T) {
+	assert := assert.New(t)
+
+	resp, err := openApiClient.UserDeleteMe(context.Background())
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	token := registerAndLogin(t)
+	resp, err = openApiClient.UserDeleteMe(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.UserGetMe(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
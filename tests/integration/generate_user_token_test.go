@@ -28,12 +28,13 @@ func TestGenerateUserToken(t *testing.T) {
 
 	username := generateUsername(10)
 	password := generatePassword(20)
+	phoneNumber := generatePhoneNumber()
 	resp, err := openApiClient.UserCreateUser(context.Background(), client.UserCreateUserJSONRequestBody{
-		Gender:      strToPtr("male"),
-		Name:        strToPtr(faker.Name()),
-		Password:    strToPtr(password),
-		PhoneNumber: strToPtr(generatePhoneNumber()),
-		Username:    strToPtr(username),
+		GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+		Name:           strToPtr(faker.Name()),
+		Password:       strToPtr(password),
+		PhoneNumber:    strToPtr(phoneNumber),
+		Username:       strToPtr(username),
 	})
 
 	assert.NoError(err)
@@ -51,4 +52,13 @@ func TestGenerateUserToken(t *testing.T) {
 	assert.Contains(string(body), "token")
 	assert.Contains(string(body), "expiresIn")
 	assert.Contains(string(body), "Bearer")
+
+	loginParams = client.UserCreateUserTokenJSONRequestBody{
+		Password:   strToPtr(password),
+		Identifier: strToPtr(phoneNumber),
+	}
+	loginResponse, err = openApiClient.UserCreateUserToken(context.Background(), loginParams)
+
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, loginResponse.StatusCode, "it should login with the phone number")
 }
@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMe(t *testing.T) {
+	assert := assert.New(t)
+
+	resp, err := openApiClient.UserGetMe(context.Background())
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	token := registerAndLogin(t)
+	resp, err = openApiClient.UserGetMe(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.NotContains(string(body), "password")
+
+	var me client.ApiV1GetMeResponse
+	assert.NoError(json.Unmarshal(body, &me))
+	assert.NotNil(me.Id)
+	assert.NotNil(me.Username)
+}
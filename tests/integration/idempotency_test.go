@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func withIdempotencyKey(key string) client.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Idempotency-Key", key)
+		return nil
+	}
+}
+
+func TestCreateUser_idempotencyKey(t *testing.T) {
+	assert := assert.New(t)
+	key := faker.UUIDHyphenated()
+	body := client.UserCreateUserJSONRequestBody{
+		GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERWOMAN),
+		Name:           strToPtr(faker.Name()),
+		Password:       strToPtr(generatePassword(20)),
+		PhoneNumber:    strToPtr(generatePhoneNumber()),
+		Username:       strToPtr(generateUsername(10)),
+	}
+
+	first, err := openApiClient.UserCreateUser(context.Background(), body, withIdempotencyKey(key))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, first.StatusCode)
+	firstBody, _ := io.ReadAll(first.Body)
+
+	retry, err := openApiClient.UserCreateUser(context.Background(), body, withIdempotencyKey(key))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, retry.StatusCode, "a retry should not be reported as a duplicate")
+	assert.Equal("true", retry.Header.Get("Idempotent-Replayed"))
+	retryBody, _ := io.ReadAll(retry.Body)
+	assert.JSONEq(string(firstBody), string(retryBody))
+
+	body.Name = strToPtr(faker.Name())
+	conflict, err := openApiClient.UserCreateUser(context.Background(), body, withIdempotencyKey(key))
+	assert.NoError(err)
+	assert.Equal(http.StatusConflict, conflict.StatusCode)
+	conflictBody, _ := io.ReadAll(conflict.Body)
+	assert.Contains(string(conflictBody), "Conflict")
+}
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterest(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	resp, err := openApiClient.InterestListInterests(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var catalog client.ApiV1ListInterestsResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &catalog))
+	assert.NotEmpty(*catalog.Interests, "it should return the seeded catalog")
+
+	resp, err = openApiClient.InterestSetMyInterests(context.Background(), client.InterestSetMyInterestsJSONRequestBody{
+		InterestIds: &[]string{"1", "999999"},
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode, "it should reject interests missing from the catalog")
+
+	resp, err = openApiClient.InterestSetMyInterests(context.Background(), client.InterestSetMyInterestsJSONRequestBody{
+		InterestIds: &[]string{"1", "12", "1"},
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.InterestListMyInterests(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var interests client.ApiV1ListInterestsResponse
+	body, _ = io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &interests))
+	if assert.Len(*interests.Interests, 2) {
+		assert.Equal("coffee", *(*interests.Interests)[0].Slug)
+		assert.Equal("hiking", *(*interests.Interests)[1].Slug)
+	}
+}
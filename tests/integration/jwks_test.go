@@ -0,0 +1,31 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKS(t *testing.T) {
+	assert := assert.New(t)
+
+	resp, err := http.Get("http://localhost:8000/.well-known/jwks.json")
+	assert.NoError(err)
+	defer resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var keySet struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+		} `json:"keys"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&keySet))
+	assert.NotEmpty(keySet.Keys)
+	for _, key := range keySet.Keys {
+		assert.NotEmpty(key.Kid)
+		assert.Equal("RSA", key.Kty)
+	}
+}
@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	resp, err := openApiClient.LocationGetMyLocation(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusNotFound, resp.StatusCode, "it should not find a location that was never shared")
+
+	latitude, longitude := 91.0, 106.8
+	resp, err = openApiClient.LocationUpdateMyLocation(context.Background(), client.LocationUpdateMyLocationJSONRequestBody{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	latitude = -6.2
+	resp, err = openApiClient.LocationUpdateMyLocation(context.Background(), client.LocationUpdateMyLocationJSONRequestBody{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.LocationGetMyLocation(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var location client.ApiV1LocationResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &location))
+	assert.Equal(-6.2, *location.Latitude)
+	assert.Equal(106.8, *location.Longitude)
+}
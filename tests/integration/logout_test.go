@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogout(t *testing.T) {
+	assert := assert.New(t)
+
+	resp, err := openApiClient.UserLogout(context.Background(), client.UserLogoutJSONRequestBody{})
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	token := registerAndLogin(t)
+	resp, err = openApiClient.UserLogout(context.Background(), client.UserLogoutJSONRequestBody{
+		RefreshToken: token.RefreshToken,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	// the access token is revoked until it expires
+	resp, err = openApiClient.UserLogout(context.Background(), client.UserLogoutJSONRequestBody{}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = openApiClient.UserRefreshUserToken(context.Background(), client.UserRefreshUserTokenJSONRequestBody{
+		RefreshToken: token.RefreshToken,
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestLogoutAll(t *testing.T) {
+	assert := assert.New(t)
+
+	token := registerAndLogin(t)
+	resp, err := openApiClient.UserLogoutAll(context.Background(), client.UserLogoutAllJSONRequestBody{}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.UserRefreshUserToken(context.Background(), client.UserRefreshUserTokenJSONRequestBody{
+		RefreshToken: token.RefreshToken,
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
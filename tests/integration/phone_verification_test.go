@@ -0,0 +1,32 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoneVerification(t *testing.T) {
+	assert := assert.New(t)
+	phoneNumber := generatePhoneNumber()
+
+	resp, err := openApiClient.UserSendPhoneVerification(context.Background(), client.UserSendPhoneVerificationJSONRequestBody{
+		PhoneNumber: strToPtr(phoneNumber),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode, "it should not reveal whether the number is registered")
+
+	resp, err = openApiClient.UserVerifyPhoneNumber(context.Background(), client.UserVerifyPhoneNumberJSONRequestBody{
+		PhoneNumber: strToPtr(phoneNumber),
+		Code:        strToPtr("000000"),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(string(body), "code")
+}
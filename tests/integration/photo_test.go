@@ -0,0 +1,90 @@
+package integration
+
+import (
+	"app/tests/client"
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoto(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	upload := func(content []byte) *http.Response {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, _ := writer.CreateFormFile("photo", "photo.png")
+		_, _ = part.Write(content)
+		assert.NoError(writer.Close())
+
+		req, err := http.NewRequest(http.MethodPost, "http://localhost:8000/api/v1/users/me/photos", &body)
+		assert.NoError(err)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		assert.NoError(withBearer(token.Token)(context.Background(), req))
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		return resp
+	}
+
+	var img bytes.Buffer
+	assert.NoError(png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 1200, 900))))
+
+	resp := upload([]byte("not an image"))
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	var photos []client.ApiV1PhotoResponse
+	for i := 0; i < 2; i++ {
+		resp = upload(img.Bytes())
+		assert.Equal(http.StatusOK, resp.StatusCode)
+
+		var photo client.ApiV1PhotoResponse
+		body, _ := io.ReadAll(resp.Body)
+		assert.NoError(json.Unmarshal(body, &photo))
+		photos = append(photos, photo)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:8000"+*photos[0].ThumbnailUrl, nil)
+	assert.NoError(err)
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	otherToken := registerAndLogin(t)
+	assert.NoError(withBearer(otherToken.Token)(context.Background(), req))
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("image/jpeg", resp.Header.Get("Content-Type"))
+
+	resp, err = openApiClient.PhotoReorderPhotos(context.Background(), client.PhotoReorderPhotosJSONRequestBody{
+		PhotoIds: &[]string{*photos[1].Id, *photos[0].Id},
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.PhotoDeletePhoto(context.Background(), *photos[1].Id, withBearer(otherToken.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = openApiClient.PhotoDeletePhoto(context.Background(), *photos[1].Id, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.PhotoListMyPhotos(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	var list client.ApiV1ListPhotosResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &list))
+	assert.Len(*list.Photos, 1)
+	assert.Equal(*photos[0].Id, *(*list.Photos)[0].Id)
+	assert.True(*(*list.Photos)[0].Primary)
+}
@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferences(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	getPreferences := func() client.ApiV1PreferencesResponse {
+		resp, err := openApiClient.PreferencesGetMyPreferences(context.Background(), withBearer(token.Token))
+		assert.NoError(err)
+		assert.Equal(http.StatusOK, resp.StatusCode)
+
+		var preferences client.ApiV1PreferencesResponse
+		body, _ := io.ReadAll(resp.Body)
+		assert.NoError(json.Unmarshal(body, &preferences))
+		return preferences
+	}
+
+	preferences := getPreferences()
+	assert.Equal(int32(18), *preferences.MinAge, "it should start with the defaults of the signup")
+	assert.Equal([]client.ApiV1PreferencesResponseInterestedIn{client.ApiV1PreferencesResponseInterestedInGENDERWOMAN}, *preferences.InterestedIn)
+
+	minAge, maxAge := int32(30), int32(25)
+	resp, err := openApiClient.PreferencesUpdateMyPreferences(context.Background(), client.PreferencesUpdateMyPreferencesJSONRequestBody{
+		MinAge: &minAge,
+		MaxAge: &maxAge,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	maxAge = int32(40)
+	resp, err = openApiClient.PreferencesUpdateMyPreferences(context.Background(), client.PreferencesUpdateMyPreferencesJSONRequestBody{
+		InterestedIn: &[]client.ApiV1UpdateMyPreferencesRequestInterestedIn{client.GENDERMAN, client.GENDERNONBINARY},
+		MinAge:       &minAge,
+		MaxAge:       &maxAge,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	preferences = getPreferences()
+	assert.ElementsMatch([]client.ApiV1PreferencesResponseInterestedIn{client.ApiV1PreferencesResponseInterestedInGENDERMAN, client.ApiV1PreferencesResponseInterestedInGENDERNONBINARY}, *preferences.InterestedIn)
+	assert.Equal(int32(30), *preferences.MinAge)
+	assert.Equal(int32(40), *preferences.MaxAge)
+	assert.Equal(int32(50), *preferences.MaxDistanceKm)
+}
@@ -0,0 +1,56 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	resp, err := openApiClient.ProfileGetMyProfile(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusNotFound, resp.StatusCode, "it should not find a profile that was never filled in")
+
+	underage := time.Now().AddDate(-17, 0, 0).Format("2006-01-02")
+	resp, err = openApiClient.ProfileUpdateMyProfile(context.Background(), client.ProfileUpdateMyProfileJSONRequestBody{
+		Birthdate: strToPtr(underage),
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	height := int32(172)
+	resp, err = openApiClient.ProfileUpdateMyProfile(context.Background(), client.ProfileUpdateMyProfileJSONRequestBody{
+		Birthdate: strToPtr("1995-03-04"),
+		Bio:       strToPtr("hello"),
+		HeightCm:  &height,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.ProfileUpdateMyProfile(context.Background(), client.ProfileUpdateMyProfileJSONRequestBody{
+		Job: strToPtr("engineer"),
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.ProfileGetMyProfile(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var profile client.ApiV1ProfileResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &profile))
+	assert.Equal("1995-03-04", *profile.Birthdate)
+	assert.Equal("hello", *profile.Bio)
+	assert.Equal("engineer", *profile.Job)
+	assert.Equal(int32(172), *profile.HeightCm)
+}
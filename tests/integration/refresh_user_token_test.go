@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshUserToken(t *testing.T) {
+	assert := assert.New(t)
+
+	refreshResponse, err := openApiClient.UserRefreshUserToken(context.Background(), client.UserRefreshUserTokenJSONRequestBody{
+		RefreshToken: strToPtr(generateUsername(30)),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, refreshResponse.StatusCode)
+
+	username := generateUsername(10)
+	password := generatePassword(20)
+	resp, err := openApiClient.UserCreateUser(context.Background(), client.UserCreateUserJSONRequestBody{
+		GenderIdentity: genderPtr(client.ApiV1CreateUserRequestGenderIdentityGENDERMAN),
+		Name:           strToPtr(faker.Name()),
+		Password:       strToPtr(password),
+		PhoneNumber:    strToPtr(generatePhoneNumber()),
+		Username:       strToPtr(username),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	loginResponse, err := openApiClient.UserCreateUserToken(context.Background(), client.UserCreateUserTokenJSONRequestBody{
+		Password: strToPtr(password),
+		Username: strToPtr(username),
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, loginResponse.StatusCode)
+
+	var token client.ApiV1CreateUserTokenResponse
+	body, _ := io.ReadAll(loginResponse.Body)
+	assert.NoError(json.Unmarshal(body, &token))
+	assert.NotEmpty(token.RefreshToken)
+
+	refreshResponse, err = openApiClient.UserRefreshUserToken(context.Background(), client.UserRefreshUserTokenJSONRequestBody{
+		RefreshToken: token.RefreshToken,
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, refreshResponse.StatusCode)
+	body, _ = io.ReadAll(refreshResponse.Body)
+	assert.Contains(string(body), "refreshToken")
+
+	// replaying the rotated refresh token must be rejected
+	refreshResponse, err = openApiClient.UserRefreshUserToken(context.Background(), client.UserRefreshUserTokenJSONRequestBody{
+		RefreshToken: token.RefreshToken,
+	})
+	assert.NoError(err)
+	assert.Equal(http.StatusUnauthorized, refreshResponse.StatusCode)
+}
@@ -0,0 +1,44 @@
+package integration
+
+import (
+	"app/tests/client"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMyIdentity(t *testing.T) {
+	assert := assert.New(t)
+	token := registerAndLogin(t)
+
+	unspecified := client.ApiV1UpdateMyIdentityRequestGenderGENDERUNSPECIFIED
+	resp, err := openApiClient.UserUpdateMyIdentity(context.Background(), client.UserUpdateMyIdentityJSONRequestBody{
+		Gender: &unspecified,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	nonBinary, queer, hidden := client.ApiV1UpdateMyIdentityRequestGenderGENDERNONBINARY, client.ORIENTATIONQUEER, false
+	resp, err = openApiClient.UserUpdateMyIdentity(context.Background(), client.UserUpdateMyIdentityJSONRequestBody{
+		Gender:      &nonBinary,
+		Orientation: &queer,
+		ShowGender:  &hidden,
+	}, withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = openApiClient.UserGetMe(context.Background(), withBearer(token.Token))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var me client.ApiV1GetMeResponse
+	body, _ := io.ReadAll(resp.Body)
+	assert.NoError(json.Unmarshal(body, &me))
+	assert.Equal(client.ApiV1GetMeResponseGenderIdentityGENDERNONBINARY, *me.GenderIdentity)
+	assert.Equal(client.ApiV1GetMeResponseOrientationORIENTATIONQUEER, *me.Orientation)
+	assert.False(*me.ShowGender)
+}